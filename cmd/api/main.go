@@ -1,12 +1,16 @@
 package main
 
 import (
+	"context"
 	"log"
 	"os"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/m7medVision/crime-management-system/internal/config"
 	"github.com/m7medVision/crime-management-system/internal/controller"
+	"github.com/m7medVision/crime-management-system/internal/email"
+	"github.com/m7medVision/crime-management-system/internal/geo"
 	"github.com/m7medVision/crime-management-system/internal/middleware"
 	"github.com/m7medVision/crime-management-system/internal/model"
 	"github.com/m7medVision/crime-management-system/internal/repository"
@@ -51,29 +55,118 @@ func main() {
 		log.Fatalf("Failed to create temp directory: %v", err)
 	}
 
+	// Offline geocoder for case areas and report locations
+	gazetteer, err := geo.LoadGazetteer(cfg.Geo.GazetteerPath)
+	if err != nil {
+		log.Printf("Geocoding disabled, failed to load gazetteer: %v", err)
+		gazetteer = geo.NewGazetteer()
+	}
+
 	// Initialize repositories
 	userRepo := repository.NewUserRepository(db)
 	caseRepo := repository.NewCaseRepository(db)
 	evidenceRepo := repository.NewEvidenceRepository(db)
+	searchRepo := repository.NewSearchRepository(db)
+	caseLinkRepo := repository.NewCaseLinkRepository(db)
+	taskRepo := repository.NewTaskRepository(db)
+	commentRepo := repository.NewCommentRepository(db)
+	notificationRepo := repository.NewNotificationRepository(db)
+	timelineRepo := repository.NewTimelineRepository(db)
+	slaRepo := repository.NewSLAPolicyRepository(db)
+	analyticsRepo := repository.NewAnalyticsRepository(db)
+	dashboardRepo := repository.NewDashboardRepository(db)
+	tagRepo := repository.NewTagRepository(db)
+	customFieldRepo := repository.NewCustomFieldSchemaRepository(db)
+	watcherRepo := repository.NewWatcherRepository(db)
+	handoverRepo := repository.NewHandoverRepository(db)
+	reportRepo := repository.NewReportRepository(db)
+	templateRepo := repository.NewCaseTemplateRepository(db)
+	savedViewRepo := repository.NewSavedViewRepository(db)
+	closureRepo := repository.NewClosureRepository(db)
+	reopenRepo := repository.NewReopenRepository(db)
+	bundleRepo := repository.NewBundleRepository(db)
+	personRepo := repository.NewPersonRepository(db)
+
+	// Email notifications are only sent when an email provider is configured
+	var mailer email.Sender
+	if cfg.Email.ResendAPIKey != "" {
+		mailer = email.NewResendSender(cfg.Email.ResendAPIKey, cfg.Email.FromEmail, cfg.Email.FromName)
+	} else {
+		log.Println("Email notifications disabled, EMAIL_RESEND_API_KEY is not set")
+	}
 
 	// Initialize services
 	authService := service.NewAuthService(userRepo, cfg.Auth.Secret, cfg.Auth.ExpiryTime)
-	caseService := service.NewCaseService(caseRepo, userRepo)
-	evidenceService := service.NewEvidenceService(evidenceRepo, caseRepo, userRepo, cfg.Storage.Minio.Bucket)
-	userService := service.NewUserService(userRepo)
+	notificationService := service.NewNotificationService(notificationRepo, watcherRepo, caseRepo, userRepo, mailer)
+	watcherService := service.NewWatcherService(watcherRepo, caseRepo)
+	reconciler := service.NewAssignmentReconciler(caseRepo, userRepo, notificationService, cfg.Case.ReconcileMode)
+	caseService := service.NewCaseService(caseRepo, userRepo, tagRepo, customFieldRepo, notificationService, reconciler, cfg.Case, gazetteer)
+	templateService := service.NewCaseTemplateService(templateRepo, tagRepo, customFieldRepo, reportRepo, caseService)
+	savedViewService := service.NewSavedViewService(savedViewRepo, caseService, notificationService)
+	closureService := service.NewClosureService(closureRepo, caseRepo, userRepo, notificationService, cfg.Closure)
+	reopenService := service.NewReopenService(reopenRepo, caseRepo, userRepo, notificationService, cfg.Closure)
+	evidenceService := service.NewEvidenceService(evidenceRepo, caseRepo, userRepo, notificationService, cfg.Storage.Minio.Bucket)
+	personService := service.NewPersonService(personRepo, caseRepo)
+	bundleService := service.NewBundleService(bundleRepo, caseRepo, userRepo, caseService, cfg.Storage.Minio.Bucket, cfg.Bundle, cfg.Case.AreaCode)
+	handoverService := service.NewHandoverService(handoverRepo, caseRepo, userRepo, notificationService)
+	userService := service.NewUserService(userRepo, reconciler, handoverService)
+	searchService := service.NewSearchService(searchRepo)
+	caseLinkService := service.NewCaseLinkService(caseRepo, caseLinkRepo, reconciler)
+	taskService := service.NewTaskService(taskRepo, caseRepo, userRepo)
+	commentService := service.NewCommentService(commentRepo, caseRepo, userRepo, notificationService)
+	timelineService := service.NewTimelineService(timelineRepo, caseRepo)
+	slaService := service.NewSLAService(slaRepo, caseRepo, userRepo, notificationService, cfg.SLA)
+	analyticsService := service.NewAnalyticsService(analyticsRepo)
+	tagService := service.NewTagService(tagRepo, caseRepo)
+	customFieldService := service.NewCustomFieldService(customFieldRepo)
+	bulkCaseService := service.NewBulkCaseService(caseRepo, userRepo, tagRepo, notificationService, reconciler)
+	duplicateService := service.NewDuplicateService(reportRepo, caseRepo, cfg.Report)
+	dashboardService := service.NewDashboardService(dashboardRepo, taskRepo, duplicateService, time.Duration(cfg.Cache.DashboardTTL)*time.Second)
+
+	// Assign reference numbers to cases created before they were introduced
+	if err := caseService.BackfillReferenceNumbers(); err != nil {
+		log.Fatalf("Failed to backfill case reference numbers: %v", err)
+	}
+
+	// Flag SLA breaches and escalate them in the background
+	slaService.Start(context.Background(), time.Duration(cfg.SLA.EvaluationInterval)*time.Second)
+
+	// Notify owners of watched views about new matching cases
+	savedViewService.Start(context.Background(), time.Duration(cfg.View.WatchInterval)*time.Second)
 
 	// Initialize report service
-	reportService, err := service.NewReportService(caseRepo)
+	reportService, err := service.NewReportService(caseRepo, customFieldRepo)
 	if err != nil {
 		log.Fatalf("Failed to initialize report service: %v", err)
 	}
 
 	// Initialize controllers
 	authController := controller.NewAuthController(authService)
-	caseController := controller.NewCaseController(caseService)
+	caseController := controller.NewCaseController(caseService, templateService)
 	evidenceController := controller.NewEvidenceController(evidenceService)
 	reportController := controller.NewReportController(reportService)
 	userController := controller.NewUserController(userService)
+	searchController := controller.NewSearchController(searchService)
+	caseLinkController := controller.NewCaseLinkController(caseLinkService)
+	taskController := controller.NewTaskController(taskService)
+	commentController := controller.NewCommentController(commentService)
+	notificationController := controller.NewNotificationController(notificationService)
+	watcherController := controller.NewWatcherController(watcherService)
+	handoverController := controller.NewHandoverController(handoverService)
+	timelineController := controller.NewTimelineController(timelineService)
+	slaController := controller.NewSLAController(slaService)
+	analyticsController := controller.NewAnalyticsController(analyticsService)
+	dashboardController := controller.NewDashboardController(dashboardService)
+	duplicateController := controller.NewDuplicateController(duplicateService)
+	tagController := controller.NewTagController(tagService)
+	customFieldController := controller.NewCustomFieldController(customFieldService)
+	templateController := controller.NewCaseTemplateController(templateService)
+	savedViewController := controller.NewSavedViewController(savedViewService)
+	closureController := controller.NewClosureController(closureService)
+	reopenController := controller.NewReopenController(reopenService)
+	bundleController := controller.NewBundleController(bundleService)
+	personController := controller.NewPersonController(personService)
+	bulkCaseController := controller.NewBulkCaseController(bulkCaseService)
 
 	// Setup Gin router
 	router := gin.Default()
@@ -93,7 +186,7 @@ func main() {
 
 	// Protected routes
 	protected := router.Group("/api")
-	protected.Use(middleware.BasicAuth(db))
+	protected.Use(middleware.BasicAuth(db), middleware.ResolveCaseReference(db))
 	{
 		// User management routes (admin only)
 		userRoutes := protected.Group("/users")
@@ -104,15 +197,23 @@ func main() {
 			userRoutes.GET("/:id", userController.GetUser)
 			userRoutes.PUT("/:id", userController.UpdateUser)
 			userRoutes.DELETE("/:id", userController.DeleteUser)
+			userRoutes.GET("/:id/reconciliation", userController.PreviewClearanceChange)
+			userRoutes.POST("/:id/reconciliation", userController.ReconcileAssignments)
+			userRoutes.POST("/:id/deactivate", userController.DeactivateUser)
 		}
 
 		// Case routes
 		protected.POST("/cases", middleware.RequireRole(model.RoleInvestigator, model.RoleAdmin), caseController.CreateCase)
 		protected.PUT("/cases/:id", middleware.RequireRole(model.RoleInvestigator, model.RoleAdmin), caseController.UpdateCase)
+		protected.PATCH("/cases/:id", middleware.RequireRole(model.RoleInvestigator, model.RoleAdmin), caseController.PatchCase)
 
 		// Case routes - All authenticated users (with clearance check)
 		protected.GET("/cases/:id", middleware.RequireClearance(model.ClearanceLow), caseController.GetCaseByID)
 		protected.GET("/cases", middleware.RequireClearance(model.ClearanceLow), caseController.ListCases)
+		protected.GET("/cases/geojson", middleware.RequireClearance(model.ClearanceLow), caseController.ExportGeoJSON)
+
+		// Bulk case operations - permissions are checked per operation and per case
+		protected.POST("/cases/bulk", middleware.RequireRole(model.RoleOfficer, model.RoleInvestigator, model.RoleAdmin), bulkCaseController.ApplyBulkOperation)
 
 		// Case status update - Officers, Investigators, and Admin
 		protected.PATCH("/cases/:id/status", middleware.RequireRole(model.RoleOfficer, model.RoleInvestigator, model.RoleAdmin), caseController.UpdateCaseStatus)
@@ -121,6 +222,30 @@ func main() {
 		protected.GET("/cases/:id/assignees", middleware.RequireClearance(model.ClearanceLow), caseController.GetAssignees)
 		protected.POST("/cases/:id/assignees", middleware.RequireRole(model.RoleInvestigator, model.RoleAdmin), caseController.AddAssignee)
 		protected.DELETE("/cases/:id/assignees", middleware.RequireRole(model.RoleInvestigator, model.RoleAdmin), caseController.RemoveAssignee)
+		protected.GET("/cases/:id/reconciliation", middleware.RequireRole(model.RoleInvestigator, model.RoleAdmin), caseController.PreviewAuthorizationChange)
+		protected.POST("/cases/:id/reconciliation", middleware.RequireRole(model.RoleInvestigator, model.RoleAdmin), caseController.ReconcileAssignees)
+		// Case handovers - the incoming investigator accepts or declines
+		protected.GET("/cases/:id/handovers", middleware.RequireClearance(model.ClearanceLow), handoverController.ListCaseHandovers)
+		protected.POST("/cases/:id/handovers", middleware.RequireRole(model.RoleInvestigator, model.RoleAdmin), handoverController.RequestHandover)
+		protected.GET("/me/handovers", handoverController.ListMyHandovers)
+		protected.POST("/me/handovers/all", middleware.RequireRole(model.RoleInvestigator, model.RoleAdmin), handoverController.HandOverAllMyCases)
+		protected.POST("/handovers/:id/accept", handoverController.AcceptHandover)
+		protected.POST("/handovers/:id/decline", handoverController.DeclineHandover)
+		protected.POST("/handovers/:id/cancel", handoverController.CancelHandover)
+		// Case closure - a disposition, closing summary and checklist, approved by a supervisor when required
+		protected.GET("/cases/:id/closure", middleware.RequireClearance(model.ClearanceLow), closureController.GetClosureCheck)
+		protected.POST("/cases/:id/close", middleware.RequireRole(model.RoleOfficer, model.RoleInvestigator, model.RoleAdmin), closureController.CloseCase)
+		protected.GET("/cases/:id/closures", middleware.RequireClearance(model.ClearanceLow), closureController.ListCaseClosures)
+		protected.GET("/closures/pending", middleware.RequireSupervisor(), closureController.ListPendingClosures)
+		protected.POST("/closures/:id/approve", middleware.RequireSupervisor(), closureController.ApproveClosure)
+		protected.POST("/closures/:id/reject", middleware.RequireSupervisor(), closureController.RejectClosure)
+		// Case reopening - a reason, approved by a supervisor when the case was closed long ago
+		protected.POST("/cases/:id/reopen", middleware.RequireRole(model.RoleOfficer, model.RoleInvestigator, model.RoleAdmin), reopenController.ReopenCase)
+		protected.GET("/cases/:id/reopens", middleware.RequireClearance(model.ClearanceLow), reopenController.ListCaseReopens)
+		protected.GET("/reopens/pending", middleware.RequireSupervisor(), reopenController.ListPendingReopens)
+		protected.POST("/reopens/:id/approve", middleware.RequireSupervisor(), reopenController.ApproveReopen)
+		protected.POST("/reopens/:id/reject", middleware.RequireSupervisor(), reopenController.RejectReopen)
+		protected.GET("/assignments/workload", middleware.RequireRole(model.RoleInvestigator, model.RoleAdmin), caseController.GetWorkload)
 
 		// Evidence routes - Create/Upload (Officers, Investigators, Admin)
 		protected.POST("/evidence/text", middleware.RequireRole(model.RoleOfficer, model.RoleInvestigator, model.RoleAdmin), evidenceController.CreateTextEvidence)
@@ -132,6 +257,7 @@ func main() {
 
 		// Evidence routes - Update/Delete (Investigators and Admin only)
 		protected.PUT("/evidence/:id", middleware.RequireRole(model.RoleInvestigator, model.RoleAdmin), evidenceController.UpdateEvidence)
+		protected.PATCH("/evidence/:id", middleware.RequireRole(model.RoleInvestigator, model.RoleAdmin), evidenceController.UpdateEvidence)
 		protected.DELETE("/evidence/:id", middleware.RequireRole(model.RoleInvestigator, model.RoleAdmin), evidenceController.SoftDeleteEvidence)
 		protected.DELETE("/evidence/:id/permanent", middleware.RequireRole(model.RoleAdmin), evidenceController.HardDeleteEvidence)
 
@@ -142,13 +268,120 @@ func main() {
 		protected.GET("/cases/:id/evidence", middleware.RequireClearance(model.ClearanceLow), caseController.GetEvidence)
 		protected.GET("/cases/:id/links", middleware.RequireClearance(model.ClearanceLow), caseController.ExtractLinks)
 
+		// Related cases and merging (Investigators and Admin can modify)
+		protected.GET("/cases/:id/related", middleware.RequireClearance(model.ClearanceLow), caseLinkController.ListRelatedCases)
+		protected.POST("/cases/:id/related", middleware.RequireRole(model.RoleInvestigator, model.RoleAdmin), caseLinkController.LinkCase)
+		protected.DELETE("/cases/:id/related/:linkId", middleware.RequireRole(model.RoleInvestigator, model.RoleAdmin), caseLinkController.UnlinkCase)
+		protected.POST("/cases/:id/merge", middleware.RequireRole(model.RoleInvestigator, model.RoleAdmin), caseLinkController.MergeCase)
+
+		// Case tasks - Investigators and Admin manage, assigned officers can update
+		protected.GET("/cases/:id/tasks", middleware.RequireClearance(model.ClearanceLow), taskController.ListCaseTasks)
+		protected.POST("/cases/:id/tasks", middleware.RequireRole(model.RoleInvestigator, model.RoleAdmin), taskController.CreateTask)
+		protected.GET("/tasks/:id", middleware.RequireClearance(model.ClearanceLow), taskController.GetTask)
+		protected.PUT("/tasks/:id", middleware.RequireRole(model.RoleOfficer, model.RoleInvestigator, model.RoleAdmin), taskController.UpdateTask)
+		protected.DELETE("/tasks/:id", middleware.RequireRole(model.RoleInvestigator, model.RoleAdmin), taskController.DeleteTask)
+		protected.GET("/me/tasks", taskController.ListMyTasks)
+
+		// Case comments - Officers, Investigators and Admin can post (with case clearance check)
+		protected.GET("/cases/:id/comments", middleware.RequireClearance(model.ClearanceLow), commentController.ListComments)
+		protected.POST("/cases/:id/comments", middleware.RequireRole(model.RoleOfficer, model.RoleInvestigator, model.RoleAdmin), commentController.CreateComment)
+		protected.PUT("/comments/:id", middleware.RequireRole(model.RoleOfficer, model.RoleInvestigator, model.RoleAdmin), commentController.UpdateComment)
+		protected.DELETE("/comments/:id", middleware.RequireRole(model.RoleOfficer, model.RoleInvestigator, model.RoleAdmin), commentController.DeleteComment)
+		protected.GET("/comments/:id/history", middleware.RequireClearance(model.ClearanceLow), commentController.GetCommentHistory)
+
+		// Case activity timeline - Any user with case clearance
+		protected.GET("/cases/:id/timeline", middleware.RequireClearance(model.ClearanceLow), timelineController.GetCaseTimeline)
+
+		// Notification inbox and preferences
+		protected.GET("/me/notifications", notificationController.ListNotifications)
+		protected.POST("/me/notifications/read-all", notificationController.MarkAllNotificationsRead)
+		protected.POST("/me/notifications/:id/read", notificationController.MarkNotificationRead)
+		protected.GET("/me/notification-preferences", notificationController.GetNotificationPreferences)
+		protected.PUT("/me/notification-preferences", notificationController.UpdateNotificationPreferences)
+
+		// Case watchers - Any user with case clearance can follow a case
+		protected.POST("/cases/:id/watch", middleware.RequireClearance(model.ClearanceLow), watcherController.WatchCase)
+		protected.DELETE("/cases/:id/watch", watcherController.UnwatchCase)
+		protected.GET("/cases/:id/watchers", middleware.RequireClearance(model.ClearanceLow), watcherController.ListWatchers)
+		protected.GET("/me/watching", watcherController.ListWatchedCases)
+
+		// Personal workload dashboard
+		protected.GET("/me/dashboard", dashboardController.GetDashboard)
+
+		// Citizen report triage - duplicates are linked to the case already handling the incident
+		protected.GET("/reports/:id/duplicates", middleware.RequireRole(model.RoleOfficer, model.RoleInvestigator, model.RoleAdmin), duplicateController.FindDuplicates)
+		protected.POST("/reports/:id/link", middleware.RequireRole(model.RoleOfficer, model.RoleInvestigator, model.RoleAdmin), duplicateController.LinkReportToCase)
+		protected.POST("/reports/:id/case", middleware.RequireRole(model.RoleInvestigator, model.RoleAdmin), caseController.ConvertReportToCase)
+
 		// Case suspects, victims, and witnesses routes (with clearance check)
 		protected.GET("/cases/:id/suspects", middleware.RequireClearance(model.ClearanceLow), caseController.GetSuspects)
 		protected.GET("/cases/:id/victims", middleware.RequireClearance(model.ClearanceLow), caseController.GetVictims)
 		protected.GET("/cases/:id/witnesses", middleware.RequireClearance(model.ClearanceLow), caseController.GetWitnesses)
+		// People - Officers, Investigators and Admin add them, Investigators and Admin change and remove them
+		protected.POST("/cases/:id/suspects", middleware.RequireRole(model.RoleOfficer, model.RoleInvestigator, model.RoleAdmin), personController.CreateSuspect)
+		protected.PUT("/cases/:id/suspects/:personId", middleware.RequireRole(model.RoleInvestigator, model.RoleAdmin), personController.UpdateSuspect)
+		protected.PATCH("/cases/:id/suspects/:personId", middleware.RequireRole(model.RoleInvestigator, model.RoleAdmin), personController.UpdateSuspect)
+		protected.DELETE("/cases/:id/suspects/:personId", middleware.RequireRole(model.RoleInvestigator, model.RoleAdmin), personController.DeleteSuspect)
+		protected.POST("/cases/:id/victims", middleware.RequireRole(model.RoleOfficer, model.RoleInvestigator, model.RoleAdmin), personController.CreateVictim)
+		protected.PUT("/cases/:id/victims/:personId", middleware.RequireRole(model.RoleInvestigator, model.RoleAdmin), personController.UpdateVictim)
+		protected.PATCH("/cases/:id/victims/:personId", middleware.RequireRole(model.RoleInvestigator, model.RoleAdmin), personController.UpdateVictim)
+		protected.DELETE("/cases/:id/victims/:personId", middleware.RequireRole(model.RoleInvestigator, model.RoleAdmin), personController.DeleteVictim)
+		protected.POST("/cases/:id/witnesses", middleware.RequireRole(model.RoleOfficer, model.RoleInvestigator, model.RoleAdmin), personController.CreateWitness)
+		protected.PUT("/cases/:id/witnesses/:personId", middleware.RequireRole(model.RoleInvestigator, model.RoleAdmin), personController.UpdateWitness)
+		protected.PATCH("/cases/:id/witnesses/:personId", middleware.RequireRole(model.RoleInvestigator, model.RoleAdmin), personController.UpdateWitness)
+		protected.DELETE("/cases/:id/witnesses/:personId", middleware.RequireRole(model.RoleInvestigator, model.RoleAdmin), personController.DeleteWitness)
 
 		// Report routes (with clearance check)
 		protected.GET("/cases/:id/report", middleware.RequireClearance(model.ClearanceLow), reportController.GenerateCaseReport)
+		// Case bundles - signed zips to hand a case over to another district or the prosecutor
+		protected.GET("/cases/:id/bundle", middleware.RequireRole(model.RoleInvestigator, model.RoleAdmin), bundleController.ExportCase)
+		protected.POST("/cases/import", middleware.RequireRole(model.RoleInvestigator, model.RoleAdmin), bundleController.ImportCase)
+
+		// Tags - Admin manages the vocabulary, Officers, Investigators and Admin apply tags
+		protected.GET("/tags", tagController.ListTags)
+		protected.POST("/tags", middleware.RequireRole(model.RoleAdmin), tagController.CreateTag)
+		protected.PUT("/tags/:id", middleware.RequireRole(model.RoleAdmin), tagController.UpdateTag)
+		protected.DELETE("/tags/:id", middleware.RequireRole(model.RoleAdmin), tagController.DeleteTag)
+		protected.GET("/tags/entity/:entityType/:entityId", middleware.RequireClearance(model.ClearanceLow), tagController.ListEntityTags)
+		protected.POST("/tags/bulk", middleware.RequireRole(model.RoleOfficer, model.RoleInvestigator, model.RoleAdmin), tagController.BulkTag)
+
+		// Custom field schemas - readable by everyone for case forms, managed by Admin
+		protected.GET("/custom-field-schemas", customFieldController.ListSchemas)
+		protected.GET("/custom-field-schemas/:caseType", customFieldController.GetSchema)
+		protected.PUT("/custom-field-schemas", middleware.RequireRole(model.RoleAdmin), customFieldController.SaveSchema)
+		protected.DELETE("/custom-field-schemas/:caseType", middleware.RequireRole(model.RoleAdmin), customFieldController.DeleteSchema)
+
+		// Case templates - Admin manages them, used with POST /cases?template= and POST /reports/:id/case
+		protected.GET("/case-templates", templateController.ListTemplates)
+		protected.GET("/case-templates/:id", templateController.GetTemplate)
+		protected.POST("/case-templates", middleware.RequireRole(model.RoleAdmin), templateController.CreateTemplate)
+		protected.PUT("/case-templates/:id", middleware.RequireRole(model.RoleAdmin), templateController.UpdateTemplate)
+		protected.DELETE("/case-templates/:id", middleware.RequireRole(model.RoleAdmin), templateController.DeleteTemplate)
+
+		// Saved case views - personal or shared with the owner's area
+		protected.GET("/views", savedViewController.ListViews)
+		protected.POST("/views", savedViewController.CreateView)
+		protected.GET("/views/:id", savedViewController.RunView)
+		protected.PUT("/views/:id", savedViewController.UpdateView)
+		protected.DELETE("/views/:id", savedViewController.DeleteView)
+
+		// SLA policies (admin only)
+		protected.GET("/sla-policies", middleware.RequireRole(model.RoleAdmin), slaController.ListPolicies)
+		protected.PUT("/sla-policies", middleware.RequireRole(model.RoleAdmin), slaController.SavePolicy)
+		protected.DELETE("/sla-policies/:id", middleware.RequireRole(model.RoleAdmin), slaController.DeletePolicy)
+
+		// Crime statistics (supervisors and admin, results filtered by clearance)
+		analyticsRoutes := protected.Group("/analytics")
+		analyticsRoutes.Use(middleware.RequireSupervisor())
+		{
+			analyticsRoutes.GET("/counts", analyticsController.CountCases)
+			analyticsRoutes.GET("/timeseries", analyticsController.CountCasesOverTime)
+			analyticsRoutes.GET("/trends", analyticsController.CaseTrends)
+			analyticsRoutes.GET("/hotspots", analyticsController.Hotspots)
+		}
+
+		// Full-text search (results filtered by clearance)
+		protected.GET("/search", middleware.RequireClearance(model.ClearanceLow), searchController.Search)
 	}
 	// Start server
 	port := cfg.Server.Port
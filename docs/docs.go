@@ -15,255 +15,392 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/cases": {
+        "/analytics/counts": {
             "get": {
                 "security": [
                     {
                         "BasicAuth": []
                     }
                 ],
-                "description": "Get a paginated list of cases with optional search",
+                "description": "Count cases created in a period grouped by area, case type, status, priority or disposition. Only cases within the caller's clearance are counted. Defaults to the last 30 days.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "text/csv"
                 ],
                 "tags": [
-                    "cases"
+                    "analytics"
                 ],
-                "summary": "List all cases",
+                "summary": "Count cases by dimension",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "default": 0,
-                        "description": "Pagination offset",
-                        "name": "offset",
+                        "type": "string",
+                        "description": "Dimension: area, caseType, status, priority or disposition",
+                        "name": "by",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start of the period (RFC3339 or YYYY-MM-DD)",
+                        "name": "from",
                         "in": "query"
                     },
                     {
-                        "type": "integer",
-                        "default": 10,
-                        "description": "Items per page",
-                        "name": "limit",
+                        "type": "string",
+                        "description": "End of the period (RFC3339 or YYYY-MM-DD, inclusive)",
+                        "name": "to",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Search term for case name or description",
-                        "name": "search",
+                        "description": "Only cases in this area",
+                        "name": "area",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only cases of this type",
+                        "name": "caseType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only cases with this status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only closed cases with this disposition",
+                        "name": "disposition",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "json",
+                        "description": "Response format: json or csv",
+                        "name": "format",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "cases and total count",
+                        "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.CountDTO"
+                            }
                         }
                     },
-                    "500": {
-                        "description": "Server error",
+                    "400": {
+                        "description": "Invalid query",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Supervisor access required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/analytics/hotspots": {
+            "get": {
                 "security": [
                     {
                         "BasicAuth": []
                     }
                 ],
-                "description": "Create a new criminal case record",
+                "description": "Get the areas with the most cases in a period, with their share of all cases and the centre of their geolocated cases. Defaults to the last 30 days.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "text/csv"
                 ],
                 "tags": [
-                    "cases"
+                    "analytics"
                 ],
-                "summary": "Create a new case",
+                "summary": "Top hotspot areas",
                 "parameters": [
                     {
-                        "description": "Case details",
-                        "name": "case",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.CaseDTO"
-                        }
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Number of areas",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start of the period (RFC3339 or YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End of the period (RFC3339 or YYYY-MM-DD, inclusive)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only cases of this type",
+                        "name": "caseType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only cases with this status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only closed cases with this disposition",
+                        "name": "disposition",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "json",
+                        "description": "Response format: json or csv",
+                        "name": "format",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.Case"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.HotspotDTO"
+                            }
                         }
                     },
                     "400": {
-                        "description": "Invalid case data",
+                        "description": "Invalid query",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
                         }
                     },
                     "403": {
-                        "description": "Permission denied",
+                        "description": "Supervisor access required",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
                         }
                     }
                 }
             }
         },
-        "/cases/{id}": {
+        "/analytics/timeseries": {
             "get": {
                 "security": [
                     {
                         "BasicAuth": []
                     }
                 ],
-                "description": "Retrieve detailed information about a specific case",
+                "description": "Count cases created per day, week (starting Monday) or month. Buckets without cases are included with a zero count. Defaults to the last 30 days.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "text/csv"
                 ],
                 "tags": [
-                    "cases"
+                    "analytics"
                 ],
-                "summary": "Get case details",
+                "summary": "Count cases over time",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Case ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "type": "string",
+                        "default": "day",
+                        "description": "Bucket size: day, week or month",
+                        "name": "bucket",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start of the period (RFC3339 or YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End of the period (RFC3339 or YYYY-MM-DD, inclusive)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only cases in this area",
+                        "name": "area",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only cases of this type",
+                        "name": "caseType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only cases with this status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only closed cases with this disposition",
+                        "name": "disposition",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "json",
+                        "description": "Response format: json or csv",
+                        "name": "format",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.Case"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.TimeBucketDTO"
+                            }
                         }
                     },
                     "400": {
-                        "description": "Invalid case ID",
+                        "description": "Invalid query",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
                         }
                     },
-                    "404": {
-                        "description": "Case not found",
+                    "403": {
+                        "description": "Supervisor access required",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/analytics/trends": {
+            "get": {
                 "security": [
                     {
                         "BasicAuth": []
                     }
                 ],
-                "description": "Update an existing criminal case record",
+                "description": "Count cases per group in a period and compare them with the previous period of the same length. Defaults to the last 30 days.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "text/csv"
                 ],
                 "tags": [
-                    "cases"
+                    "analytics"
                 ],
-                "summary": "Update an existing case",
+                "summary": "Compare case counts with the previous period",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Case ID",
-                        "name": "id",
-                        "in": "path",
+                        "type": "string",
+                        "description": "Dimension: area, caseType, status, priority or disposition",
+                        "name": "by",
+                        "in": "query",
                         "required": true
                     },
                     {
-                        "description": "Updated case details",
-                        "name": "case",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.CaseDTO"
-                        }
+                        "type": "string",
+                        "description": "Start of the period (RFC3339 or YYYY-MM-DD)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End of the period (RFC3339 or YYYY-MM-DD, inclusive)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only cases in this area",
+                        "name": "area",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only cases of this type",
+                        "name": "caseType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only cases with this status",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only closed cases with this disposition",
+                        "name": "disposition",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "json",
+                        "description": "Response format: json or csv",
+                        "name": "format",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.Case"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.TrendDTO"
+                            }
                         }
                     },
                     "400": {
-                        "description": "Invalid case data",
+                        "description": "Invalid query",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
                         }
                     },
                     "403": {
-                        "description": "Permission denied",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
-                        }
-                    },
-                    "404": {
-                        "description": "Case not found",
+                        "description": "Supervisor access required",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
                         }
                     }
                 }
             }
         },
-        "/cases/{id}/assignees": {
+        "/assignments/workload": {
             "get": {
                 "security": [
                     {
                         "BasicAuth": []
                     }
                 ],
-                "description": "Retrieve list of users assigned to a case",
+                "description": "Count the open cases of every active investigator, least loaded first. Used to balance assignments and by auto-assignment.",
                 "consumes": [
                     "application/json"
                 ],
@@ -274,14 +411,13 @@ const docTemplate = `{
                     "cases",
                     "assignees"
                 ],
-                "summary": "Get case assignees",
+                "summary": "Get investigator workload",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Case ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "type": "string",
+                        "description": "Only list investigators whose home area matches",
+                        "name": "area",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -290,37 +426,27 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.User"
-                            }
-                        }
-                    },
-                    "400": {
-                        "description": "Invalid case ID",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
+                                "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.WorkloadDTO"
                             }
                         }
                     },
                     "500": {
                         "description": "Server error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/case-templates": {
+            "get": {
                 "security": [
                     {
                         "BasicAuth": []
                     }
                 ],
-                "description": "Assign a user to a case",
+                "description": "Get the templates cases of recurring crime types can be created from",
                 "consumes": [
                     "application/json"
                 ],
@@ -328,59 +454,34 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "cases",
-                    "assignees"
-                ],
-                "summary": "Add assignee to case",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Case ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "User ID to assign",
-                        "name": "assignee",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.AssigneeDTO"
-                        }
-                    }
+                    "case-templates"
                 ],
+                "summary": "List case templates",
                 "responses": {
                     "200": {
-                        "description": "Success message",
+                        "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.CaseTemplateResponseDTO"
                             }
                         }
                     },
-                    "400": {
-                        "description": "Invalid case ID or assignee data",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
-                        }
-                    },
-                    "403": {
-                        "description": "Permission denied",
+                    "500": {
+                        "description": "Server error",
                         "schema": {
                             "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
                         }
                     }
                 }
             },
-            "delete": {
+            "post": {
                 "security": [
                     {
                         "BasicAuth": []
                     }
                 ],
-                "description": "Unassign a user from a case",
+                "description": "Define the defaults, checklist tasks, tags and custom field defaults of cases of a recurring crime type",
                 "consumes": [
                     "application/json"
                 ],
@@ -388,46 +489,41 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "cases",
-                    "assignees"
+                    "case-templates"
                 ],
-                "summary": "Remove assignee from case",
+                "summary": "Create a case template",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Case ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "User ID to unassign",
-                        "name": "assignee",
+                        "description": "Case template",
+                        "name": "template",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.AssigneeDTO"
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.CaseTemplateDTO"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Success message",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.CaseTemplateResponseDTO"
                         }
                     },
                     "400": {
-                        "description": "Invalid case ID or assignee data",
+                        "description": "Invalid template",
                         "schema": {
                             "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
                         }
                     },
-                    "403": {
-                        "description": "Permission denied",
+                    "404": {
+                        "description": "Tag not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "409": {
+                        "description": "Template name already in use",
                         "schema": {
                             "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
                         }
@@ -435,14 +531,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/cases/{id}/evidence": {
+        "/case-templates/{id}": {
             "get": {
                 "security": [
                     {
                         "BasicAuth": []
                     }
                 ],
-                "description": "Retrieve all evidence related to a case",
+                "description": "Get a case template by ID or name",
                 "consumes": [
                     "application/json"
                 ],
@@ -450,14 +546,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "cases",
-                    "evidence"
+                    "case-templates"
                 ],
-                "summary": "Get case evidence",
+                "summary": "Get a case template",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Case ID",
+                        "type": "string",
+                        "description": "Template ID or name",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -467,35 +562,24 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.Evidence"
-                            }
-                        }
-                    },
-                    "400": {
-                        "description": "Invalid case ID",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.CaseTemplateResponseDTO"
                         }
                     },
-                    "500": {
-                        "description": "Server error",
+                    "404": {
+                        "description": "Template not found",
                         "schema": {
                             "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
                         }
                     }
                 }
-            }
-        },
-        "/cases/{id}/links": {
-            "get": {
+            },
+            "put": {
                 "security": [
                     {
                         "BasicAuth": []
                     }
                 ],
-                "description": "Extract URLs from the case description text",
+                "description": "Replace a case template. Cases already created from it are not changed.",
                 "consumes": [
                     "application/json"
                 ],
@@ -503,68 +587,75 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "cases"
+                    "case-templates"
                 ],
-                "summary": "Extract links from case description",
+                "summary": "Replace a case template",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Case ID",
+                        "description": "Template ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Case template",
+                        "name": "template",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.CaseTemplateDTO"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Extracted links",
+                        "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "array",
-                                "items": {
-                                    "type": "string"
-                                }
-                            }
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.CaseTemplateResponseDTO"
                         }
                     },
                     "400": {
-                        "description": "Invalid case ID",
+                        "description": "Invalid template",
                         "schema": {
                             "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
                         }
                     },
-                    "500": {
-                        "description": "Server error",
+                    "404": {
+                        "description": "Template or tag not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "409": {
+                        "description": "Template name already in use",
                         "schema": {
                             "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
                         }
                     }
                 }
-            }
-        },
-        "/cases/{id}/report": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BasicAuth": []
                     }
                 ],
-                "description": "Generate a PDF report for a case with all details",
+                "description": "Remove a case template. Cases created from it are kept as they are.",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "application/pdf"
+                    "application/json"
                 ],
                 "tags": [
-                    "reports"
+                    "case-templates"
                 ],
-                "summary": "Generate case report",
+                "summary": "Delete a case template",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Case ID",
+                        "description": "Template ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -572,19 +663,22 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "PDF report file",
+                        "description": "Success message",
                         "schema": {
-                            "type": "file"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "400": {
-                        "description": "Invalid case ID",
+                        "description": "Invalid template ID",
                         "schema": {
                             "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
                         }
                     },
-                    "500": {
-                        "description": "Server error",
+                    "404": {
+                        "description": "Template not found",
                         "schema": {
                             "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
                         }
@@ -592,14 +686,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/cases/{id}/status": {
-            "patch": {
+        "/cases": {
+            "get": {
                 "security": [
                     {
                         "BasicAuth": []
                     }
                 ],
-                "description": "Update the status of a case (pending, ongoing, closed)",
+                "description": "Get a paginated list of cases with optional search and filters",
                 "consumes": [
                     "application/json"
                 ],
@@ -609,34 +703,119 @@ const docTemplate = `{
                 "tags": [
                     "cases"
                 ],
-                "summary": "Update case status",
+                "summary": "List all cases",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Case ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "default": 0,
+                        "description": "Pagination offset",
+                        "name": "offset",
+                        "in": "query"
                     },
                     {
-                        "description": "New case status",
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Search term for case name or description",
+                        "name": "search",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by priority (low, medium, high, critical)",
+                        "name": "priority",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by SLA status (on_track, at_risk, breached, met)",
+                        "name": "slaStatus",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bounding box as minLon,minLat,maxLon,maxLat",
+                        "name": "bbox",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Centre point as lat,lon for a radius search",
+                        "name": "near",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "default": 5,
+                        "description": "Radius in km around near",
+                        "name": "radius",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated tag names to filter by",
+                        "name": "tags",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "all",
+                        "description": "Match all tags (all) or any tag (any)",
+                        "name": "tagMatch",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by status (pending, ongoing, closed)",
                         "name": "status",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.StatusUpdateDTO"
-                        }
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter closed cases by disposition (solved_arrest, solved_no_arrest, unfounded, insufficient_evidence, referred, withdrawn)",
+                        "name": "disposition",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by area",
+                        "name": "area",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by case type",
+                        "name": "caseType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by a custom field of the case type, e.g. field.plate=AB1234; requires caseType",
+                        "name": "field.key",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sort by createdAt, updatedAt, name, referenceNumber, status, priority or slaDueAt; prefix with - for descending",
+                        "name": "sort",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "cases and total count",
                         "schema": {
-                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.Case"
+                            "type": "object",
+                            "additionalProperties": true
                         }
                     },
                     "400": {
-                        "description": "Invalid case ID or status",
+                        "description": "Invalid filter",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -644,35 +823,8 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "401": {
-                        "description": "Authentication required",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
-                        }
-                    },
-                    "403": {
-                        "description": "Permission denied",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
-                        }
-                    },
-                    "404": {
-                        "description": "Case not found",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
-                        }
-                    },
-                    "500": {
-                        "description": "Server error",
+                    "500": {
+                        "description": "Server error",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -681,16 +833,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/cases/{id}/suspects": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "BasicAuth": []
                     }
                 ],
-                "description": "Retrieve all suspects related to a case",
+                "description": "Create a new criminal case record. With a template, the template supplies the defaults, checklist tasks and tags; every body field becomes optional and overrides the template, and custom field values are merged with its defaults.",
                 "consumes": [
                     "application/json"
                 ],
@@ -698,37 +848,53 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "cases",
-                    "suspects"
+                    "cases"
                 ],
-                "summary": "Get case suspects",
+                "summary": "Create a new case",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Case ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "type": "string",
+                        "description": "Case template ID or name",
+                        "name": "template",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Case details",
+                        "name": "case",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.CaseDTO"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.Suspect"
-                            }
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.Case"
                         }
                     },
                     "400": {
-                        "description": "Invalid case ID",
+                        "description": "Invalid case data",
                         "schema": {
-                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
-                    "500": {
-                        "description": "Server error",
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Template not found",
                         "schema": {
                             "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
                         }
@@ -736,14 +902,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/cases/{id}/victims": {
-            "get": {
+        "/cases/bulk": {
+            "post": {
                 "security": [
                     {
                         "BasicAuth": []
                     }
                 ],
-                "description": "Retrieve all victims related to a case",
+                "description": "Assign or unassign a user, change the status, tag or untag, or change the authorization level of a list of cases or of every case matching a filter (at most 500). Permissions are checked per case. By default each case is applied on its own and the result lists the outcome per case; with atomic set, either every case is changed or none is. Each changed case gets one audit log entry.",
                 "consumes": [
                     "application/json"
                 ],
@@ -751,37 +917,41 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "cases",
-                    "victims"
+                    "cases"
                 ],
-                "summary": "Get case victims",
+                "summary": "Apply an operation to many cases",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Case ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Operation and cases",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.BulkCaseDTO"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.Victim"
-                            }
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.BulkCaseResultDTO"
                         }
                     },
                     "400": {
-                        "description": "Invalid case ID",
+                        "description": "Invalid request",
                         "schema": {
                             "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
                         }
                     },
-                    "500": {
-                        "description": "Server error",
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "User or tag not found",
                         "schema": {
                             "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
                         }
@@ -789,14 +959,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/cases/{id}/witnesses": {
+        "/cases/geojson": {
             "get": {
                 "security": [
                     {
                         "BasicAuth": []
                     }
                 ],
-                "description": "Retrieve all witnesses related to a case",
+                "description": "Get the cases visible to the caller that have coordinates as a GeoJSON FeatureCollection. Accepts the same filters as the case list.",
                 "consumes": [
                     "application/json"
                 ],
@@ -804,31 +974,64 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "cases",
-                    "witnesses"
+                    "cases"
                 ],
-                "summary": "Get case witnesses",
+                "summary": "Export case locations as GeoJSON",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Filter by priority (low, medium, high, critical)",
+                        "name": "priority",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by SLA status (on_track, at_risk, breached, met)",
+                        "name": "slaStatus",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by case type",
+                        "name": "caseType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bounding box as minLon,minLat,maxLon,maxLat",
+                        "name": "bbox",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Centre point as lat,lon for a radius search",
+                        "name": "near",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "default": 5,
+                        "description": "Radius in km around near",
+                        "name": "radius",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
-                        "description": "Case ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "default": 1000,
+                        "description": "Maximum number of features",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.Witness"
-                            }
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.GeoJSONFeatureCollection"
                         }
                     },
                     "400": {
-                        "description": "Invalid case ID",
+                        "description": "Invalid filter",
                         "schema": {
                             "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
                         }
@@ -842,14 +1045,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/evidence/image": {
+        "/cases/import": {
             "post": {
                 "security": [
                     {
                         "BasicAuth": []
                     }
                 ],
-                "description": "Upload an image as evidence for a case",
+                "description": "Recreate a case from a bundle exported by this or another system. The manifest signature and the hash of every file are verified first. The case gets a new reference number and new IDs; records by users unknown here are attributed to you. Your clearance must cover the case's authorization level.",
                 "consumes": [
                     "multipart/form-data"
                 ],
@@ -857,27 +1060,15 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "evidence"
+                    "cases",
+                    "bundles"
                 ],
-                "summary": "Upload image evidence",
+                "summary": "Import a case bundle",
                 "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Case ID",
-                        "name": "caseId",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Optional remarks about the evidence",
-                        "name": "remarks",
-                        "in": "formData"
-                    },
                     {
                         "type": "file",
-                        "description": "Image file",
-                        "name": "image",
+                        "description": "Case bundle (zip)",
+                        "name": "bundle",
                         "in": "formData",
                         "required": true
                     }
@@ -886,17 +1077,17 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.Evidence"
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.Case"
                         }
                     },
                     "400": {
-                        "description": "Invalid request or not an image",
+                        "description": "Missing bundle, bad signature, hash mismatch or unsupported version",
                         "schema": {
                             "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
                         }
                     },
-                    "401": {
-                        "description": "Authentication required",
+                    "403": {
+                        "description": "Insufficient clearance",
                         "schema": {
                             "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
                         }
@@ -910,14 +1101,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/evidence/text": {
-            "post": {
+        "/cases/{id}": {
+            "get": {
                 "security": [
                     {
                         "BasicAuth": []
                     }
                 ],
-                "description": "Add textual evidence to a case",
+                "description": "Retrieve detailed information about a specific case",
                 "consumes": [
                     "application/json"
                 ],
@@ -925,56 +1116,66 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "evidence"
+                    "cases"
                 ],
-                "summary": "Create text evidence",
+                "summary": "Get case details",
                 "parameters": [
                     {
-                        "description": "Text evidence details",
-                        "name": "evidence",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.CreateTextEvidenceDTO"
-                        }
+                        "type": "string",
+                        "description": "Case ID or reference number",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "default": true,
+                        "description": "Redirect merged cases to the surviving case",
+                        "name": "redirect",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.Evidence"
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.Case"
                         }
                     },
-                    "400": {
-                        "description": "Invalid evidence data",
+                    "301": {
+                        "description": "Case was merged into another case",
                         "schema": {
-                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                            "type": "object",
+                            "additionalProperties": true
                         }
                     },
-                    "401": {
-                        "description": "Authentication required",
+                    "400": {
+                        "description": "Invalid case ID",
                         "schema": {
-                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
-                    "500": {
-                        "description": "Server error",
+                    "404": {
+                        "description": "Case not found",
                         "schema": {
-                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     }
                 }
-            }
-        },
-        "/evidence/{id}": {
-            "get": {
+            },
+            "put": {
                 "security": [
                     {
                         "BasicAuth": []
                     }
                 ],
-                "description": "Retrieve details of a specific evidence item",
+                "description": "Replace the editable fields of a case. Only columns whose value changed are written. Send the ETag from a previous read in If-Match to fail with 412 if someone else changed the case meanwhile.",
                 "consumes": [
                     "application/json"
                 ],
@@ -982,46 +1183,88 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "evidence"
+                    "cases"
                 ],
-                "summary": "Get evidence details",
+                "summary": "Update an existing case",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Evidence ID",
+                        "type": "string",
+                        "description": "Case ID or reference number",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the case version being edited",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Updated case details",
+                        "name": "case",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.CaseDTO"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.Evidence"
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.Case"
                         }
                     },
                     "400": {
-                        "description": "Invalid evidence ID",
+                        "description": "Invalid case data",
                         "schema": {
-                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "404": {
-                        "description": "Evidence not found",
+                        "description": "Case not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Case changed during the update",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "412": {
+                        "description": "Case has been modified since it was read",
                         "schema": {
                             "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
                         }
                     }
                 }
             },
-            "put": {
+            "patch": {
                 "security": [
                     {
                         "BasicAuth": []
                     }
                 ],
-                "description": "Update evidence remarks",
+                "description": "Update only the supplied fields of a case. Send the ETag from a previous read in If-Match to fail with 412 if someone else changed the case meanwhile.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1029,24 +1272,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "evidence"
+                    "cases"
                 ],
-                "summary": "Update evidence",
+                "summary": "Partially update a case",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Evidence ID",
+                        "type": "string",
+                        "description": "Case ID or reference number",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Updated evidence details",
-                        "name": "evidence",
+                        "type": "string",
+                        "description": "ETag of the case version being edited",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Fields to update",
+                        "name": "case",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.UpdateEvidenceDTO"
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.CaseUpdateDTO"
                         }
                     }
                 ],
@@ -1054,36 +1303,50 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.Evidence"
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.Case"
                         }
                     },
                     "400": {
-                        "description": "Invalid evidence ID or data",
+                        "description": "Invalid case data",
                         "schema": {
                             "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
                         }
                     },
-                    "401": {
-                        "description": "Authentication required",
+                    "403": {
+                        "description": "Permission denied",
                         "schema": {
                             "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
                         }
                     },
-                    "500": {
-                        "description": "Server error",
+                    "404": {
+                        "description": "Case not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "409": {
+                        "description": "Case changed during the update",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "412": {
+                        "description": "Case has been modified since it was read",
                         "schema": {
                             "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/cases/{id}/assignees": {
+            "get": {
                 "security": [
                     {
                         "BasicAuth": []
                     }
                 ],
-                "description": "Mark evidence as deleted (soft delete)",
+                "description": "Retrieve list of users assigned to a case with their assignment role (lead, support or reviewer), lead first",
                 "consumes": [
                     "application/json"
                 ],
@@ -1091,13 +1354,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "evidence"
+                    "cases",
+                    "assignees"
                 ],
-                "summary": "Soft delete evidence",
+                "summary": "Get case assignees",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Evidence ID",
+                        "type": "string",
+                        "description": "Case ID or reference number",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1105,43 +1369,41 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success message",
+                        "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_repository.Assignment"
                             }
                         }
                     },
                     "400": {
-                        "description": "Invalid evidence ID",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
-                        }
-                    },
-                    "401": {
-                        "description": "Authentication required",
+                        "description": "Invalid case ID",
                         "schema": {
-                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "500": {
                         "description": "Server error",
                         "schema": {
-                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     }
                 }
-            }
-        },
-        "/evidence/{id}/audit": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "BasicAuth": []
                     }
                 ],
-                "description": "Retrieve audit logs for a specific evidence item",
+                "description": "Assign a user to a case as lead, support (the default) or reviewer. Assigning someone already on the case changes their role. A case has at most one lead.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1149,86 +1411,118 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "evidence",
-                    "audit"
+                    "cases",
+                    "assignees"
                 ],
-                "summary": "Get evidence audit logs",
+                "summary": "Add assignee to case",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Evidence ID",
+                        "type": "string",
+                        "description": "Case ID or reference number",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "User ID and role to assign",
+                        "name": "assignee",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.AssigneeDTO"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Success message",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.AuditLog"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
                             }
                         }
                     },
                     "400": {
-                        "description": "Invalid evidence ID",
+                        "description": "Invalid case ID or assignee data",
                         "schema": {
                             "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
                         }
                     },
-                    "500": {
-                        "description": "Server error",
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Case or user not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "409": {
+                        "description": "Case already has a lead",
                         "schema": {
                             "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
                         }
                     }
                 }
-            }
-        },
-        "/evidence/{id}/image": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BasicAuth": []
                     }
                 ],
-                "description": "Stream an evidence image file",
+                "description": "Unassign a user from a case",
                 "consumes": [
                     "application/json"
                 ],
                 "produces": [
-                    "image/*"
+                    "application/json"
                 ],
                 "tags": [
-                    "evidence"
+                    "cases",
+                    "assignees"
                 ],
-                "summary": "Get evidence image",
+                "summary": "Remove assignee from case",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Evidence ID",
+                        "type": "string",
+                        "description": "Case ID or reference number",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "User ID to unassign",
+                        "name": "assignee",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.AssigneeDTO"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Image file",
+                        "description": "Success message",
                         "schema": {
-                            "type": "file"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "400": {
-                        "description": "Invalid evidence ID",
+                        "description": "Invalid case ID or assignee data",
                         "schema": {
                             "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
                         }
                     },
-                    "500": {
-                        "description": "Server error or not an image",
+                    "403": {
+                        "description": "Permission denied",
                         "schema": {
                             "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
                         }
@@ -1236,60 +1530,52 @@ const docTemplate = `{
                 }
             }
         },
-        "/evidence/{id}/permanent": {
-            "delete": {
+        "/cases/{id}/bundle": {
+            "get": {
                 "security": [
                     {
                         "BasicAuth": []
                     }
                 ],
-                "description": "Permanently delete evidence (requires confirmation)",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Download a case as a signed zip bundle that another system can import: JSON for the case, people, evidence metadata, comments and audit trail, the evidence images, and a manifest with the SHA-256 of every file, signed with the shared bundle key",
                 "produces": [
-                    "application/json"
+                    "application/zip"
                 ],
                 "tags": [
-                    "evidence"
+                    "cases",
+                    "bundles"
                 ],
-                "summary": "Permanently delete evidence",
+                "summary": "Export a case bundle",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Evidence ID",
+                        "type": "string",
+                        "description": "Case ID or reference number",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Deletion confirmation",
-                        "name": "confirmation",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.DeleteConfirmationDTO"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Success message",
+                        "description": "Case bundle",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "type": "file"
                         }
                     },
                     "400": {
-                        "description": "Invalid evidence ID or missing confirmation",
+                        "description": "Invalid case ID",
                         "schema": {
                             "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
                         }
                     },
-                    "401": {
-                        "description": "Authentication required",
+                    "403": {
+                        "description": "Insufficient clearance",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Case not found",
                         "schema": {
                             "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
                         }
@@ -1303,9 +1589,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/login": {
+        "/cases/{id}/close": {
             "post": {
-                "description": "Authenticate user and return JWT token",
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Close a case with a disposition and closing summary. Every checklist item must be confirmed, the case must have no open tasks and solved_arrest needs an arrested suspect. When supervisor approval is required the closure waits for approval and the case stays open (202). Officers must be assigned to the case.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1313,52 +1604,88 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "cases",
+                    "closures"
                 ],
-                "summary": "User login",
+                "summary": "Close a case",
                 "parameters": [
                     {
-                        "description": "Login Credentials",
-                        "name": "credentials",
+                        "type": "string",
+                        "description": "Case ID or reference number",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the case version being closed",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Disposition, closing summary and checklist",
+                        "name": "closure",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.LoginDTO"
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.CloseCaseDTO"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "token and user information",
+                    "201": {
+                        "description": "Case closed",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": true
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ClosureResponseDTO"
+                        }
+                    },
+                    "202": {
+                        "description": "Closure waiting for approval",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ClosureResponseDTO"
                         }
                     },
                     "400": {
-                        "description": "Invalid login request",
+                        "description": "Invalid closure or incomplete checklist",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
                         }
                     },
-                    "401": {
-                        "description": "Invalid credentials",
+                    "403": {
+                        "description": "Permission denied",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Case not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "409": {
+                        "description": "Case is closed, has open tasks or a closure is already pending",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "412": {
+                        "description": "Case has been modified since it was read",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
                         }
                     }
                 }
             }
         },
-        "/public/reports": {
-            "post": {
-                "description": "Public endpoint to submit a crime report",
+        "/cases/{id}/closure": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Get the evidence, open tasks and suspects the closure checklist covers, the dispositions a case can be closed with, whether your closure needs a supervisor's approval and any closure waiting for approval",
                 "consumes": [
                     "application/json"
                 ],
@@ -1366,55 +1693,55 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "public",
-                    "reports"
+                    "cases",
+                    "closures"
                 ],
-                "summary": "Submit a crime report",
+                "summary": "Check whether a case can be closed",
                 "parameters": [
                     {
-                        "description": "Crime report details",
-                        "name": "report",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ReportDTO"
-                        }
+                        "type": "string",
+                        "description": "Case ID or reference number",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "reportId",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "integer"
-                            }
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ClosureCheckDTO"
                         }
                     },
                     "400": {
-                        "description": "Invalid report data",
+                        "description": "Invalid case ID",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
                         }
                     },
-                    "500": {
-                        "description": "Server error",
+                    "403": {
+                        "description": "Insufficient clearance",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Case not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
                         }
                     }
                 }
             }
         },
-        "/public/reports/{reportId}/status": {
+        "/cases/{id}/closures": {
             "get": {
-                "description": "Public endpoint to check a case status using report ID",
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Get the closure history of a case, including closures that were rejected, newest first",
                 "consumes": [
                     "application/json"
                 ],
@@ -1422,67 +1749,58 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "public",
-                    "reports"
+                    "cases",
+                    "closures"
                 ],
-                "summary": "Get case status by report ID",
+                "summary": "List the closures of a case",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Report ID",
-                        "name": "reportId",
+                        "description": "Case ID or reference number",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Report ID and status",
+                        "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ClosureResponseDTO"
                             }
                         }
                     },
                     "400": {
-                        "description": "Invalid Report ID",
+                        "description": "Invalid case ID",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
                         }
                     },
-                    "404": {
-                        "description": "Report not found",
+                    "403": {
+                        "description": "Insufficient clearance",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
                         }
                     },
-                    "500": {
-                        "description": "Server error",
+                    "404": {
+                        "description": "Case not found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
                         }
                     }
                 }
             }
         },
-        "/users": {
+        "/cases/{id}/comments": {
             "get": {
                 "security": [
                     {
                         "BasicAuth": []
                     }
                 ],
-                "description": "Admin endpoint to get a paginated list of users",
+                "description": "Get a paginated list of comment threads on a case, newest first, each with its replies",
                 "consumes": [
                     "application/json"
                 ],
@@ -1490,10 +1808,18 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "cases",
+                    "comments"
                 ],
-                "summary": "List all users",
+                "summary": "List case comments",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Case ID or reference number",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "type": "integer",
                         "default": 0,
@@ -1504,21 +1830,33 @@ const docTemplate = `{
                     {
                         "type": "integer",
                         "default": 10,
-                        "description": "Items per page",
+                        "description": "Threads per page",
                         "name": "limit",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "users and total count",
+                        "description": "comments and total count",
                         "schema": {
                             "type": "object",
                             "additionalProperties": true
                         }
                     },
+                    "400": {
+                        "description": "Invalid case ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
                     "403": {
-                        "description": "Permission denied",
+                        "description": "Insufficient clearance level",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Case not found",
                         "schema": {
                             "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
                         }
@@ -1531,7 +1869,7 @@ const docTemplate = `{
                         "BasicAuth": []
                     }
                 ],
-                "description": "Admin endpoint to create a new user with specified role and clearance",
+                "description": "Post a comment or a reply on a case. Users mentioned with @username are notified if they are cleared for the case.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1539,17 +1877,25 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "cases",
+                    "comments"
                 ],
-                "summary": "Create a new user",
+                "summary": "Add a comment to a case",
                 "parameters": [
                     {
-                        "description": "User details",
-                        "name": "user",
+                        "type": "string",
+                        "description": "Case ID or reference number",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Comment content",
+                        "name": "comment",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.UserDTO"
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.CreateCommentDTO"
                         }
                     }
                 ],
@@ -1557,17 +1903,23 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.User"
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.CommentResponseDTO"
                         }
                     },
                     "400": {
-                        "description": "Invalid user data",
+                        "description": "Invalid comment data",
                         "schema": {
                             "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
                         }
                     },
                     "403": {
-                        "description": "Permission denied",
+                        "description": "Insufficient clearance level",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Case or parent comment not found",
                         "schema": {
                             "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
                         }
@@ -1575,14 +1927,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{id}": {
+        "/cases/{id}/evidence": {
             "get": {
                 "security": [
                     {
                         "BasicAuth": []
                     }
                 ],
-                "description": "Admin endpoint to get details of a specific user",
+                "description": "Retrieve all evidence related to a case",
                 "consumes": [
                     "application/json"
                 ],
@@ -1590,52 +1942,65 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "cases",
+                    "evidence"
                 ],
-                "summary": "Get user details",
+                "summary": "Get case evidence",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "User ID",
+                        "type": "string",
+                        "description": "Case ID or reference number",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated tag names to filter by",
+                        "name": "tags",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "all",
+                        "description": "Match all tags (all) or any tag (any)",
+                        "name": "tagMatch",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.User"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.Evidence"
+                            }
                         }
                     },
                     "400": {
-                        "description": "Invalid user ID",
-                        "schema": {
-                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
-                        }
-                    },
-                    "403": {
-                        "description": "Permission denied",
+                        "description": "Invalid case ID or unknown tag",
                         "schema": {
                             "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
                         }
                     },
-                    "404": {
-                        "description": "User not found",
+                    "500": {
+                        "description": "Server error",
                         "schema": {
                             "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/cases/{id}/handovers": {
+            "get": {
                 "security": [
                     {
                         "BasicAuth": []
                     }
                 ],
-                "description": "Admin endpoint to update user details including role and clearance",
+                "description": "Get the handover history of a case, newest first",
                 "consumes": [
                     "application/json"
                 ],
@@ -1643,61 +2008,56 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "cases",
+                    "handovers"
                 ],
-                "summary": "Update an existing user",
+                "summary": "List case handovers",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "User ID",
+                        "type": "string",
+                        "description": "Case ID or reference number",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Updated user details",
-                        "name": "user",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.UpdateUserDTO"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.User"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.HandoverResponseDTO"
+                            }
                         }
                     },
                     "400": {
-                        "description": "Invalid user data",
+                        "description": "Invalid case ID",
                         "schema": {
                             "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
                         }
                     },
                     "403": {
-                        "description": "Permission denied",
+                        "description": "Insufficient clearance level",
                         "schema": {
                             "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
                         }
                     },
                     "404": {
-                        "description": "User not found",
+                        "description": "Case not found",
                         "schema": {
                             "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
                         }
                     }
                 }
             },
-            "delete": {
+            "post": {
                 "security": [
                     {
                         "BasicAuth": []
                     }
                 ],
-                "description": "Admin endpoint to delete a user",
+                "description": "Ask an investigator to take over your assignment on a case, with a handover note. You stay assigned until they accept. Admins may hand over someone else's assignment with fromUserId.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1705,183 +2065,7716 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "users"
+                    "cases",
+                    "handovers"
                 ],
-                "summary": "Delete a user",
+                "summary": "Request a case handover",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "User ID",
+                        "type": "string",
+                        "description": "Case ID or reference number",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "Success message",
+                    },
+                    {
+                        "description": "Incoming investigator and handover note",
+                        "name": "handover",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.CreateHandoverDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.HandoverResponseDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid handover data",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Case or user not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "409": {
+                        "description": "A handover is already pending",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/cases/{id}/links": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Extract URLs from the case description text",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cases"
+                ],
+                "summary": "Extract links from case description",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Case ID or reference number",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Extracted links",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
-                                "type": "string"
+                                "type": "array",
+                                "items": {
+                                    "type": "string"
+                                }
                             }
                         }
                     },
                     "400": {
-                        "description": "Invalid user ID",
+                        "description": "Invalid case ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/cases/{id}/merge": {
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Move evidence, suspects, victims, witnesses, comments, tasks, tags, assignees, watchers and linked reports into the surviving case. The merged case is closed and redirects to the surviving case; its pending handovers, closure and reopen requests are settled. The surviving case must have at least the authorization level of the merged case, and moved assignees who are not cleared for it are reconciled.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cases",
+                    "links"
+                ],
+                "summary": "Merge a case into another case",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "ID or reference number of the case to merge",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Surviving case",
+                        "name": "merge",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.MergeCaseDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.Case"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid merge data",
                         "schema": {
                             "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
                         }
                     },
                     "403": {
-                        "description": "Permission denied",
+                        "description": "Insufficient clearance level",
                         "schema": {
                             "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
                         }
                     },
                     "404": {
-                        "description": "User not found",
+                        "description": "Case not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "409": {
+                        "description": "Case already merged, or the surviving case has a lower authorization level",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/cases/{id}/reconciliation": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Dry run: list the assignees that would be flagged, removed or restored if the case were moved to the given authorization level. Nothing is changed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cases",
+                    "assignees"
+                ],
+                "summary": "Preview an authorization level change",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Case ID or reference number",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Proposed authorization level (low, medium, high, critical), defaults to the current one",
+                        "name": "authorizationLevel",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ReconciliationReportDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid case ID or authorization level",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient clearance level",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Case not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Flag or remove (depending on CASE_RECONCILE_MODE) assignees who are not cleared for the case's authorization level, restore flagged ones who are cleared again, notify the case lead and record the changes in the case history. This also runs automatically when the authorization level changes.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cases",
+                    "assignees"
+                ],
+                "summary": "Reconcile case assignees",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Case ID or reference number",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ReconciliationReportDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid case ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient clearance level",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Case not found",
                         "schema": {
                             "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "github_com_m7medVision_crime-management-system_internal_dto.AssigneeDTO": {
+        },
+        "/cases/{id}/related": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Retrieve all cases linked to a case, with the link type seen from this case",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cases",
+                    "links"
+                ],
+                "summary": "List related cases",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Case ID or reference number",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.RelatedCaseResponseDTO"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid case ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient clearance level",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Case not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Create a typed link (related, duplicate_of, duplicated_by, parent_of, child_of, same_suspect) from this case to another case",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cases",
+                    "links"
+                ],
+                "summary": "Link two cases",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Case ID or reference number",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Link details",
+                        "name": "link",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.CaseLinkDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.CaseLink"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid link data",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient clearance level",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Case not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "409": {
+                        "description": "Cases are already linked",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/cases/{id}/related/{linkId}": {
+            "delete": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Remove a link between this case and another case",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cases",
+                    "links"
+                ],
+                "summary": "Remove a case link",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Case ID or reference number",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Link ID",
+                        "name": "linkId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid case or link ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient clearance level",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Link not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/cases/{id}/reopen": {
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Move a closed case back to ongoing with a reason and restore its archived evidence. Cases closed longer than the configured period wait for a supervisor's approval unless a supervisor reopens them (202). Officers must be assigned to the case.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cases",
+                    "reopens"
+                ],
+                "summary": "Reopen a closed case",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Case ID or reference number",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the case version being reopened",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Reason for reopening",
+                        "name": "reopen",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ReopenCaseDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Case reopened",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ReopenResponseDTO"
+                        }
+                    },
+                    "202": {
+                        "description": "Reopen request waiting for approval",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ReopenResponseDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid case ID or missing reason",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Case not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "409": {
+                        "description": "Case is not closed or a reopen request is already pending",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "412": {
+                        "description": "Case has been modified since it was read",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/cases/{id}/reopens": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Get the reopen history of a case, including requests that were rejected, newest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cases",
+                    "reopens"
+                ],
+                "summary": "List the reopen requests of a case",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Case ID or reference number",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ReopenResponseDTO"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid case ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient clearance",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Case not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/cases/{id}/report": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Generate a PDF report for a case with all details",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/pdf"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Generate case report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Case ID or reference number",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "PDF report file",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid case ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/cases/{id}/status": {
+            "patch": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Update the status of a case (pending, ongoing). Cases are closed with a disposition through POST /cases/{id}/close and reopened with a reason through POST /cases/{id}/reopen.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cases"
+                ],
+                "summary": "Update case status",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Case ID or reference number",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the case version being edited",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "New case status",
+                        "name": "status",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.StatusUpdateDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.Case"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid case ID or status",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Authentication required",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Case not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Case changed during the update",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "412": {
+                        "description": "Case has been modified since it was read",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/cases/{id}/suspects": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Retrieve all suspects related to a case",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cases",
+                    "suspects"
+                ],
+                "summary": "Get case suspects",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Case ID or reference number",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated tag names to filter by",
+                        "name": "tags",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "all",
+                        "description": "Match all tags (all) or any tag (any)",
+                        "name": "tagMatch",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.Suspect"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid case ID or unknown tag",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Add a suspect to a case. firstName and lastName are required; besides the common fields a suspect has description and isArrested. Officers must be assigned to the case, and the people of a closed case cannot be changed until it is reopened.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cases",
+                    "suspects"
+                ],
+                "summary": "Add a suspect",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Case ID or reference number",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Suspect details",
+                        "name": "suspect",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.PersonDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.Suspect"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid suspect data",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Case not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "409": {
+                        "description": "Case is closed",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/cases/{id}/suspects/{personId}": {
+            "put": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Change the given fields of a suspect; omitted fields are left unchanged. Every changed field is recorded in the case history.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cases",
+                    "suspects"
+                ],
+                "summary": "Update a suspect",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Case ID or reference number",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Suspect ID",
+                        "name": "personId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to change",
+                        "name": "suspect",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.PersonDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.Suspect"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid suspect data",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Case or suspect not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "409": {
+                        "description": "Case is closed",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Remove a suspect from a case",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cases",
+                    "suspects"
+                ],
+                "summary": "Remove a suspect",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Case ID or reference number",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Suspect ID",
+                        "name": "personId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid case or suspect ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Case or suspect not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "409": {
+                        "description": "Case is closed",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/cases/{id}/tasks": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Retrieve the tasks of a case, optionally filtered by status or overdue state",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cases",
+                    "tasks"
+                ],
+                "summary": "List case tasks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Case ID or reference number",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Task status (open, in_progress, done, cancelled)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only overdue tasks",
+                        "name": "overdue",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.TaskResponseDTO"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid case ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient clearance level",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Case not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Create an action item on a case with optional assignee, due date and priority",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cases",
+                    "tasks"
+                ],
+                "summary": "Create a case task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Case ID or reference number",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Task details",
+                        "name": "task",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.CreateTaskDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.TaskResponseDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid task data",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient clearance level",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Case not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/cases/{id}/timeline": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Get a chronological, paginated feed of everything that happened on a case: status changes, assignments, evidence, people, comments, report links and more. Raw before/after values are only included for admins.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cases"
+                ],
+                "summary": "Get case activity timeline",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Case ID or reference number",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated event types to include (e.g. status_changed,evidence_added)",
+                        "name": "types",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Only include events by this user ID",
+                        "name": "actor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only include events at or after this time (RFC3339)",
+                        "name": "since",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only include events at or before this time (RFC3339)",
+                        "name": "until",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "desc",
+                        "description": "Sort order: desc (newest first) or asc",
+                        "name": "order",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Pagination offset",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "events and total count",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid filter",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient clearance level",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Case not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/cases/{id}/victims": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Retrieve all victims related to a case",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cases",
+                    "victims"
+                ],
+                "summary": "Get case victims",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Case ID or reference number",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated tag names to filter by",
+                        "name": "tags",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "all",
+                        "description": "Match all tags (all) or any tag (any)",
+                        "name": "tagMatch",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.Victim"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid case ID or unknown tag",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Add a victim to a case. firstName and lastName are required; besides the common fields a victim has injuryDescription. Officers must be assigned to the case, and the people of a closed case cannot be changed until it is reopened.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cases",
+                    "victims"
+                ],
+                "summary": "Add a victim",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Case ID or reference number",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Victim details",
+                        "name": "victim",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.PersonDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.Victim"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid victim data",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Case not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "409": {
+                        "description": "Case is closed",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/cases/{id}/victims/{personId}": {
+            "put": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Change the given fields of a victim; omitted fields are left unchanged. Every changed field is recorded in the case history.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cases",
+                    "victims"
+                ],
+                "summary": "Update a victim",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Case ID or reference number",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Victim ID",
+                        "name": "personId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to change",
+                        "name": "victim",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.PersonDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.Victim"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid victim data",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Case or victim not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "409": {
+                        "description": "Case is closed",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Remove a victim from a case",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cases",
+                    "victims"
+                ],
+                "summary": "Remove a victim",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Case ID or reference number",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Victim ID",
+                        "name": "personId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid case or victim ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Case or victim not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "409": {
+                        "description": "Case is closed",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/cases/{id}/watch": {
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Follow a case without being assigned to it. Watchers are notified of status changes, new evidence, new comments and new assignees.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cases",
+                    "notifications"
+                ],
+                "summary": "Watch a case",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Case ID or reference number",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid case ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient clearance level",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Case not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Stop following a case",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cases",
+                    "notifications"
+                ],
+                "summary": "Stop watching a case",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Case ID or reference number",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid case ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/cases/{id}/watchers": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Get the users watching a case",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cases",
+                    "notifications"
+                ],
+                "summary": "List case watchers",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Case ID or reference number",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.WatcherResponseDTO"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid case ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient clearance level",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Case not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/cases/{id}/witnesses": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Retrieve all witnesses related to a case",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cases",
+                    "witnesses"
+                ],
+                "summary": "Get case witnesses",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Case ID or reference number",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated tag names to filter by",
+                        "name": "tags",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "default": "all",
+                        "description": "Match all tags (all) or any tag (any)",
+                        "name": "tagMatch",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.Witness"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid case ID or unknown tag",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Add a witness to a case. firstName and lastName are required; besides the common fields a witness has statement. Officers must be assigned to the case, and the people of a closed case cannot be changed until it is reopened.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cases",
+                    "witnesses"
+                ],
+                "summary": "Add a witness",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Case ID or reference number",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Witness details",
+                        "name": "witness",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.PersonDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.Witness"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid witness data",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Case not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "409": {
+                        "description": "Case is closed",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/cases/{id}/witnesses/{personId}": {
+            "put": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Change the given fields of a witness; omitted fields are left unchanged. Every changed field is recorded in the case history.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cases",
+                    "witnesses"
+                ],
+                "summary": "Update a witness",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Case ID or reference number",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Witness ID",
+                        "name": "personId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to change",
+                        "name": "witness",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.PersonDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.Witness"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid witness data",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Case or witness not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "409": {
+                        "description": "Case is closed",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Remove a witness from a case",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cases",
+                    "witnesses"
+                ],
+                "summary": "Remove a witness",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Case ID or reference number",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Witness ID",
+                        "name": "personId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid case or witness ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Case or witness not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "409": {
+                        "description": "Case is closed",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/closures/pending": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Get the closures waiting for a supervisor's approval on cases within your clearance, oldest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "closures"
+                ],
+                "summary": "List closures waiting for approval",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ClosureResponseDTO"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Supervisor access required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/closures/{id}/approve": {
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Close the case of a pending closure with its disposition. The checks are run again, since the case may have changed while the closure was waiting.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "closures"
+                ],
+                "summary": "Approve a closure",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Closure ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Optional response to the requester",
+                        "name": "decision",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ClosureDecisionDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ClosureResponseDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid closure ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Supervisor access required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Closure not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "409": {
+                        "description": "Closure is no longer pending or the case cannot be closed",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/closures/{id}/reject": {
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Turn down a pending closure with a reason. The case stays open.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "closures"
+                ],
+                "summary": "Reject a closure",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Closure ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reason for the rejection",
+                        "name": "decision",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ClosureDecisionDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ClosureResponseDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid closure ID or missing reason",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Supervisor access required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Closure not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "409": {
+                        "description": "Closure is no longer pending",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/comments/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Edit a comment's content. Only the author may edit; the previous content is kept in the edit history.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "comments"
+                ],
+                "summary": "Edit a comment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Comment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New content",
+                        "name": "comment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.UpdateCommentDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.CommentResponseDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid comment data",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Comment not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Soft delete a comment. Only the author or an admin may delete; replies remain in the thread.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "comments"
+                ],
+                "summary": "Delete a comment",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Comment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid comment ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Comment not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/comments/{id}/history": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Retrieve the previous versions of a comment, oldest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "comments"
+                ],
+                "summary": "Get comment edit history",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Comment ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.CommentRevisionDTO"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid comment ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient clearance level",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Comment not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/custom-field-schemas": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Get the custom fields defined for each case type",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "custom-fields"
+                ],
+                "summary": "List custom field schemas",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.CustomFieldSchemaResponseDTO"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Define the structured fields cases of a type carry. Existing case values are validated against the new schema when the case is next updated.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "custom-fields"
+                ],
+                "summary": "Create or replace the custom fields of a case type",
+                "parameters": [
+                    {
+                        "description": "Custom field schema",
+                        "name": "schema",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.CustomFieldSchemaDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.CustomFieldSchemaResponseDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid schema",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/custom-field-schemas/{caseType}": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Get the custom field schema that case values of this type are validated against",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "custom-fields"
+                ],
+                "summary": "Get the custom fields of a case type",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Case type",
+                        "name": "caseType",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.CustomFieldSchemaResponseDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "No custom fields defined for the case type",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Remove a case type's custom field schema. Values already stored on cases are kept.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "custom-fields"
+                ],
+                "summary": "Delete the custom fields of a case type",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Case type",
+                        "name": "caseType",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "No custom fields defined for the case type",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/evidence/image": {
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Upload an image as evidence for a case",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "evidence"
+                ],
+                "summary": "Upload image evidence",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Case ID or reference number",
+                        "name": "caseId",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Optional remarks about the evidence",
+                        "name": "remarks",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "file",
+                        "description": "Image file",
+                        "name": "image",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.Evidence"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request or not an image",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Authentication required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/evidence/text": {
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Add textual evidence to a case",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "evidence"
+                ],
+                "summary": "Create text evidence",
+                "parameters": [
+                    {
+                        "description": "Text evidence details",
+                        "name": "evidence",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.CreateTextEvidenceDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.Evidence"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid evidence data",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Authentication required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/evidence/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Retrieve details of a specific evidence item",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "evidence"
+                ],
+                "summary": "Get evidence details",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Evidence ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.Evidence"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid evidence ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Evidence not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Update evidence remarks. Omitted remarks are left unchanged. Send the ETag from a previous read in If-Match to fail with 412 if someone else changed the evidence meanwhile.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "evidence"
+                ],
+                "summary": "Update evidence",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Evidence ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the evidence version being edited",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Updated evidence details",
+                        "name": "evidence",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.UpdateEvidenceDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.Evidence"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid evidence ID or data",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Authentication required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Evidence not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "409": {
+                        "description": "Evidence changed during the update or is archived with its closed case",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "412": {
+                        "description": "Evidence has been modified since it was read",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Mark evidence as deleted (soft delete)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "evidence"
+                ],
+                "summary": "Soft delete evidence",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Evidence ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid evidence ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Authentication required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "409": {
+                        "description": "Evidence is archived with its closed case",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Update evidence remarks. Omitted remarks are left unchanged. Send the ETag from a previous read in If-Match to fail with 412 if someone else changed the evidence meanwhile.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "evidence"
+                ],
+                "summary": "Update evidence",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Evidence ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the evidence version being edited",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Updated evidence details",
+                        "name": "evidence",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.UpdateEvidenceDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.Evidence"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid evidence ID or data",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Authentication required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Evidence not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "409": {
+                        "description": "Evidence changed during the update or is archived with its closed case",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "412": {
+                        "description": "Evidence has been modified since it was read",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/evidence/{id}/audit": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Retrieve audit logs for a specific evidence item",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "evidence",
+                    "audit"
+                ],
+                "summary": "Get evidence audit logs",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Evidence ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.AuditLog"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid evidence ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/evidence/{id}/image": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Stream an evidence image file",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "image/*"
+                ],
+                "tags": [
+                    "evidence"
+                ],
+                "summary": "Get evidence image",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Evidence ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Image file",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid evidence ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error or not an image",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/evidence/{id}/permanent": {
+            "delete": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Permanently delete evidence (requires confirmation)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "evidence"
+                ],
+                "summary": "Permanently delete evidence",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Evidence ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Deletion confirmation",
+                        "name": "confirmation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.DeleteConfirmationDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid evidence ID or missing confirmation",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Authentication required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/handovers/{id}/accept": {
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Take over the assignment described by a pending handover. The outgoing user is unassigned and the acceptance is recorded in the case history.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "handovers"
+                ],
+                "summary": "Accept a handover",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Handover ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Optional response",
+                        "name": "decision",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.HandoverDecisionDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.HandoverResponseDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid handover ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Only the incoming investigator can accept",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Handover not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "409": {
+                        "description": "Handover is no longer pending",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/handovers/{id}/cancel": {
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Withdraw a pending handover. Allowed for the outgoing user, whoever requested it and admins.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "handovers"
+                ],
+                "summary": "Cancel a handover",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Handover ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.HandoverResponseDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid handover ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Handover not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "409": {
+                        "description": "Handover is no longer pending",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/handovers/{id}/decline": {
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Refuse a pending handover with a reason. The outgoing user stays assigned.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "handovers"
+                ],
+                "summary": "Decline a handover",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Handover ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reason for declining",
+                        "name": "decision",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.HandoverDecisionDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.HandoverResponseDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid handover ID or missing reason",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Only the incoming investigator can decline",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Handover not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "409": {
+                        "description": "Handover is no longer pending",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/login": {
+            "post": {
+                "description": "Authenticate user and return JWT token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "User login",
+                "parameters": [
+                    {
+                        "description": "Login Credentials",
+                        "name": "credentials",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.LoginDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "token and user information",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid login request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Invalid credentials",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/me/dashboard": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Summary of the current user's workload: assigned cases by status, cases assigned in the last 7 days, overdue tasks, cases in breach of SLA, recent evidence on assigned cases and untriaged public reports in the user's area with their likely duplicates. Results are cached for a short time.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "me"
+                ],
+                "summary": "Get my dashboard",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "default": false,
+                        "description": "Bypass the cache",
+                        "name": "refresh",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.DashboardDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Authentication required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/handovers": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Get the handovers you are handing over or asked to accept, newest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "handovers"
+                ],
+                "summary": "List my handovers",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "incoming or outgoing, both when omitted",
+                        "name": "direction",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "pending (default), accepted, declined, cancelled or all",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.HandoverResponseDTO"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid filter",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/handovers/all": {
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Request a handover to one investigator for every open case you are assigned to. Each case is handled separately and reported in the result.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "handovers"
+                ],
+                "summary": "Hand over all my cases",
+                "parameters": [
+                    {
+                        "description": "Incoming investigator and handover note",
+                        "name": "handover",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.HandOverAllDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.HandoverBatchResultDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid handover data",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/notification-preferences": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Get how the current user receives each notification type. Types without a saved preference are delivered in-app only.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "Get my notification preferences",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.NotificationPreferenceDTO"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Authentication required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Choose in-app and email delivery per notification type. Types that are not listed keep their current setting.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "Update my notification preferences",
+                "parameters": [
+                    {
+                        "description": "Preferences per notification type",
+                        "name": "preferences",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.UpdateNotificationPreferencesDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.NotificationPreferenceDTO"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid preferences",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Authentication required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/notifications": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Get a paginated list of the current user's notifications, newest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "List my notifications",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Only list unread notifications",
+                        "name": "unread",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Pagination offset",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "notifications, total count and unread count",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Authentication required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/notifications/read-all": {
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Mark every unread notification of the current user as read",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "Mark all notifications as read",
+                "responses": {
+                    "200": {
+                        "description": "Number of notifications marked as read",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "401": {
+                        "description": "Authentication required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/notifications/{id}/read": {
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Mark one of the current user's notifications as read",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "notifications"
+                ],
+                "summary": "Mark a notification as read",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Notification ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid notification ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Notification not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/tasks": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Retrieve the tasks assigned to the current user across all cases",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "List my tasks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Task status (open, in_progress, done, cancelled)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only overdue tasks",
+                        "name": "overdue",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.TaskResponseDTO"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Authentication required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/me/watching": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Get the cases the current user watches, most recently watched first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cases",
+                    "notifications"
+                ],
+                "summary": "List the cases I watch",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.Case"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Authentication required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/public/reports": {
+            "post": {
+                "description": "Public endpoint to submit a crime report",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "public",
+                    "reports"
+                ],
+                "summary": "Submit a crime report",
+                "parameters": [
+                    {
+                        "description": "Crime report details",
+                        "name": "report",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ReportDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "reportId",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "integer"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid report data",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/public/reports/{reportId}/status": {
+            "get": {
+                "description": "Public endpoint to check a case status using report ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "public",
+                    "reports"
+                ],
+                "summary": "Get case status by report ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Report ID",
+                        "name": "reportId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Report ID and status",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid Report ID",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Report not found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/reopens/pending": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Get the reopen requests waiting for a supervisor's approval on cases within your clearance, oldest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reopens"
+                ],
+                "summary": "List reopen requests waiting for approval",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ReopenResponseDTO"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Supervisor access required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/reopens/{id}/approve": {
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Reopen the case of a pending request and restore its archived evidence",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reopens"
+                ],
+                "summary": "Approve a reopen request",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Reopen request ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Optional response to the requester",
+                        "name": "decision",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ReopenDecisionDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ReopenResponseDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid reopen request ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Supervisor access required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Reopen request not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "409": {
+                        "description": "Request is no longer pending or the case is no longer closed",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/reopens/{id}/reject": {
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Turn down a pending reopen request with a reason. The case stays closed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reopens"
+                ],
+                "summary": "Reject a reopen request",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Reopen request ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Reason for the rejection",
+                        "name": "decision",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ReopenDecisionDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ReopenResponseDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid reopen request ID or missing reason",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Supervisor access required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Reopen request not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "409": {
+                        "description": "Request is no longer pending",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/reports/{id}/case": {
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Create a case from a citizen report and link the report to it. The case takes the report's title, text and location; with a template it also gets the template's defaults, checklist tasks and tags, with the description skeleton placed before the report text. Body fields override both. Without a template, caseType and authorizationLevel are required.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports",
+                    "cases"
+                ],
+                "summary": "Open a case for a citizen report",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Report ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Case template ID or name",
+                        "name": "template",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Case fields overriding the report and template",
+                        "name": "case",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.CaseUpdateDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.Case"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid case data",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Report or template not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "409": {
+                        "description": "Report already linked to a case",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/reports/{id}/duplicates": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Score a citizen report against the reports and open cases filed around the same time, on text similarity, location proximity and time. Matches from the configured minimum score are returned, best first, each with the case the report can be linked to.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Find likely duplicates of a report",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Report ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.DuplicateMatchDTO"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid report ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Report not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/reports/{id}/link": {
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "File a citizen report under a case, typically one suggested as a likely duplicate. The report leaves the triage list.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Link a report to an existing case",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Report ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Case to link the report to",
+                        "name": "link",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.LinkReportDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.LinkedReportDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid report ID or link data",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient clearance level",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Report or case not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "409": {
+                        "description": "Report already linked to the case",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/search": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Search a keyword across case descriptions, text evidence, witness statements, suspect descriptions and comments. Results are ranked and limited to cases the caller is cleared for. Snippets are HTML: matches are wrapped in \u003cb\u003e tags and all other text is escaped.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "search"
+                ],
+                "summary": "Full-text search",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search query (supports quoted phrases, OR and -exclusion)",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated entity types to search (case, evidence, witness, suspect, comment)",
+                        "name": "types",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Pagination offset",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "results and total count",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid search query",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Authentication required",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/sla-policies": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Get the SLA targets for every case type and priority. Policies with an empty case type apply to all case types without their own policy.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sla"
+                ],
+                "summary": "List SLA policies",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.SLAPolicy"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Set the SLA targets, in minutes, for a case type and priority. A target of 0 means no target.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sla"
+                ],
+                "summary": "Create or replace an SLA policy",
+                "parameters": [
+                    {
+                        "description": "SLA policy",
+                        "name": "policy",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.SLAPolicyDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.SLAPolicy"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid policy data",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/sla-policies/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Remove an SLA policy. Cases it covered fall back to the default policy for their priority.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sla"
+                ],
+                "summary": "Delete an SLA policy",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Policy ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid policy ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Policy not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/tags": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Get the tag vocabulary, limited to tags the caller is cleared to see",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tags"
+                ],
+                "summary": "List tags",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.TagResponseDTO"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Admin endpoint to add a tag to the vocabulary. Users below the tag's minimum clearance can neither see nor apply it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tags"
+                ],
+                "summary": "Create a tag",
+                "parameters": [
+                    {
+                        "description": "Tag details",
+                        "name": "tag",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.CreateTagDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.TagResponseDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid tag data",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "409": {
+                        "description": "Tag already exists",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/tags/bulk": {
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Add or remove tags on any number of cases, evidence items and people in one atomic operation. Fails without changes if the caller cannot use one of the tags or access one of the entities.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tags"
+                ],
+                "summary": "Tag or untag entities in bulk",
+                "parameters": [
+                    {
+                        "description": "Tags and entities",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.BulkTagDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid request",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient clearance level",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Tag or entity not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/tags/entity/{entityType}/{entityId}": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Get the tags attached to a case, evidence item, suspect, victim or witness",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tags"
+                ],
+                "summary": "List the tags on an entity",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Entity type: case, evidence, suspect, victim or witness",
+                        "name": "entityType",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Entity ID",
+                        "name": "entityId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.TagResponseDTO"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid entity",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient clearance level",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Entity not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/tags/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Admin endpoint to rename, recolour or change the minimum clearance of a tag",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tags"
+                ],
+                "summary": "Update a tag",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Tag ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to update",
+                        "name": "tag",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.UpdateTagDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.TagResponseDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid tag data",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Tag not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "409": {
+                        "description": "Tag name already in use",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Admin endpoint to remove a tag from the vocabulary and from everything it is attached to",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tags"
+                ],
+                "summary": "Delete a tag",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Tag ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid tag ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Tag not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/tasks/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Retrieve a single case task",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Get task details",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.TaskResponseDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid task ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient clearance level",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Update the supplied fields of a task. Officers may only update tasks assigned to them.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Update a task",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to update",
+                        "name": "task",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.UpdateTaskDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.TaskResponseDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid task data",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Delete a case task",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Delete a task",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Task ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid task ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Insufficient clearance level",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "Task not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/users": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Admin endpoint to get a paginated list of users",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "List all users",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Pagination offset",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Items per page",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "users and total count",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": true
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Admin endpoint to create a new user with specified role and clearance",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Create a new user",
+                "parameters": [
+                    {
+                        "description": "User details",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.UserDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.User"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user data",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Admin endpoint to get details of a specific user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Get user details",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.User"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Admin endpoint to update user details including role and clearance",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Update an existing user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Updated user details",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.UpdateUserDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.User"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user data",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Admin endpoint to delete a user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users"
+                ],
+                "summary": "Delete a user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/deactivate": {
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Disable a user's account. With handoverToUserId, a handover of every open case the user is assigned to is requested first; the user stays assigned to each case until the handover is accepted.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users",
+                    "handovers"
+                ],
+                "summary": "Deactivate a user",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Optional handover of the user's cases",
+                        "name": "deactivation",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.DeactivateUserDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.HandoverBatchResultDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user ID or handover data",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{id}/reconciliation": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Dry run: list the case assignments that would be flagged, removed or restored if the user's clearance were changed to the given level. Nothing is changed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users",
+                    "assignees"
+                ],
+                "summary": "Preview a clearance change",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Proposed clearance level (low, medium, high, critical), defaults to the current one",
+                        "name": "clearanceLevel",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ReconciliationReportDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user ID or clearance level",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Flag or remove (depending on CASE_RECONCILE_MODE) the user's assignments to cases they are no longer cleared for, restore flagged ones they are cleared for again, notify the case leads and record the changes in the case history. This also runs automatically when the user's clearance level changes.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "users",
+                    "assignees"
+                ],
+                "summary": "Reconcile a user's assignments",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "User ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ReconciliationReportDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid user ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/views": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Get your own saved case views and the views shared with your home area",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "views"
+                ],
+                "summary": "List saved case views",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.SavedViewResponseDTO"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "500": {
+                        "description": "Server error",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Save a named case list query with its sort and columns. The query takes the same parameters as GET /cases. Shared views are visible to the users of your home area. Watched views notify you when new cases match.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "views"
+                ],
+                "summary": "Save a case view",
+                "parameters": [
+                    {
+                        "description": "Saved view",
+                        "name": "view",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.SavedViewDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.SavedViewResponseDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid view",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "409": {
+                        "description": "View name already in use",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        },
+        "/views/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Get the cases matching a saved view, limited to the columns of the view. Results depend on the clearance of the caller.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "views"
+                ],
+                "summary": "Run a saved case view",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "View ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Offset for pagination",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 10,
+                        "description": "Limit for pagination",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.SavedViewResultDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid view ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "View not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Replace the query, columns, sharing and watch settings of a saved view. Only its owner and admins may change it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "views"
+                ],
+                "summary": "Update a saved case view",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "View ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Saved view",
+                        "name": "view",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.SavedViewDTO"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.SavedViewResponseDTO"
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid view",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "View not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "409": {
+                        "description": "View name already in use",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BasicAuth": []
+                    }
+                ],
+                "description": "Delete a saved view. Only its owner and admins may delete it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "views"
+                ],
+                "summary": "Delete a saved case view",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "View ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success message",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Invalid view ID",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "403": {
+                        "description": "Permission denied",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    },
+                    "404": {
+                        "description": "View not found",
+                        "schema": {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "github_com_m7medVision_crime-management-system_internal_dto.AssigneeDTO": {
+            "type": "object",
+            "required": [
+                "userId"
+            ],
+            "properties": {
+                "role": {
+                    "description": "defaults to support",
+                    "type": "string",
+                    "enum": [
+                        "lead",
+                        "support",
+                        "reviewer"
+                    ]
+                },
+                "userId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.BulkCaseDTO": {
+            "type": "object",
+            "required": [
+                "operation"
+            ],
+            "properties": {
+                "atomic": {
+                    "description": "apply to all cases or to none",
+                    "type": "boolean"
+                },
+                "authorizationLevel": {
+                    "description": "authorization_level",
+                    "type": "string"
+                },
+                "caseIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "filter": {
+                    "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.BulkCaseFilterDTO"
+                },
+                "operation": {
+                    "type": "string",
+                    "enum": [
+                        "assign",
+                        "unassign",
+                        "status",
+                        "tag",
+                        "untag",
+                        "authorization_level"
+                    ]
+                },
+                "role": {
+                    "description": "assign",
+                    "type": "string",
+                    "enum": [
+                        "lead",
+                        "support",
+                        "reviewer"
+                    ]
+                },
+                "status": {
+                    "description": "status",
+                    "type": "string"
+                },
+                "tagIds": {
+                    "description": "tag, untag",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "userId": {
+                    "description": "assign, unassign",
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.BulkCaseFilterDTO": {
+            "type": "object",
+            "properties": {
+                "area": {
+                    "type": "string"
+                },
+                "caseType": {
+                    "type": "string"
+                },
+                "priority": {
+                    "type": "string",
+                    "enum": [
+                        "low",
+                        "medium",
+                        "high",
+                        "critical"
+                    ]
+                },
+                "search": {
+                    "type": "string"
+                },
+                "slaStatus": {
+                    "type": "string",
+                    "enum": [
+                        "on_track",
+                        "at_risk",
+                        "breached",
+                        "met"
+                    ]
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "pending",
+                        "ongoing",
+                        "closed"
+                    ]
+                },
+                "tagMatch": {
+                    "type": "string",
+                    "enum": [
+                        "all",
+                        "any"
+                    ]
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.BulkCaseResultDTO": {
+            "type": "object",
+            "properties": {
+                "applied": {
+                    "type": "integer"
+                },
+                "failed": {
+                    "type": "integer"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.BulkItemResultDTO"
+                    }
+                },
+                "unchanged": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.BulkItemResultDTO": {
+            "type": "object",
+            "properties": {
+                "caseId": {
+                    "type": "integer"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "status": {
+                    "description": "applied, unchanged, failed or rolled_back",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.BulkTagDTO": {
+            "type": "object",
+            "required": [
+                "action",
+                "entities",
+                "tagIds"
+            ],
+            "properties": {
+                "action": {
+                    "type": "string",
+                    "enum": [
+                        "tag",
+                        "untag"
+                    ]
+                },
+                "entities": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.TaggedEntityDTO"
+                    }
+                },
+                "tagIds": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.CaseDTO": {
+            "type": "object",
+            "required": [
+                "area",
+                "authorizationLevel",
+                "caseType",
+                "description",
+                "name"
+            ],
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "area": {
+                    "type": "string"
+                },
+                "authorizationLevel": {
+                    "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.ClearanceLevel"
+                },
+                "caseType": {
+                    "type": "string"
+                },
+                "customFields": {
+                    "description": "validated against the custom field schema of the case type",
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "description": {
+                    "type": "string"
+                },
+                "latitude": {
+                    "type": "number",
+                    "maximum": 90,
+                    "minimum": -90
+                },
+                "longitude": {
+                    "type": "number",
+                    "maximum": 180,
+                    "minimum": -180
+                },
+                "name": {
+                    "type": "string"
+                },
+                "priority": {
+                    "enum": [
+                        "low",
+                        "medium",
+                        "high",
+                        "critical"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.CasePriority"
+                        }
+                    ]
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.CaseLinkDTO": {
+            "type": "object",
+            "required": [
+                "linkType"
+            ],
+            "properties": {
+                "linkType": {
+                    "enum": [
+                        "related",
+                        "duplicate_of",
+                        "duplicated_by",
+                        "parent_of",
+                        "child_of",
+                        "same_suspect"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.CaseLinkType"
+                        }
+                    ]
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "targetCaseId": {
+                    "type": "integer"
+                },
+                "targetCaseReference": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.CaseTemplateDTO": {
+            "type": "object",
+            "required": [
+                "authorizationLevel",
+                "caseType",
+                "name"
+            ],
+            "properties": {
+                "authorizationLevel": {
+                    "enum": [
+                        "low",
+                        "medium",
+                        "high",
+                        "critical"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.ClearanceLevel"
+                        }
+                    ]
+                },
+                "caseDescription": {
+                    "description": "description skeleton",
+                    "type": "string"
+                },
+                "caseName": {
+                    "type": "string"
+                },
+                "caseType": {
+                    "type": "string"
+                },
+                "customFields": {
+                    "description": "custom field defaults",
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "priority": {
+                    "enum": [
+                        "low",
+                        "medium",
+                        "high",
+                        "critical"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.CasePriority"
+                        }
+                    ]
+                },
+                "tagIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "tasks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.TemplateTaskDTO"
+                    }
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.CaseTemplateResponseDTO": {
+            "type": "object",
+            "properties": {
+                "authorizationLevel": {
+                    "type": "string"
+                },
+                "caseDescription": {
+                    "type": "string"
+                },
+                "caseName": {
+                    "type": "string"
+                },
+                "caseType": {
+                    "type": "string"
+                },
+                "customFields": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "priority": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.TagResponseDTO"
+                    }
+                },
+                "tasks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.TemplateTaskDTO"
+                    }
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.CaseUpdateDTO": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "area": {
+                    "type": "string",
+                    "minLength": 1
+                },
+                "authorizationLevel": {
+                    "enum": [
+                        "low",
+                        "medium",
+                        "high",
+                        "critical"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.ClearanceLevel"
+                        }
+                    ]
+                },
+                "caseType": {
+                    "type": "string",
+                    "minLength": 1
+                },
+                "customFields": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "description": {
+                    "type": "string",
+                    "minLength": 1
+                },
+                "latitude": {
+                    "type": "number",
+                    "maximum": 90,
+                    "minimum": -90
+                },
+                "longitude": {
+                    "type": "number",
+                    "maximum": 180,
+                    "minimum": -180
+                },
+                "name": {
+                    "type": "string",
+                    "minLength": 1
+                },
+                "priority": {
+                    "enum": [
+                        "low",
+                        "medium",
+                        "high",
+                        "critical"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.CasePriority"
+                        }
+                    ]
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.CloseCaseDTO": {
+            "type": "object",
+            "required": [
+                "disposition",
+                "summary"
+            ],
+            "properties": {
+                "checklist": {
+                    "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ClosureChecklistDTO"
+                },
+                "disposition": {
+                    "enum": [
+                        "solved_arrest",
+                        "solved_no_arrest",
+                        "unfounded",
+                        "insufficient_evidence",
+                        "referred",
+                        "withdrawn"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.CaseDisposition"
+                        }
+                    ]
+                },
+                "summary": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.ClosureCheckDTO": {
+            "type": "object",
+            "properties": {
+                "approvalRequired": {
+                    "type": "boolean"
+                },
+                "arrestedSuspects": {
+                    "type": "integer"
+                },
+                "dispositions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "evidence": {
+                    "type": "integer"
+                },
+                "openTasks": {
+                    "type": "integer"
+                },
+                "pending": {
+                    "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ClosureResponseDTO"
+                },
+                "suspects": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.ClosureChecklistDTO": {
+            "type": "object",
+            "properties": {
+                "evidenceReviewed": {
+                    "type": "boolean"
+                },
+                "suspectStatusesSet": {
+                    "description": "arrest status of every suspect is up to date",
+                    "type": "boolean"
+                },
+                "tasksDone": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.ClosureDecisionDTO": {
+            "type": "object",
+            "properties": {
+                "response": {
+                    "description": "required to reject",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.ClosureResponseDTO": {
+            "type": "object",
+            "properties": {
+                "caseId": {
+                    "type": "integer"
+                },
+                "checklist": {
+                    "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ClosureChecklistDTO"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "decidedAt": {
+                    "type": "string"
+                },
+                "decidedBy": {
+                    "type": "string"
+                },
+                "decidedById": {
+                    "type": "integer"
+                },
+                "disposition": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "referenceNumber": {
+                    "type": "string"
+                },
+                "requestedBy": {
+                    "type": "string"
+                },
+                "requestedById": {
+                    "type": "integer"
+                },
+                "response": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "summary": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.CommentResponseDTO": {
+            "type": "object",
+            "properties": {
+                "author": {
+                    "type": "string"
+                },
+                "authorId": {
+                    "type": "integer"
+                },
+                "caseId": {
+                    "type": "integer"
+                },
+                "content": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "editedAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "isDeleted": {
+                    "type": "boolean"
+                },
+                "parentId": {
+                    "type": "integer"
+                },
+                "replies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.CommentResponseDTO"
+                    }
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.CommentRevisionDTO": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "type": "string"
+                },
+                "editedAt": {
+                    "type": "string"
+                },
+                "editedBy": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.CountDTO": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "key": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.CreateCommentDTO": {
+            "type": "object",
+            "required": [
+                "content"
+            ],
+            "properties": {
+                "content": {
+                    "type": "string"
+                },
+                "parentId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.CreateHandoverDTO": {
+            "type": "object",
+            "required": [
+                "note",
+                "toUserId"
+            ],
+            "properties": {
+                "fromUserId": {
+                    "description": "admins only, defaults to the caller",
+                    "type": "integer"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "toUserId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.CreateTagDTO": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "color": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "minClearance": {
+                    "type": "string",
+                    "enum": [
+                        "low",
+                        "medium",
+                        "high",
+                        "critical"
+                    ]
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 64
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.CreateTaskDTO": {
+            "type": "object",
+            "required": [
+                "title"
+            ],
+            "properties": {
+                "assigneeId": {
+                    "type": "integer"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "dueDate": {
+                    "type": "string"
+                },
+                "priority": {
+                    "enum": [
+                        "low",
+                        "medium",
+                        "high",
+                        "urgent"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.TaskPriority"
+                        }
+                    ]
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.CreateTextEvidenceDTO": {
+            "type": "object",
+            "required": [
+                "content"
+            ],
+            "properties": {
+                "caseId": {
+                    "type": "integer"
+                },
+                "caseReference": {
+                    "type": "string"
+                },
+                "content": {
+                    "type": "string"
+                },
+                "remarks": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.CustomFieldDefinitionDTO": {
+            "type": "object",
+            "required": [
+                "key",
+                "type"
+            ],
+            "properties": {
+                "key": {
+                    "type": "string"
+                },
+                "label": {
+                    "type": "string"
+                },
+                "max": {
+                    "type": "number"
+                },
+                "maxLength": {
+                    "type": "integer"
+                },
+                "min": {
+                    "type": "number"
+                },
+                "options": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "pattern": {
+                    "type": "string"
+                },
+                "required": {
+                    "type": "boolean"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "string",
+                        "number",
+                        "integer",
+                        "boolean",
+                        "date",
+                        "enum"
+                    ]
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.CustomFieldSchemaDTO": {
+            "type": "object",
+            "required": [
+                "caseType"
+            ],
+            "properties": {
+                "caseType": {
+                    "type": "string"
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.CustomFieldDefinitionDTO"
+                    }
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.CustomFieldSchemaResponseDTO": {
+            "type": "object",
+            "properties": {
+                "caseType": {
+                    "type": "string"
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.CustomFieldDefinitionDTO"
+                    }
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.DashboardCaseDTO": {
+            "type": "object",
+            "properties": {
+                "assignedAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "priority": {
+                    "type": "string"
+                },
+                "referenceNumber": {
+                    "type": "string"
+                },
+                "slaDueAt": {
+                    "type": "string"
+                },
+                "slaStatus": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.DashboardDTO": {
+            "type": "object",
+            "properties": {
+                "assignedCases": {
+                    "type": "integer"
+                },
+                "breachedCases": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.DashboardCaseDTO"
+                    }
+                },
+                "casesByStatus": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "generatedAt": {
+                    "type": "string"
+                },
+                "newlyAssignedCases": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.DashboardCaseDTO"
+                    }
+                },
+                "overdueTasks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.TaskResponseDTO"
+                    }
+                },
+                "recentEvidence": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.DashboardEvidenceDTO"
+                    }
+                },
+                "untriagedReports": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.DashboardReportDTO"
+                    }
+                },
+                "untriagedReportsTotal": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.DashboardEvidenceDTO": {
+            "type": "object",
+            "properties": {
+                "addedBy": {
+                    "type": "string"
+                },
+                "caseId": {
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "remarks": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.DashboardReportDTO": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "likelyDuplicates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.DuplicateMatchDTO"
+                    }
+                },
+                "location": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.DeactivateUserDTO": {
+            "type": "object",
+            "properties": {
+                "handoverToUserId": {
+                    "description": "hand over all open cases to this investigator first",
+                    "type": "integer"
+                },
+                "note": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.DeleteConfirmationDTO": {
+            "type": "object",
+            "required": [
+                "confirmation"
+            ],
+            "properties": {
+                "confirmation": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.DuplicateMatchDTO": {
+            "type": "object",
+            "properties": {
+                "caseId": {
+                    "description": "case to link the report to",
+                    "type": "integer"
+                },
+                "caseReference": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "distanceKm": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "kind": {
+                    "description": "report or case",
+                    "type": "string"
+                },
+                "locationScore": {
+                    "type": "integer"
+                },
+                "referenceNumber": {
+                    "type": "string"
+                },
+                "score": {
+                    "type": "integer"
+                },
+                "textScore": {
+                    "type": "integer"
+                },
+                "timeScore": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.GeoJSONFeature": {
+            "type": "object",
+            "properties": {
+                "geometry": {
+                    "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.GeoJSONPoint"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "properties": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "type": {
+                    "type": "string",
+                    "example": "Feature"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.GeoJSONFeatureCollection": {
+            "type": "object",
+            "properties": {
+                "features": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.GeoJSONFeature"
+                    }
+                },
+                "type": {
+                    "type": "string",
+                    "example": "FeatureCollection"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.GeoJSONPoint": {
+            "type": "object",
+            "properties": {
+                "coordinates": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "type": {
+                    "type": "string",
+                    "example": "Point"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.HandOverAllDTO": {
+            "type": "object",
+            "required": [
+                "note",
+                "toUserId"
+            ],
+            "properties": {
+                "note": {
+                    "type": "string"
+                },
+                "toUserId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.HandoverBatchItemDTO": {
+            "type": "object",
+            "properties": {
+                "caseId": {
+                    "type": "integer"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "handover": {
+                    "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.HandoverResponseDTO"
+                },
+                "referenceNumber": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.HandoverBatchResultDTO": {
+            "type": "object",
+            "properties": {
+                "failed": {
+                    "type": "integer"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.HandoverBatchItemDTO"
+                    }
+                },
+                "requested": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.HandoverDecisionDTO": {
+            "type": "object",
+            "properties": {
+                "response": {
+                    "description": "required to decline",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.HandoverResponseDTO": {
+            "type": "object",
+            "properties": {
+                "caseId": {
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "decidedAt": {
+                    "type": "string"
+                },
+                "fromUser": {
+                    "type": "string"
+                },
+                "fromUserId": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "referenceNumber": {
+                    "type": "string"
+                },
+                "requestedById": {
+                    "type": "integer"
+                },
+                "response": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "toUser": {
+                    "type": "string"
+                },
+                "toUserId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.HotspotDTO": {
+            "type": "object",
+            "properties": {
+                "area": {
+                    "type": "string"
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "latitude": {
+                    "type": "number"
+                },
+                "longitude": {
+                    "type": "number"
+                },
+                "share": {
+                    "type": "number"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.LinkReportDTO": {
+            "type": "object",
+            "required": [
+                "caseId"
+            ],
+            "properties": {
+                "caseId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.LinkedReportDTO": {
+            "type": "object",
+            "properties": {
+                "caseIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.LoginDTO": {
+            "type": "object",
+            "required": [
+                "password",
+                "username"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.MergeCaseDTO": {
+            "type": "object",
+            "properties": {
+                "intoCaseId": {
+                    "type": "integer"
+                },
+                "intoCaseReference": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.NotificationPreferenceDTO": {
+            "type": "object",
+            "required": [
+                "type"
+            ],
+            "properties": {
+                "email": {
+                    "type": "boolean"
+                },
+                "inApp": {
+                    "type": "boolean"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.PersonDTO": {
+            "type": "object",
+            "properties": {
+                "address": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "age": {
+                    "type": "integer",
+                    "maximum": 150,
+                    "minimum": 0
+                },
+                "description": {
+                    "description": "suspects only",
+                    "type": "string"
+                },
+                "firstName": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 1
+                },
+                "gender": {
+                    "type": "string",
+                    "maxLength": 32
+                },
+                "injuryDescription": {
+                    "description": "victims only",
+                    "type": "string"
+                },
+                "isArrested": {
+                    "description": "suspects only",
+                    "type": "boolean"
+                },
+                "lastName": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 1
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "phoneNumber": {
+                    "type": "string",
+                    "maxLength": 32
+                },
+                "statement": {
+                    "description": "witnesses only",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.ReconciliationItemDTO": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "removed, flagged or restored",
+                    "type": "string"
+                },
+                "authorizationLevel": {
+                    "type": "string"
+                },
+                "caseId": {
+                    "type": "integer"
+                },
+                "clearanceLevel": {
+                    "type": "string"
+                },
+                "fullName": {
+                    "type": "string"
+                },
+                "referenceNumber": {
+                    "type": "string"
+                },
+                "role": {
+                    "type": "string"
+                },
+                "userId": {
+                    "type": "integer"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.ReconciliationReportDTO": {
+            "type": "object",
+            "properties": {
+                "dryRun": {
+                    "type": "boolean"
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.ReconciliationItemDTO"
+                    }
+                },
+                "mode": {
+                    "description": "flag or remove",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.RelatedCaseResponseDTO": {
+            "type": "object",
+            "properties": {
+                "caseId": {
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "createdBy": {
+                    "type": "string"
+                },
+                "linkId": {
+                    "type": "integer"
+                },
+                "linkType": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "referenceNumber": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.ReopenCaseDTO": {
+            "type": "object",
+            "required": [
+                "reason"
+            ],
+            "properties": {
+                "reason": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.ReopenDecisionDTO": {
+            "type": "object",
+            "properties": {
+                "response": {
+                    "description": "required to reject",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.ReopenResponseDTO": {
+            "type": "object",
+            "properties": {
+                "caseId": {
+                    "type": "integer"
+                },
+                "closedAt": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "decidedAt": {
+                    "type": "string"
+                },
+                "decidedBy": {
+                    "type": "string"
+                },
+                "decidedById": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "previousDisposition": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "referenceNumber": {
+                    "type": "string"
+                },
+                "requestedBy": {
+                    "type": "string"
+                },
+                "requestedById": {
+                    "type": "integer"
+                },
+                "response": {
+                    "type": "string"
+                },
+                "restoredEvidence": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.ReportDTO": {
+            "type": "object",
+            "required": [
+                "civil_id",
+                "description",
+                "email",
+                "location",
+                "name",
+                "title"
+            ],
+            "properties": {
+                "address": {
+                    "type": "string"
+                },
+                "civil_id": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "latitude": {
+                    "type": "number",
+                    "maximum": 90,
+                    "minimum": -90
+                },
+                "location": {
+                    "type": "string"
+                },
+                "longitude": {
+                    "type": "number",
+                    "maximum": 180,
+                    "minimum": -180
+                },
+                "name": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.SLAPolicyDTO": {
+            "type": "object",
+            "required": [
+                "priority"
+            ],
+            "properties": {
+                "caseType": {
+                    "description": "empty applies to all case types without their own policy",
+                    "type": "string"
+                },
+                "closureMinutes": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "firstAssignmentMinutes": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "firstUpdateMinutes": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "priority": {
+                    "enum": [
+                        "low",
+                        "medium",
+                        "high",
+                        "critical"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.CasePriority"
+                        }
+                    ]
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.SavedViewDTO": {
             "type": "object",
             "required": [
-                "userId"
+                "name"
             ],
             "properties": {
-                "userId": {
-                    "type": "integer"
+                "columns": {
+                    "description": "columns to show, all when empty",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "query": {
+                    "description": "case list query string, e.g. priority=high\u0026area=Muscat\u0026sort=-createdAt",
+                    "type": "string"
+                },
+                "shared": {
+                    "description": "share with the users of your home area",
+                    "type": "boolean"
+                },
+                "watch": {
+                    "description": "notify me when new cases match",
+                    "type": "boolean"
                 }
             }
         },
-        "github_com_m7medVision_crime-management-system_internal_dto.CaseDTO": {
+        "github_com_m7medVision_crime-management-system_internal_dto.SavedViewResponseDTO": {
             "type": "object",
-            "required": [
-                "area",
-                "authorizationLevel",
-                "caseType",
-                "description",
-                "name"
-            ],
             "properties": {
-                "area": {
+                "columns": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "description": {
                     "type": "string"
                 },
-                "authorizationLevel": {
-                    "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.ClearanceLevel"
+                "id": {
+                    "type": "integer"
                 },
-                "caseType": {
+                "lastCheckedAt": {
                     "type": "string"
                 },
-                "description": {
+                "name": {
                     "type": "string"
                 },
-                "name": {
+                "ownerId": {
+                    "type": "integer"
+                },
+                "ownerName": {
                     "type": "string"
+                },
+                "query": {
+                    "type": "string"
+                },
+                "sharedArea": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "watch": {
+                    "type": "boolean"
                 }
             }
         },
-        "github_com_m7medVision_crime-management-system_internal_dto.CreateTextEvidenceDTO": {
+        "github_com_m7medVision_crime-management-system_internal_dto.SavedViewResultDTO": {
             "type": "object",
-            "required": [
-                "caseId",
-                "content"
-            ],
             "properties": {
-                "caseId": {
-                    "type": "integer"
+                "columns": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "content": {
-                    "type": "string"
+                "rows": {
+                    "type": "array",
+                    "items": {
+                        "type": "object",
+                        "additionalProperties": true
+                    }
                 },
-                "remarks": {
-                    "type": "string"
+                "total": {
+                    "type": "integer"
+                },
+                "view": {
+                    "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.SavedViewResponseDTO"
                 }
             }
         },
-        "github_com_m7medVision_crime-management-system_internal_dto.DeleteConfirmationDTO": {
+        "github_com_m7medVision_crime-management-system_internal_dto.StatusUpdateDTO": {
             "type": "object",
             "required": [
-                "confirmation"
+                "status"
             ],
             "properties": {
-                "confirmation": {
-                    "type": "string"
+                "status": {
+                    "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.CaseStatus"
                 }
             }
         },
-        "github_com_m7medVision_crime-management-system_internal_dto.ErrorDTO": {
+        "github_com_m7medVision_crime-management-system_internal_dto.TagResponseDTO": {
             "type": "object",
             "properties": {
-                "code": {
+                "color": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "id": {
                     "type": "integer"
                 },
-                "message": {
+                "minClearance": {
+                    "type": "string"
+                },
+                "name": {
                     "type": "string"
                 }
             }
         },
-        "github_com_m7medVision_crime-management-system_internal_dto.LoginDTO": {
+        "github_com_m7medVision_crime-management-system_internal_dto.TaggedEntityDTO": {
             "type": "object",
             "required": [
-                "password",
-                "username"
+                "id",
+                "type"
             ],
             "properties": {
-                "password": {
+                "id": {
+                    "type": "integer"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "case",
+                        "evidence",
+                        "suspect",
+                        "victim",
+                        "witness"
+                    ]
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.TaskResponseDTO": {
+            "type": "object",
+            "properties": {
+                "assignee": {
                     "type": "string"
                 },
-                "username": {
+                "assigneeId": {
+                    "type": "integer"
+                },
+                "caseId": {
+                    "type": "integer"
+                },
+                "completedAt": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "createdBy": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "dueDate": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "isOverdue": {
+                    "type": "boolean"
+                },
+                "priority": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "updatedAt": {
                     "type": "string"
                 }
             }
         },
-        "github_com_m7medVision_crime-management-system_internal_dto.ReportDTO": {
+        "github_com_m7medVision_crime-management-system_internal_dto.TemplateTaskDTO": {
             "type": "object",
             "required": [
-                "civil_id",
-                "description",
-                "email",
-                "location",
-                "name",
                 "title"
             ],
             "properties": {
-                "civil_id": {
-                    "type": "string"
-                },
                 "description": {
                     "type": "string"
                 },
-                "email": {
-                    "type": "string"
+                "dueInDays": {
+                    "description": "due date relative to case creation, 0 for none",
+                    "type": "integer",
+                    "minimum": 0
                 },
-                "location": {
+                "priority": {
+                    "enum": [
+                        "low",
+                        "medium",
+                        "high",
+                        "urgent"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.TaskPriority"
+                        }
+                    ]
+                },
+                "title": {
                     "type": "string"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.TimeBucketDTO": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
                 },
-                "name": {
+                "start": {
                     "type": "string"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.TrendDTO": {
+            "type": "object",
+            "properties": {
+                "change": {
+                    "type": "integer"
                 },
-                "title": {
+                "changePercent": {
+                    "type": "number"
+                },
+                "current": {
+                    "type": "integer"
+                },
+                "key": {
                     "type": "string"
+                },
+                "previous": {
+                    "type": "integer"
                 }
             }
         },
-        "github_com_m7medVision_crime-management-system_internal_dto.StatusUpdateDTO": {
+        "github_com_m7medVision_crime-management-system_internal_dto.UpdateCommentDTO": {
             "type": "object",
             "required": [
-                "status"
+                "content"
             ],
             "properties": {
-                "status": {
-                    "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.CaseStatus"
+                "content": {
+                    "type": "string"
                 }
             }
         },
@@ -1889,13 +9782,104 @@ const docTemplate = `{
             "type": "object",
             "properties": {
                 "remarks": {
+                    "description": "omitted remarks are left unchanged",
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.UpdateNotificationPreferencesDTO": {
+            "type": "object",
+            "required": [
+                "preferences"
+            ],
+            "properties": {
+                "preferences": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_dto.NotificationPreferenceDTO"
+                    }
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.UpdateTagDTO": {
+            "type": "object",
+            "properties": {
+                "color": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "minClearance": {
+                    "type": "string",
+                    "enum": [
+                        "low",
+                        "medium",
+                        "high",
+                        "critical"
+                    ]
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 64
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.UpdateTaskDTO": {
+            "type": "object",
+            "properties": {
+                "assigneeId": {
+                    "type": "integer"
+                },
+                "clearDueDate": {
+                    "type": "boolean"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "dueDate": {
+                    "type": "string"
+                },
+                "priority": {
+                    "enum": [
+                        "low",
+                        "medium",
+                        "high",
+                        "urgent"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.TaskPriority"
+                        }
+                    ]
+                },
+                "status": {
+                    "enum": [
+                        "open",
+                        "in_progress",
+                        "done",
+                        "cancelled"
+                    ],
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.TaskStatus"
+                        }
+                    ]
+                },
+                "title": {
                     "type": "string"
+                },
+                "unassign": {
+                    "type": "boolean"
                 }
             }
         },
         "github_com_m7medVision_crime-management-system_internal_dto.UpdateUserDTO": {
             "type": "object",
             "properties": {
+                "area": {
+                    "type": "string"
+                },
                 "clearanceLevel": {
                     "type": "string",
                     "enum": [
@@ -1911,7 +9895,10 @@ const docTemplate = `{
                 "fullName": {
                     "type": "string"
                 },
-                "isActive": {
+                "isActive": {
+                    "type": "boolean"
+                },
+                "isSupervisor": {
                     "type": "boolean"
                 },
                 "password": {
@@ -1938,6 +9925,9 @@ const docTemplate = `{
                 "username"
             ],
             "properties": {
+                "area": {
+                    "type": "string"
+                },
                 "clearanceLevel": {
                     "type": "string",
                     "enum": [
@@ -1956,6 +9946,9 @@ const docTemplate = `{
                 "isActive": {
                     "type": "boolean"
                 },
+                "isSupervisor": {
+                    "type": "boolean"
+                },
                 "password": {
                     "type": "string"
                 },
@@ -1973,17 +9966,75 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_m7medVision_crime-management-system_internal_dto.WatcherResponseDTO": {
+            "type": "object",
+            "properties": {
+                "fullName": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_dto.WorkloadDTO": {
+            "type": "object",
+            "properties": {
+                "area": {
+                    "type": "string"
+                },
+                "clearanceLevel": {
+                    "type": "string"
+                },
+                "fullName": {
+                    "type": "string"
+                },
+                "lastAssignedAt": {
+                    "type": "string"
+                },
+                "leadCases": {
+                    "type": "integer"
+                },
+                "openCases": {
+                    "type": "integer"
+                },
+                "userId": {
+                    "type": "integer"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
         "github_com_m7medVision_crime-management-system_internal_model.ActionType": {
             "type": "string",
             "enum": [
                 "create",
                 "update",
-                "delete"
+                "delete",
+                "merge"
             ],
             "x-enum-varnames": [
                 "ActionCreate",
                 "ActionUpdate",
-                "ActionDelete"
+                "ActionDelete",
+                "ActionMerge"
+            ]
+        },
+        "github_com_m7medVision_crime-management-system_internal_model.AssignmentRole": {
+            "type": "string",
+            "enum": [
+                "lead",
+                "support",
+                "reviewer"
+            ],
+            "x-enum-varnames": [
+                "AssignmentLead",
+                "AssignmentSupport",
+                "AssignmentReviewer"
             ]
         },
         "github_com_m7medVision_crime-management-system_internal_model.AuditLog": {
@@ -1992,6 +10043,10 @@ const docTemplate = `{
                 "action": {
                     "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.ActionType"
                 },
+                "caseID": {
+                    "description": "Case the entry belongs to, used for case history",
+                    "type": "integer"
+                },
                 "createdAt": {
                     "type": "string"
                 },
@@ -2031,6 +10086,9 @@ const docTemplate = `{
         "github_com_m7medVision_crime-management-system_internal_model.Case": {
             "type": "object",
             "properties": {
+                "address": {
+                    "type": "string"
+                },
                 "area": {
                     "description": "City/Area",
                     "type": "string"
@@ -2047,6 +10105,12 @@ const docTemplate = `{
                 "caseType": {
                     "type": "string"
                 },
+                "closedAt": {
+                    "type": "string"
+                },
+                "closingSummary": {
+                    "type": "string"
+                },
                 "createdAt": {
                     "type": "string"
                 },
@@ -2056,30 +10120,82 @@ const docTemplate = `{
                 "createdByID": {
                     "type": "integer"
                 },
+                "customFields": {
+                    "description": "Validated against the CustomFieldSchema of the case type",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.CustomFields"
+                        }
+                    ]
+                },
                 "deletedAt": {
                     "$ref": "#/definitions/gorm.DeletedAt"
                 },
                 "description": {
                     "type": "string"
                 },
+                "disposition": {
+                    "description": "Outcome recorded by the closure workflow, cleared when the case is reopened",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.CaseDisposition"
+                        }
+                    ]
+                },
+                "escalatedAt": {
+                    "type": "string"
+                },
                 "evidence": {
                     "type": "array",
                     "items": {
                         "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.Evidence"
                     }
                 },
+                "firstAssignedAt": {
+                    "description": "SLA tracking",
+                    "type": "string"
+                },
+                "firstUpdatedAt": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "integer"
                 },
+                "latitude": {
+                    "type": "number"
+                },
+                "longitude": {
+                    "type": "number"
+                },
+                "mergedAt": {
+                    "type": "string"
+                },
+                "mergedIntoID": {
+                    "description": "Set when this case was merged into another case",
+                    "type": "integer"
+                },
                 "name": {
                     "type": "string"
                 },
+                "priority": {
+                    "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.CasePriority"
+                },
+                "referenceNumber": {
+                    "type": "string"
+                },
                 "reports": {
                     "type": "array",
                     "items": {
                         "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.Report"
                     }
                 },
+                "sladueAt": {
+                    "description": "Closure deadline under the applicable SLA policy",
+                    "type": "string"
+                },
+                "slastatus": {
+                    "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.SLAStatus"
+                },
                 "status": {
                     "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.CaseStatus"
                 },
@@ -2092,6 +10208,10 @@ const docTemplate = `{
                 "updatedAt": {
                     "type": "string"
                 },
+                "version": {
+                    "description": "Incremented on every update, used for optimistic locking",
+                    "type": "integer"
+                },
                 "victims": {
                     "type": "array",
                     "items": {
@@ -2106,6 +10226,107 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_m7medVision_crime-management-system_internal_model.CaseDisposition": {
+            "type": "string",
+            "enum": [
+                "solved_arrest",
+                "solved_no_arrest",
+                "unfounded",
+                "insufficient_evidence",
+                "referred",
+                "withdrawn",
+                "merged"
+            ],
+            "x-enum-comments": {
+                "DispositionReferred": "handed to another agency",
+                "DispositionSolvedNoArrest": "cleared by exceptional means",
+                "DispositionWithdrawn": "complaint withdrawn"
+            },
+            "x-enum-varnames": [
+                "DispositionSolvedArrest",
+                "DispositionSolvedNoArrest",
+                "DispositionUnfounded",
+                "DispositionInsufficientEvidence",
+                "DispositionReferred",
+                "DispositionWithdrawn",
+                "DispositionMerged"
+            ]
+        },
+        "github_com_m7medVision_crime-management-system_internal_model.CaseLink": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string"
+                },
+                "createdBy": {
+                    "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.User"
+                },
+                "createdByID": {
+                    "type": "integer"
+                },
+                "deletedAt": {
+                    "$ref": "#/definitions/gorm.DeletedAt"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "linkType": {
+                    "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.CaseLinkType"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "sourceCase": {
+                    "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.Case"
+                },
+                "sourceCaseID": {
+                    "type": "integer"
+                },
+                "targetCase": {
+                    "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.Case"
+                },
+                "targetCaseID": {
+                    "type": "integer"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_model.CaseLinkType": {
+            "type": "string",
+            "enum": [
+                "related",
+                "duplicate_of",
+                "duplicated_by",
+                "parent_of",
+                "child_of",
+                "same_suspect"
+            ],
+            "x-enum-varnames": [
+                "LinkRelated",
+                "LinkDuplicateOf",
+                "LinkDuplicateBy",
+                "LinkParentOf",
+                "LinkChildOf",
+                "LinkSameSuspect"
+            ]
+        },
+        "github_com_m7medVision_crime-management-system_internal_model.CasePriority": {
+            "type": "string",
+            "enum": [
+                "low",
+                "medium",
+                "high",
+                "critical"
+            ],
+            "x-enum-varnames": [
+                "PriorityLow",
+                "PriorityMedium",
+                "PriorityHigh",
+                "PriorityCritical"
+            ]
+        },
         "github_com_m7medVision_crime-management-system_internal_model.CaseStatus": {
             "type": "string",
             "enum": [
@@ -2134,6 +10355,10 @@ const docTemplate = `{
                 "ClearanceCritical"
             ]
         },
+        "github_com_m7medVision_crime-management-system_internal_model.CustomFields": {
+            "type": "object",
+            "additionalProperties": true
+        },
         "github_com_m7medVision_crime-management-system_internal_model.Evidence": {
             "type": "object",
             "properties": {
@@ -2143,6 +10368,10 @@ const docTemplate = `{
                 "addedByID": {
                     "type": "integer"
                 },
+                "archivedAt": {
+                    "description": "Set while the case is closed, archived evidence is read-only",
+                    "type": "string"
+                },
                 "case": {
                     "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.Case"
                 },
@@ -2178,6 +10407,10 @@ const docTemplate = `{
                 },
                 "updatedAt": {
                     "type": "string"
+                },
+                "version": {
+                    "description": "Incremented on every update, used for optimistic locking",
+                    "type": "integer"
                 }
             }
         },
@@ -2195,6 +10428,9 @@ const docTemplate = `{
         "github_com_m7medVision_crime-management-system_internal_model.Report": {
             "type": "object",
             "properties": {
+                "address": {
+                    "type": "string"
+                },
                 "cases": {
                     "type": "array",
                     "items": {
@@ -2219,9 +10455,15 @@ const docTemplate = `{
                 "id": {
                     "type": "integer"
                 },
+                "latitude": {
+                    "type": "number"
+                },
                 "location": {
                     "type": "string"
                 },
+                "longitude": {
+                    "type": "number"
+                },
                 "name": {
                     "type": "string"
                 },
@@ -2251,6 +10493,53 @@ const docTemplate = `{
                 "RoleCitizen"
             ]
         },
+        "github_com_m7medVision_crime-management-system_internal_model.SLAPolicy": {
+            "type": "object",
+            "properties": {
+                "caseType": {
+                    "type": "string"
+                },
+                "closureMinutes": {
+                    "type": "integer"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "deletedAt": {
+                    "$ref": "#/definitions/gorm.DeletedAt"
+                },
+                "firstAssignmentMinutes": {
+                    "type": "integer"
+                },
+                "firstUpdateMinutes": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "priority": {
+                    "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.CasePriority"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "github_com_m7medVision_crime-management-system_internal_model.SLAStatus": {
+            "type": "string",
+            "enum": [
+                "on_track",
+                "at_risk",
+                "breached",
+                "met"
+            ],
+            "x-enum-varnames": [
+                "SLAStatusOnTrack",
+                "SLAStatusAtRisk",
+                "SLAStatusBreached",
+                "SLAStatusMet"
+            ]
+        },
         "github_com_m7medVision_crime-management-system_internal_model.Suspect": {
             "type": "object",
             "properties": {
@@ -2304,9 +10593,43 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_m7medVision_crime-management-system_internal_model.TaskPriority": {
+            "type": "string",
+            "enum": [
+                "low",
+                "medium",
+                "high",
+                "urgent"
+            ],
+            "x-enum-varnames": [
+                "TaskPriorityLow",
+                "TaskPriorityMedium",
+                "TaskPriorityHigh",
+                "TaskPriorityUrgent"
+            ]
+        },
+        "github_com_m7medVision_crime-management-system_internal_model.TaskStatus": {
+            "type": "string",
+            "enum": [
+                "open",
+                "in_progress",
+                "done",
+                "cancelled"
+            ],
+            "x-enum-varnames": [
+                "TaskStatusOpen",
+                "TaskStatusInProgress",
+                "TaskStatusDone",
+                "TaskStatusCancelled"
+            ]
+        },
         "github_com_m7medVision_crime-management-system_internal_model.User": {
             "type": "object",
             "properties": {
+                "area": {
+                    "description": "Home area, used to match public reports and workload",
+                    "type": "string"
+                },
                 "clearanceLevel": {
                     "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.ClearanceLevel"
                 },
@@ -2328,6 +10651,10 @@ const docTemplate = `{
                 "isActive": {
                     "type": "boolean"
                 },
+                "isSupervisor": {
+                    "description": "Receives escalations for cases in breach of SLA",
+                    "type": "boolean"
+                },
                 "lastLogin": {
                     "type": "string"
                 },
@@ -2445,6 +10772,64 @@ const docTemplate = `{
                 }
             }
         },
+        "github_com_m7medVision_crime-management-system_internal_repository.Assignment": {
+            "type": "object",
+            "properties": {
+                "area": {
+                    "description": "Home area, used to match public reports and workload",
+                    "type": "string"
+                },
+                "assignedAt": {
+                    "type": "string"
+                },
+                "assignedByID": {
+                    "type": "integer"
+                },
+                "clearanceLevel": {
+                    "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.ClearanceLevel"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "deletedAt": {
+                    "$ref": "#/definitions/gorm.DeletedAt"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "fullName": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "ineligibleSince": {
+                    "type": "string"
+                },
+                "isActive": {
+                    "type": "boolean"
+                },
+                "isSupervisor": {
+                    "description": "Receives escalations for cases in breach of SLA",
+                    "type": "boolean"
+                },
+                "lastLogin": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "role": {
+                    "$ref": "#/definitions/github_com_m7medVision_crime-management-system_internal_model.AssignmentRole"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
         "gorm.DeletedAt": {
             "type": "object",
             "properties": {
@@ -0,0 +1,333 @@
+package bundle
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Format and Version identify the bundle layout. Version is raised whenever the layout
+// changes in a way older readers cannot handle.
+const (
+	Format  = "case-bundle"
+	Version = 1
+)
+
+const (
+	manifestFile   = "manifest.json"
+	signatureFile  = "manifest.sig"
+	caseFile       = "case.json"
+	peopleFile     = "people.json"
+	evidenceFile   = "evidence.json"
+	commentsFile   = "comments.json"
+	auditFile      = "audit.json"
+	objectsDir     = "objects/"
+	maxControlSize = 1 << 20 // manifest and signature are small, anything larger is not a bundle
+)
+
+var (
+	ErrInvalid            = errors.New("invalid bundle")
+	ErrSignature          = errors.New("bundle signature does not match")
+	ErrUnsupportedVersion = errors.New("unsupported bundle version")
+)
+
+// Manifest describes a bundle and lists the SHA-256 of every file in it. The manifest is
+// signed, so the hashes vouch for the rest of the bundle.
+type Manifest struct {
+	Format     string    `json:"format"`
+	Version    int       `json:"version"`
+	ExportedAt time.Time `json:"exportedAt"`
+	ExportedBy Actor     `json:"exportedBy"`
+	Source     string    `json:"source"` // area code of the exporting system
+	Reference  string    `json:"reference"`
+	Files      []File    `json:"files"`
+}
+
+type File struct {
+	Path   string `json:"path"`
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+// Actor is a user of the exporting system
+type Actor struct {
+	Username string `json:"username"`
+	FullName string `json:"fullName"`
+}
+
+type Case struct {
+	ID                 uint                   `json:"id"`
+	Reference          string                 `json:"reference"`
+	Name               string                 `json:"name"`
+	Description        string                 `json:"description"`
+	Area               string                 `json:"area"`
+	Address            string                 `json:"address,omitempty"`
+	Latitude           *float64               `json:"latitude,omitempty"`
+	Longitude          *float64               `json:"longitude,omitempty"`
+	CaseType           string                 `json:"caseType"`
+	CustomFields       map[string]interface{} `json:"customFields,omitempty"`
+	Status             string                 `json:"status"`
+	Priority           string                 `json:"priority"`
+	AuthorizationLevel string                 `json:"authorizationLevel"`
+	Disposition        string                 `json:"disposition,omitempty"`
+	ClosingSummary     string                 `json:"closingSummary,omitempty"`
+	CreatedBy          Actor                  `json:"createdBy"`
+	CreatedAt          time.Time              `json:"createdAt"`
+	FirstAssignedAt    *time.Time             `json:"firstAssignedAt,omitempty"`
+	FirstUpdatedAt     *time.Time             `json:"firstUpdatedAt,omitempty"`
+	ClosedAt           *time.Time             `json:"closedAt,omitempty"`
+}
+
+// Person is a suspect, victim or witness. Only the fields of its role are set.
+type Person struct {
+	ID                uint      `json:"id"`
+	Role              string    `json:"role"` // suspect, victim or witness
+	FirstName         string    `json:"firstName"`
+	LastName          string    `json:"lastName"`
+	Age               int       `json:"age,omitempty"`
+	Gender            string    `json:"gender,omitempty"`
+	Address           string    `json:"address,omitempty"`
+	PhoneNumber       string    `json:"phoneNumber,omitempty"`
+	Notes             string    `json:"notes,omitempty"`
+	Description       string    `json:"description,omitempty"`
+	IsArrested        bool      `json:"isArrested,omitempty"`
+	InjuryDescription string    `json:"injuryDescription,omitempty"`
+	Statement         string    `json:"statement,omitempty"`
+	AddedBy           Actor     `json:"addedBy"`
+	CreatedAt         time.Time `json:"createdAt"`
+}
+
+type Evidence struct {
+	ID         uint       `json:"id"`
+	Type       string     `json:"type"`
+	Content    string     `json:"content,omitempty"`
+	Object     string     `json:"object,omitempty"` // path of the image in the bundle
+	Remarks    string     `json:"remarks,omitempty"`
+	AddedBy    Actor      `json:"addedBy"`
+	ArchivedAt *time.Time `json:"archivedAt,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+}
+
+type Comment struct {
+	ID        uint       `json:"id"`
+	ParentID  *uint      `json:"parentId,omitempty"`
+	Content   string     `json:"content"`
+	Author    Actor      `json:"author"`
+	EditedAt  *time.Time `json:"editedAt,omitempty"`
+	IsDeleted bool       `json:"isDeleted,omitempty"`
+	CreatedAt time.Time  `json:"createdAt"`
+}
+
+// AuditEntry is an entry of the case history. EntityID refers to the exporting system.
+type AuditEntry struct {
+	Actor      Actor     `json:"actor"`
+	Action     string    `json:"action"`
+	EntityType string    `json:"entityType"`
+	EntityID   uint      `json:"entityId"`
+	OldValue   string    `json:"oldValue,omitempty"`
+	NewValue   string    `json:"newValue,omitempty"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+// Bundle is the content of a case bundle
+type Bundle struct {
+	Manifest   Manifest
+	Case       Case
+	People     []Person
+	Evidence   []Evidence
+	Comments   []Comment
+	AuditTrail []AuditEntry
+	Objects    map[string][]byte // evidence images by path in the bundle
+}
+
+// ObjectPath returns the path an evidence image is stored at in the bundle
+func ObjectPath(evidenceID uint, name string) string {
+	name = name[strings.LastIndex(name, "/")+1:]
+	return fmt.Sprintf("%s%d/%s", objectsDir, evidenceID, name)
+}
+
+// sign returns the hex HMAC-SHA256 of the manifest
+func sign(manifest, key []byte) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(manifest)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Write writes the bundle as a zip archive and signs its manifest with the key.
+// The format, version and file list of the manifest are filled in.
+func Write(w io.Writer, b *Bundle, key []byte) error {
+	files := map[string][]byte{}
+	for name, value := range map[string]interface{}{
+		caseFile:     b.Case,
+		peopleFile:   b.People,
+		evidenceFile: b.Evidence,
+		commentsFile: b.Comments,
+		auditFile:    b.AuditTrail,
+	} {
+		data, err := json.MarshalIndent(value, "", "  ")
+		if err != nil {
+			return err
+		}
+		files[name] = data
+	}
+	for path, data := range b.Objects {
+		if !strings.HasPrefix(path, objectsDir) {
+			return fmt.Errorf("%w: object %s is outside %s", ErrInvalid, path, objectsDir)
+		}
+		files[path] = data
+	}
+
+	paths := make([]string, 0, len(files))
+	for path := range files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	b.Manifest.Format = Format
+	b.Manifest.Version = Version
+	b.Manifest.Files = make([]File, 0, len(paths))
+	for _, path := range paths {
+		sum := sha256.Sum256(files[path])
+		b.Manifest.Files = append(b.Manifest.Files, File{Path: path, Size: int64(len(files[path])), SHA256: hex.EncodeToString(sum[:])})
+	}
+	manifest, err := json.MarshalIndent(b.Manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	archive := zip.NewWriter(w)
+	entries := append([]string{manifestFile, signatureFile}, paths...)
+	files[manifestFile] = manifest
+	files[signatureFile] = []byte(sign(manifest, key))
+	for _, path := range entries {
+		entry, err := archive.Create(path)
+		if err != nil {
+			return err
+		}
+		if _, err := entry.Write(files[path]); err != nil {
+			return err
+		}
+	}
+	return archive.Close()
+}
+
+// Read opens a bundle, checks the signature of its manifest against the key and the
+// hash of every file against the manifest. Files missing from the manifest are rejected.
+func Read(r io.ReaderAt, size int64, key []byte) (*Bundle, error) {
+	archive, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+	entries := map[string]*zip.File{}
+	for _, entry := range archive.File {
+		if _, ok := entries[entry.Name]; ok {
+			return nil, fmt.Errorf("%w: %s appears twice", ErrInvalid, entry.Name)
+		}
+		entries[entry.Name] = entry
+	}
+
+	manifestData, err := readEntry(entries, manifestFile, maxControlSize)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := readEntry(entries, signatureFile, maxControlSize)
+	if err != nil {
+		return nil, err
+	}
+	if !hmac.Equal([]byte(strings.TrimSpace(string(signature))), []byte(sign(manifestData, key))) {
+		return nil, ErrSignature
+	}
+
+	b := &Bundle{Objects: map[string][]byte{}}
+	if err := json.Unmarshal(manifestData, &b.Manifest); err != nil {
+		return nil, fmt.Errorf("%w: manifest: %v", ErrInvalid, err)
+	}
+	if b.Manifest.Format != Format {
+		return nil, fmt.Errorf("%w: not a case bundle", ErrInvalid)
+	}
+	if b.Manifest.Version < 1 || b.Manifest.Version > Version {
+		return nil, fmt.Errorf("%w: %d, this system reads up to version %d", ErrUnsupportedVersion, b.Manifest.Version, Version)
+	}
+
+	files := map[string][]byte{}
+	for _, file := range b.Manifest.Files {
+		if _, ok := files[file.Path]; ok || file.Path == manifestFile || file.Path == signatureFile {
+			return nil, fmt.Errorf("%w: manifest lists %s twice", ErrInvalid, file.Path)
+		}
+		data, err := readEntry(entries, file.Path, file.Size)
+		if err != nil {
+			return nil, err
+		}
+		sum := sha256.Sum256(data)
+		if int64(len(data)) != file.Size || hex.EncodeToString(sum[:]) != file.SHA256 {
+			return nil, fmt.Errorf("%w: %s does not match its hash", ErrInvalid, file.Path)
+		}
+		files[file.Path] = data
+	}
+	for name := range entries {
+		if _, ok := files[name]; !ok && name != manifestFile && name != signatureFile {
+			return nil, fmt.Errorf("%w: %s is not in the manifest", ErrInvalid, name)
+		}
+	}
+
+	for name, dest := range map[string]interface{}{
+		caseFile:     &b.Case,
+		peopleFile:   &b.People,
+		evidenceFile: &b.Evidence,
+		commentsFile: &b.Comments,
+		auditFile:    &b.AuditTrail,
+	} {
+		data, ok := files[name]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s is missing", ErrInvalid, name)
+		}
+		if err := json.Unmarshal(data, dest); err != nil {
+			return nil, fmt.Errorf("%w: %s: %v", ErrInvalid, name, err)
+		}
+	}
+	for path, data := range files {
+		if strings.HasPrefix(path, objectsDir) {
+			b.Objects[path] = data
+		}
+	}
+	for _, evidence := range b.Evidence {
+		if evidence.Object != "" {
+			if _, ok := b.Objects[evidence.Object]; !ok {
+				return nil, fmt.Errorf("%w: image of evidence %d is missing", ErrInvalid, evidence.ID)
+			}
+		}
+	}
+	return b, nil
+}
+
+// readEntry reads a file of the archive, reading at most limit bytes past which the
+// file cannot match what the manifest says
+func readEntry(entries map[string]*zip.File, name string, limit int64) ([]byte, error) {
+	entry, ok := entries[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s is missing", ErrInvalid, name)
+	}
+	file, err := entry.Open()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s: %v", ErrInvalid, name, err)
+	}
+	defer file.Close()
+
+	var buffer bytes.Buffer
+	if _, err := io.Copy(&buffer, io.LimitReader(file, limit+1)); err != nil {
+		return nil, fmt.Errorf("%w: %s: %v", ErrInvalid, name, err)
+	}
+	if int64(buffer.Len()) > limit {
+		return nil, fmt.Errorf("%w: %s is larger than expected", ErrInvalid, name)
+	}
+	return buffer.Bytes(), nil
+}
@@ -0,0 +1,326 @@
+package bundle
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+	"time"
+)
+
+var testKey = []byte("test-key")
+
+type entry struct {
+	name string
+	data []byte
+}
+
+func testBundle() *Bundle {
+	object := ObjectPath(7, "evidence/7/photo.jpg")
+	return &Bundle{
+		Manifest: Manifest{ExportedAt: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC), Source: "DC", Reference: "DC-2026-000001"},
+		Case:     Case{ID: 1, Reference: "DC-2026-000001", Name: "Burglary", Status: "ongoing"},
+		People:   []Person{{ID: 3, Role: "suspect", FirstName: "Jane", LastName: "Doe"}},
+		Evidence: []Evidence{{ID: 7, Type: "image", Object: object}},
+		Comments: []Comment{{ID: 9, Content: "First"}, {ID: 10, ParentID: ptr(uint(9)), Content: "Reply"}},
+		Objects:  map[string][]byte{object: []byte("jpeg bytes")},
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func writeBundle(t *testing.T, b *Bundle, key []byte) []byte {
+	t.Helper()
+	var buffer bytes.Buffer
+	if err := Write(&buffer, b, key); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	return buffer.Bytes()
+}
+
+func readEntries(t *testing.T, data []byte) []entry {
+	t.Helper()
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("zip.NewReader: %v", err)
+	}
+	var entries []entry
+	for _, file := range archive.File {
+		reader, err := file.Open()
+		if err != nil {
+			t.Fatalf("open %s: %v", file.Name, err)
+		}
+		content, err := io.ReadAll(reader)
+		reader.Close()
+		if err != nil {
+			t.Fatalf("read %s: %v", file.Name, err)
+		}
+		entries = append(entries, entry{file.Name, content})
+	}
+	return entries
+}
+
+func writeEntries(t *testing.T, entries []entry) []byte {
+	t.Helper()
+	var buffer bytes.Buffer
+	archive := zip.NewWriter(&buffer)
+	for _, e := range entries {
+		w, err := archive.Create(e.name)
+		if err != nil {
+			t.Fatalf("create %s: %v", e.name, err)
+		}
+		if _, err := w.Write(e.data); err != nil {
+			t.Fatalf("write %s: %v", e.name, err)
+		}
+	}
+	if err := archive.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+	return buffer.Bytes()
+}
+
+// resign replaces the manifest after edit and signs it again with the key
+func resign(t *testing.T, entries []entry, key []byte, edit func(*Manifest)) []entry {
+	t.Helper()
+	var manifest Manifest
+	for _, e := range entries {
+		if e.name == manifestFile {
+			if err := json.Unmarshal(e.data, &manifest); err != nil {
+				t.Fatalf("manifest: %v", err)
+			}
+		}
+	}
+	edit(&manifest)
+	data, err := json.Marshal(manifest)
+	if err != nil {
+		t.Fatalf("marshal manifest: %v", err)
+	}
+	for i := range entries {
+		switch entries[i].name {
+		case manifestFile:
+			entries[i].data = data
+		case signatureFile:
+			entries[i].data = []byte(sign(data, key))
+		}
+	}
+	return entries
+}
+
+func TestRoundTrip(t *testing.T) {
+	original := testBundle()
+	data := writeBundle(t, original, testKey)
+
+	b, err := Read(bytes.NewReader(data), int64(len(data)), testKey)
+	if err != nil {
+		t.Fatalf("Read: %v", err)
+	}
+	if b.Manifest.Format != Format || b.Manifest.Version != Version {
+		t.Errorf("manifest format %q version %d", b.Manifest.Format, b.Manifest.Version)
+	}
+	if b.Case.Name != "Burglary" || len(b.People) != 1 || len(b.Comments) != 2 {
+		t.Errorf("content not preserved: %+v", b)
+	}
+	if *b.Comments[1].ParentID != 9 {
+		t.Errorf("reply parent = %d, want 9", *b.Comments[1].ParentID)
+	}
+	object := b.Evidence[0].Object
+	if object != "objects/7/photo.jpg" || string(b.Objects[object]) != "jpeg bytes" {
+		t.Errorf("object %q = %q", object, b.Objects[object])
+	}
+}
+
+func TestReadRejects(t *testing.T) {
+	valid := writeBundle(t, testBundle(), testKey)
+
+	tests := []struct {
+		name string
+		key  []byte
+		edit func([]entry) []entry
+		want error
+	}{
+		{
+			name: "wrong key",
+			key:  []byte("other-key"),
+			want: ErrSignature,
+		},
+		{
+			name: "tampered manifest",
+			edit: func(entries []entry) []entry {
+				for i := range entries {
+					if entries[i].name == manifestFile {
+						entries[i].data = bytes.Replace(entries[i].data, []byte("DC-2026-000001"), []byte("DC-2026-000002"), 1)
+					}
+				}
+				return entries
+			},
+			want: ErrSignature,
+		},
+		{
+			name: "tampered file",
+			edit: func(entries []entry) []entry {
+				for i := range entries {
+					if entries[i].name == caseFile {
+						entries[i].data = bytes.Replace(entries[i].data, []byte("Burglary"), []byte("Burglarz"), 1)
+					}
+				}
+				return entries
+			},
+			want: ErrInvalid,
+		},
+		{
+			name: "tampered object",
+			edit: func(entries []entry) []entry {
+				for i := range entries {
+					if entries[i].name == "objects/7/photo.jpg" {
+						entries[i].data = []byte("other bytes")
+					}
+				}
+				return entries
+			},
+			want: ErrInvalid,
+		},
+		{
+			name: "duplicate entry",
+			edit: func(entries []entry) []entry {
+				for _, e := range entries {
+					if e.name == caseFile {
+						return append(entries, e)
+					}
+				}
+				return entries
+			},
+			want: ErrInvalid,
+		},
+		{
+			name: "file missing from the manifest",
+			edit: func(entries []entry) []entry {
+				return append(entries, entry{"objects/8/extra.jpg", []byte("extra")})
+			},
+			want: ErrInvalid,
+		},
+		{
+			name: "file listed in the manifest but missing",
+			edit: func(entries []entry) []entry {
+				var kept []entry
+				for _, e := range entries {
+					if e.name != commentsFile {
+						kept = append(kept, e)
+					}
+				}
+				return kept
+			},
+			want: ErrInvalid,
+		},
+		{
+			name: "missing signature",
+			edit: func(entries []entry) []entry {
+				var kept []entry
+				for _, e := range entries {
+					if e.name != signatureFile {
+						kept = append(kept, e)
+					}
+				}
+				return kept
+			},
+			want: ErrInvalid,
+		},
+		{
+			name: "newer version",
+			edit: func(entries []entry) []entry {
+				return resign(t, entries, testKey, func(m *Manifest) { m.Version = Version + 1 })
+			},
+			want: ErrUnsupportedVersion,
+		},
+		{
+			name: "other format",
+			edit: func(entries []entry) []entry {
+				return resign(t, entries, testKey, func(m *Manifest) { m.Format = "other" })
+			},
+			want: ErrInvalid,
+		},
+		{
+			name: "manifest lists a file twice",
+			edit: func(entries []entry) []entry {
+				return resign(t, entries, testKey, func(m *Manifest) { m.Files = append(m.Files, m.Files[0]) })
+			},
+			want: ErrInvalid,
+		},
+		{
+			name: "evidence image missing",
+			edit: func(entries []entry) []entry {
+				var kept []entry
+				for _, e := range entries {
+					if e.name != "objects/7/photo.jpg" {
+						kept = append(kept, e)
+					}
+				}
+				return resign(t, kept, testKey, func(m *Manifest) {
+					var files []File
+					for _, file := range m.Files {
+						if file.Path != "objects/7/photo.jpg" {
+							files = append(files, file)
+						}
+					}
+					m.Files = files
+				})
+			},
+			want: ErrInvalid,
+		},
+		{
+			name: "not a zip archive",
+			edit: func([]entry) []entry { return nil },
+			want: ErrInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := valid
+			if tt.edit != nil {
+				if entries := tt.edit(readEntries(t, valid)); entries != nil {
+					data = writeEntries(t, entries)
+				} else {
+					data = []byte("not a zip")
+				}
+			}
+			key := testKey
+			if tt.key != nil {
+				key = tt.key
+			}
+
+			_, err := Read(bytes.NewReader(data), int64(len(data)), key)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("Read error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestWriteRejectsObjectOutsideObjects(t *testing.T) {
+	b := testBundle()
+	b.Objects["case.json"] = []byte("{}")
+	if err := Write(io.Discard, b, testKey); !errors.Is(err, ErrInvalid) {
+		t.Errorf("Write error = %v, want %v", err, ErrInvalid)
+	}
+}
+
+func TestObjectPath(t *testing.T) {
+	tests := []struct {
+		id   uint
+		name string
+		want string
+	}{
+		{7, "photo.jpg", "objects/7/photo.jpg"},
+		{7, "evidence/7/photo.jpg", "objects/7/photo.jpg"},
+		{12, "../../etc/passwd", "objects/12/passwd"},
+	}
+	for _, tt := range tests {
+		if got := ObjectPath(tt.id, tt.name); got != tt.want {
+			t.Errorf("ObjectPath(%d, %q) = %q, want %q", tt.id, tt.name, got, tt.want)
+		}
+	}
+}
@@ -16,6 +16,14 @@ type Config struct {
 	Auth     AuthConfig
 	Email    EmailConfig
 	Storage  StorageConfig
+	Case     CaseConfig
+	SLA      SLAConfig
+	Geo      GeoConfig
+	Cache    CacheConfig
+	Report   ReportConfig
+	View     ViewConfig
+	Closure  ClosureConfig
+	Bundle   BundleConfig
 }
 
 type ServerConfig struct {
@@ -51,6 +59,46 @@ type StorageConfig struct {
 	Minio     MinioConfig
 }
 
+type CaseConfig struct {
+	ReferenceFormat string // supports {AREA}, {YEAR} and {SEQ} placeholders
+	AreaCode        string
+	ReferenceDigits int    // zero-padded width of {SEQ}
+	AutoAssign      string // none, round_robin or least_loaded
+	ReconcileMode   string // flag or remove assignees who lose clearance for a case
+}
+
+type SLAConfig struct {
+	EvaluationInterval int // in seconds
+	AtRiskPercent      int // share of a target's window after which a case is at risk
+}
+
+type GeoConfig struct {
+	GazetteerPath string // CSV of place names used for offline geocoding
+}
+
+type CacheConfig struct {
+	DashboardTTL int // in seconds
+}
+
+type ReportConfig struct {
+	DuplicateWindowHours int // reports and cases this close in time are compared with a new report
+	DuplicateRadiusKm    int // distance beyond which locations no longer count as the same place
+	DuplicateMinScore    int // score out of 100 from which a match is a likely duplicate
+}
+
+type ViewConfig struct {
+	WatchInterval int // in seconds
+}
+
+type ClosureConfig struct {
+	RequireApproval    bool // closures by non-supervisors wait for a supervisor's approval
+	ReopenApprovalDays int  // reopening a case closed longer than this needs a supervisor's approval
+}
+
+type BundleConfig struct {
+	SigningKey string // shared by the systems that exchange case bundles
+}
+
 type MinioConfig struct {
 	Endpoint  string
 	AccessKey string
@@ -121,11 +169,59 @@ func LoadConfig() (*Config, error) {
 				Region:    getEnv("MINIO_REGION", "us-east-1"),
 			},
 		},
+		Case: CaseConfig{
+			ReferenceFormat: getEnv("CASE_REFERENCE_FORMAT", "{AREA}-{YEAR}-{SEQ}"),
+			AreaCode:        getEnv("CASE_AREA_CODE", "DC"),
+			ReferenceDigits: getEnvAsInt("CASE_REFERENCE_DIGITS", 6),
+			AutoAssign:      getEnv("CASE_AUTO_ASSIGN", "none"),
+			ReconcileMode:   getEnv("CASE_RECONCILE_MODE", "flag"),
+		},
+		SLA: SLAConfig{
+			EvaluationInterval: getEnvAsInt("SLA_EVALUATION_INTERVAL", 60),
+			AtRiskPercent:      getEnvAsInt("SLA_AT_RISK_PERCENT", 75),
+		},
+		Geo: GeoConfig{
+			GazetteerPath: getEnv("GEO_GAZETTEER_PATH", "config/gazetteer.csv"),
+		},
+		Cache: CacheConfig{
+			DashboardTTL: getEnvAsInt("CACHE_DASHBOARD_TTL", 30),
+		},
+		Report: ReportConfig{
+			DuplicateWindowHours: getEnvAsInt("REPORT_DUPLICATE_WINDOW_HOURS", 72),
+			DuplicateRadiusKm:    getEnvAsInt("REPORT_DUPLICATE_RADIUS_KM", 2),
+			DuplicateMinScore:    getEnvAsInt("REPORT_DUPLICATE_MIN_SCORE", 50),
+		},
+		View: ViewConfig{
+			WatchInterval: getEnvAsInt("VIEW_WATCH_INTERVAL", 300),
+		},
+		Closure: ClosureConfig{
+			RequireApproval:    getEnvAsBool("CLOSURE_REQUIRE_APPROVAL", false),
+			ReopenApprovalDays: getEnvAsInt("CLOSURE_REOPEN_APPROVAL_DAYS", 90),
+		},
+		Bundle: BundleConfig{
+			SigningKey: getEnv("BUNDLE_SIGNING_KEY", "default-bundle-key-change-in-production"),
+		},
 	}
 
+	if err := config.Case.validate(); err != nil {
+		return nil, err
+	}
 	return config, nil
 }
 
+// validate checks that the reference format yields unique references that can be told
+// apart from case IDs. Sequences restart every year, so the format needs {SEQ} and {YEAR}.
+func (c CaseConfig) validate() error {
+	if !strings.Contains(c.ReferenceFormat, "{SEQ}") || !strings.Contains(c.ReferenceFormat, "{YEAR}") {
+		return fmt.Errorf("CASE_REFERENCE_FORMAT %q must contain {SEQ} and {YEAR}", c.ReferenceFormat)
+	}
+	sample := strings.NewReplacer("{AREA}", c.AreaCode, "{YEAR}", "2000", "{SEQ}", "1").Replace(c.ReferenceFormat)
+	if _, err := strconv.ParseUint(sample, 10, 64); err == nil {
+		return fmt.Errorf("CASE_REFERENCE_FORMAT %q yields numeric references, which cannot be told apart from case IDs", c.ReferenceFormat)
+	}
+	return nil
+}
+
 // Helper functions to get environment variables with defaults
 func getEnv(key, defaultValue string) string {
 	value := os.Getenv(key)
@@ -0,0 +1,26 @@
+package config
+
+import "testing"
+
+func TestCaseConfigValidate(t *testing.T) {
+	tests := []struct {
+		format  string
+		area    string
+		wantErr bool
+	}{
+		{"{AREA}-{YEAR}-{SEQ}", "DC", false},
+		{"{YEAR}/{SEQ}", "DC", false},
+		{"CASE{YEAR}{SEQ}", "DC", false},
+		{"{AREA}{YEAR}{SEQ}", "DC", false},
+		{"{AREA}-{SEQ}", "DC", true},  // restarts every year without {YEAR}
+		{"{AREA}-{YEAR}", "DC", true}, // no sequence
+		{"{YEAR}{SEQ}", "DC", true},   // all digits, read as a case ID
+		{"{AREA}{YEAR}{SEQ}", "12", true},
+	}
+	for _, tt := range tests {
+		err := CaseConfig{ReferenceFormat: tt.format, AreaCode: tt.area, ReferenceDigits: 6}.validate()
+		if (err != nil) != tt.wantErr {
+			t.Errorf("validate(%q, area %q) error = %v, want error %v", tt.format, tt.area, err, tt.wantErr)
+		}
+	}
+}
@@ -0,0 +1,334 @@
+package controller
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/m7medVision/crime-management-system/internal/dto"
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"github.com/m7medVision/crime-management-system/internal/service"
+)
+
+type AnalyticsController struct {
+	analyticsService *service.AnalyticsService
+}
+
+func NewAnalyticsController(analyticsService *service.AnalyticsService) *AnalyticsController {
+	return &AnalyticsController{analyticsService: analyticsService}
+}
+
+// parseAnalyticsQuery reads the period and case filters shared by all analytics endpoints.
+// Dates may be RFC3339 timestamps or YYYY-MM-DD; a date-only "to" includes the whole day.
+func parseAnalyticsQuery(c *gin.Context) (service.AnalyticsQuery, error) {
+	query := service.AnalyticsQuery{
+		Area:        c.Query("area"),
+		CaseType:    c.Query("caseType"),
+		Status:      model.CaseStatus(c.Query("status")),
+		Disposition: model.CaseDisposition(c.Query("disposition")),
+	}
+
+	for _, param := range []struct {
+		name     string
+		target   **time.Time
+		endOfDay bool
+	}{{"from", &query.From, false}, {"to", &query.To, true}} {
+		value := c.Query(param.name)
+		if value == "" {
+			continue
+		}
+		if t, err := time.Parse(time.RFC3339, value); err == nil {
+			*param.target = &t
+			continue
+		}
+		t, err := time.Parse("2006-01-02", value)
+		if err != nil {
+			return query, fmt.Errorf("Invalid %s, expected RFC3339 or YYYY-MM-DD", param.name)
+		}
+		if param.endOfDay {
+			t = t.AddDate(0, 0, 1)
+		}
+		*param.target = &t
+	}
+	return query, nil
+}
+
+// writeCSV sends rows as a CSV attachment
+func writeCSV(c *gin.Context, filename string, header []string, rows [][]string) {
+	c.Header("Content-Type", "text/csv")
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s", filename))
+	c.Status(http.StatusOK)
+
+	writer := csv.NewWriter(c.Writer)
+	writer.Write(header)
+	writer.WriteAll(rows)
+}
+
+// analyticsRequest parses the shared query and returns the current user, or writes an error response
+func analyticsRequest(c *gin.Context) (*model.User, service.AnalyticsQuery, bool) {
+	query, err := parseAnalyticsQuery(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    http.StatusBadRequest,
+		})
+		return nil, query, false
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return nil, query, false
+	}
+	return user.(*model.User), query, true
+}
+
+func analyticsError(c *gin.Context, err error) {
+	status := http.StatusInternalServerError
+	if errors.Is(err, service.ErrInvalidInput) {
+		status = http.StatusBadRequest
+	}
+	c.JSON(status, dto.ErrorDTO{
+		Message: err.Error(),
+		Code:    status,
+	})
+}
+
+// CountCases godoc
+// @Summary Count cases by dimension
+// @Description Count cases created in a period grouped by area, case type, status, priority or disposition. Only cases within the caller's clearance are counted. Defaults to the last 30 days.
+// @Tags analytics
+// @Accept json
+// @Produce json,text/csv
+// @Param by query string true "Dimension: area, caseType, status, priority or disposition"
+// @Param from query string false "Start of the period (RFC3339 or YYYY-MM-DD)"
+// @Param to query string false "End of the period (RFC3339 or YYYY-MM-DD, inclusive)"
+// @Param area query string false "Only cases in this area"
+// @Param caseType query string false "Only cases of this type"
+// @Param status query string false "Only cases with this status"
+// @Param disposition query string false "Only closed cases with this disposition"
+// @Param format query string false "Response format: json or csv" default(json)
+// @Success 200 {array} dto.CountDTO
+// @Failure 400 {object} dto.ErrorDTO "Invalid query"
+// @Failure 403 {object} dto.ErrorDTO "Supervisor access required"
+// @Security BasicAuth
+// @Router /analytics/counts [get]
+func (ctrl *AnalyticsController) CountCases(c *gin.Context) {
+	user, query, ok := analyticsRequest(c)
+	if !ok {
+		return
+	}
+
+	dimension := c.Query("by")
+	counts, err := ctrl.analyticsService.CountBy(user, query, dimension)
+	if err != nil {
+		analyticsError(c, err)
+		return
+	}
+
+	if c.Query("format") == "csv" {
+		rows := make([][]string, 0, len(counts))
+		for _, count := range counts {
+			rows = append(rows, []string{count.Key, strconv.FormatInt(count.Count, 10)})
+		}
+		writeCSV(c, "cases-by-"+dimension+".csv", []string{dimension, "count"}, rows)
+		return
+	}
+
+	response := make([]dto.CountDTO, 0, len(counts))
+	for _, count := range counts {
+		response = append(response, dto.CountDTO{Key: count.Key, Count: count.Count})
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// CountCasesOverTime godoc
+// @Summary Count cases over time
+// @Description Count cases created per day, week (starting Monday) or month. Buckets without cases are included with a zero count. Defaults to the last 30 days.
+// @Tags analytics
+// @Accept json
+// @Produce json,text/csv
+// @Param bucket query string false "Bucket size: day, week or month" default(day)
+// @Param from query string false "Start of the period (RFC3339 or YYYY-MM-DD)"
+// @Param to query string false "End of the period (RFC3339 or YYYY-MM-DD, inclusive)"
+// @Param area query string false "Only cases in this area"
+// @Param caseType query string false "Only cases of this type"
+// @Param status query string false "Only cases with this status"
+// @Param disposition query string false "Only closed cases with this disposition"
+// @Param format query string false "Response format: json or csv" default(json)
+// @Success 200 {array} dto.TimeBucketDTO
+// @Failure 400 {object} dto.ErrorDTO "Invalid query"
+// @Failure 403 {object} dto.ErrorDTO "Supervisor access required"
+// @Security BasicAuth
+// @Router /analytics/timeseries [get]
+func (ctrl *AnalyticsController) CountCasesOverTime(c *gin.Context) {
+	user, query, ok := analyticsRequest(c)
+	if !ok {
+		return
+	}
+
+	series, err := ctrl.analyticsService.CountByTimeBucket(user, query, c.DefaultQuery("bucket", "day"))
+	if err != nil {
+		analyticsError(c, err)
+		return
+	}
+
+	response := make([]dto.TimeBucketDTO, 0, len(series))
+	for _, point := range series {
+		response = append(response, dto.TimeBucketDTO{Start: point.Start.Format(time.RFC3339), Count: point.Count})
+	}
+
+	if c.Query("format") == "csv" {
+		rows := make([][]string, 0, len(response))
+		for _, point := range response {
+			rows = append(rows, []string{point.Start, strconv.FormatInt(point.Count, 10)})
+		}
+		writeCSV(c, "cases-over-time.csv", []string{"start", "count"}, rows)
+		return
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// CaseTrends godoc
+// @Summary Compare case counts with the previous period
+// @Description Count cases per group in a period and compare them with the previous period of the same length. Defaults to the last 30 days.
+// @Tags analytics
+// @Accept json
+// @Produce json,text/csv
+// @Param by query string true "Dimension: area, caseType, status, priority or disposition"
+// @Param from query string false "Start of the period (RFC3339 or YYYY-MM-DD)"
+// @Param to query string false "End of the period (RFC3339 or YYYY-MM-DD, inclusive)"
+// @Param area query string false "Only cases in this area"
+// @Param caseType query string false "Only cases of this type"
+// @Param status query string false "Only cases with this status"
+// @Param disposition query string false "Only closed cases with this disposition"
+// @Param format query string false "Response format: json or csv" default(json)
+// @Success 200 {array} dto.TrendDTO
+// @Failure 400 {object} dto.ErrorDTO "Invalid query"
+// @Failure 403 {object} dto.ErrorDTO "Supervisor access required"
+// @Security BasicAuth
+// @Router /analytics/trends [get]
+func (ctrl *AnalyticsController) CaseTrends(c *gin.Context) {
+	user, query, ok := analyticsRequest(c)
+	if !ok {
+		return
+	}
+
+	dimension := c.Query("by")
+	trends, err := ctrl.analyticsService.Trends(user, query, dimension)
+	if err != nil {
+		analyticsError(c, err)
+		return
+	}
+
+	if c.Query("format") == "csv" {
+		rows := make([][]string, 0, len(trends))
+		for _, trend := range trends {
+			percent := ""
+			if trend.ChangePercent != nil {
+				percent = strconv.FormatFloat(*trend.ChangePercent, 'f', 1, 64)
+			}
+			rows = append(rows, []string{
+				trend.Key,
+				strconv.FormatInt(trend.Current, 10),
+				strconv.FormatInt(trend.Previous, 10),
+				strconv.FormatInt(trend.Change, 10),
+				percent,
+			})
+		}
+		writeCSV(c, "case-trends-by-"+dimension+".csv", []string{dimension, "current", "previous", "change", "changePercent"}, rows)
+		return
+	}
+
+	response := make([]dto.TrendDTO, 0, len(trends))
+	for _, trend := range trends {
+		response = append(response, dto.TrendDTO{
+			Key:           trend.Key,
+			Current:       trend.Current,
+			Previous:      trend.Previous,
+			Change:        trend.Change,
+			ChangePercent: trend.ChangePercent,
+		})
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// Hotspots godoc
+// @Summary Top hotspot areas
+// @Description Get the areas with the most cases in a period, with their share of all cases and the centre of their geolocated cases. Defaults to the last 30 days.
+// @Tags analytics
+// @Accept json
+// @Produce json,text/csv
+// @Param limit query int false "Number of areas" default(10)
+// @Param from query string false "Start of the period (RFC3339 or YYYY-MM-DD)"
+// @Param to query string false "End of the period (RFC3339 or YYYY-MM-DD, inclusive)"
+// @Param caseType query string false "Only cases of this type"
+// @Param status query string false "Only cases with this status"
+// @Param disposition query string false "Only closed cases with this disposition"
+// @Param format query string false "Response format: json or csv" default(json)
+// @Success 200 {array} dto.HotspotDTO
+// @Failure 400 {object} dto.ErrorDTO "Invalid query"
+// @Failure 403 {object} dto.ErrorDTO "Supervisor access required"
+// @Security BasicAuth
+// @Router /analytics/hotspots [get]
+func (ctrl *AnalyticsController) Hotspots(c *gin.Context) {
+	user, query, ok := analyticsRequest(c)
+	if !ok {
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid limit",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	hotspots, err := ctrl.analyticsService.Hotspots(user, query, limit)
+	if err != nil {
+		analyticsError(c, err)
+		return
+	}
+
+	if c.Query("format") == "csv" {
+		rows := make([][]string, 0, len(hotspots))
+		for _, hotspot := range hotspots {
+			latitude, longitude := "", ""
+			if hotspot.Latitude != nil && hotspot.Longitude != nil {
+				latitude = strconv.FormatFloat(*hotspot.Latitude, 'f', 6, 64)
+				longitude = strconv.FormatFloat(*hotspot.Longitude, 'f', 6, 64)
+			}
+			rows = append(rows, []string{
+				hotspot.Area,
+				strconv.FormatInt(hotspot.Count, 10),
+				strconv.FormatFloat(hotspot.Share, 'f', 1, 64),
+				latitude,
+				longitude,
+			})
+		}
+		writeCSV(c, "hotspots.csv", []string{"area", "count", "share", "latitude", "longitude"}, rows)
+		return
+	}
+
+	response := make([]dto.HotspotDTO, 0, len(hotspots))
+	for _, hotspot := range hotspots {
+		response = append(response, dto.HotspotDTO{
+			Area:      hotspot.Area,
+			Count:     hotspot.Count,
+			Share:     hotspot.Share,
+			Latitude:  hotspot.Latitude,
+			Longitude: hotspot.Longitude,
+		})
+	}
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,99 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/m7medVision/crime-management-system/internal/dto"
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"github.com/m7medVision/crime-management-system/internal/repository"
+	"github.com/m7medVision/crime-management-system/internal/service"
+)
+
+type BulkCaseController struct {
+	bulkCaseService *service.BulkCaseService
+}
+
+func NewBulkCaseController(bulkCaseService *service.BulkCaseService) *BulkCaseController {
+	return &BulkCaseController{bulkCaseService: bulkCaseService}
+}
+
+// ApplyBulkOperation godoc
+// @Summary Apply an operation to many cases
+// @Description Assign or unassign a user, change the status, tag or untag, or change the authorization level of a list of cases or of every case matching a filter (at most 500). Permissions are checked per case. By default each case is applied on its own and the result lists the outcome per case; with atomic set, either every case is changed or none is. Each changed case gets one audit log entry.
+// @Tags cases
+// @Accept json
+// @Produce json
+// @Param request body dto.BulkCaseDTO true "Operation and cases"
+// @Success 200 {object} dto.BulkCaseResultDTO
+// @Failure 400 {object} dto.ErrorDTO "Invalid request"
+// @Failure 403 {object} dto.ErrorDTO "Permission denied"
+// @Failure 404 {object} dto.ErrorDTO "User or tag not found"
+// @Security BasicAuth
+// @Router /cases/bulk [post]
+func (ctrl *BulkCaseController) ApplyBulkOperation(c *gin.Context) {
+	var bulkDTO dto.BulkCaseDTO
+	if err := c.ShouldBindJSON(&bulkDTO); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid bulk operation data",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	request := service.BulkCaseRequest{
+		Operation:          service.BulkOperation(bulkDTO.Operation),
+		CaseIDs:            bulkDTO.CaseIDs,
+		UserID:             bulkDTO.UserID,
+		Role:               model.AssignmentRole(bulkDTO.Role),
+		Status:             model.CaseStatus(bulkDTO.Status),
+		TagIDs:             bulkDTO.TagIDs,
+		AuthorizationLevel: model.ClearanceLevel(bulkDTO.AuthorizationLevel),
+		Atomic:             bulkDTO.Atomic,
+		IPAddress:          c.ClientIP(),
+	}
+	if f := bulkDTO.Filter; f != nil {
+		request.Filter = &repository.CaseFilter{
+			Search:    f.Search,
+			Priority:  model.CasePriority(f.Priority),
+			SLAStatus: model.SLAStatus(f.SLAStatus),
+			Status:    model.CaseStatus(f.Status),
+			Area:      f.Area,
+			CaseType:  f.CaseType,
+		}
+		request.Tags = service.TagQuery{Names: f.Tags, MatchAny: f.TagMatch == "any"}
+	}
+
+	result, err := ctrl.bulkCaseService.Apply(user.(*model.User), request)
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	response := dto.BulkCaseResultDTO{
+		Applied:   result.Applied,
+		Unchanged: result.Unchanged,
+		Failed:    result.Failed,
+		Items:     make([]dto.BulkItemResultDTO, 0, len(result.Items)),
+	}
+	for _, item := range result.Items {
+		response.Items = append(response.Items, dto.BulkItemResultDTO{
+			CaseID: item.CaseID,
+			Status: string(item.Status),
+			Error:  item.Error,
+		})
+	}
+	c.JSON(http.StatusOK, response)
+}
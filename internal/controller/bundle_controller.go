@@ -0,0 +1,122 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/m7medVision/crime-management-system/internal/dto"
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"github.com/m7medVision/crime-management-system/internal/service"
+)
+
+type BundleController struct {
+	bundleService *service.BundleService
+}
+
+func NewBundleController(bundleService *service.BundleService) *BundleController {
+	return &BundleController{bundleService: bundleService}
+}
+
+// ExportCase godoc
+// @Summary Export a case bundle
+// @Description Download a case as a signed zip bundle that another system can import: JSON for the case, people, evidence metadata, comments and audit trail, the evidence images, and a manifest with the SHA-256 of every file, signed with the shared bundle key
+// @Tags cases,bundles
+// @Produce application/zip
+// @Param id path string true "Case ID or reference number"
+// @Success 200 {file} binary "Case bundle"
+// @Failure 400 {object} dto.ErrorDTO "Invalid case ID"
+// @Failure 403 {object} dto.ErrorDTO "Insufficient clearance"
+// @Failure 404 {object} dto.ErrorDTO "Case not found"
+// @Failure 500 {object} dto.ErrorDTO "Server error"
+// @Security BasicAuth
+// @Router /cases/{id}/bundle [get]
+func (ctrl *BundleController) ExportCase(c *gin.Context) {
+	caseID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid case ID",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	data, caseData, err := ctrl.bundleService.ExportCase(user.(*model.User), uint(caseID))
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	fileName := "case_bundle_" + caseData.ReferenceNumber + ".zip"
+	c.Header("Content-Description", "File Transfer")
+	c.Header("Content-Disposition", "attachment; filename="+fileName)
+	c.Header("Content-Length", strconv.Itoa(len(data)))
+	c.Header("Cache-Control", "no-cache")
+	c.Data(http.StatusOK, "application/zip", data)
+}
+
+// ImportCase godoc
+// @Summary Import a case bundle
+// @Description Recreate a case from a bundle exported by this or another system. The manifest signature and the hash of every file are verified first. The case gets a new reference number and new IDs; records by users unknown here are attributed to you. Your clearance must cover the case's authorization level.
+// @Tags cases,bundles
+// @Accept multipart/form-data
+// @Produce json
+// @Param bundle formData file true "Case bundle (zip)"
+// @Success 201 {object} model.Case
+// @Failure 400 {object} dto.ErrorDTO "Missing bundle, bad signature, hash mismatch or unsupported version"
+// @Failure 403 {object} dto.ErrorDTO "Insufficient clearance"
+// @Failure 500 {object} dto.ErrorDTO "Server error"
+// @Security BasicAuth
+// @Router /cases/import [post]
+func (ctrl *BundleController) ImportCase(c *gin.Context) {
+	file, err := c.FormFile("bundle")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Bundle file is required",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	src, err := file.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Failed to read bundle file",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+	defer src.Close()
+
+	caseData, err := ctrl.bundleService.ImportCase(user.(*model.User), src, file.Size)
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, caseData)
+}
@@ -1,36 +1,63 @@
 package controller
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/m7medVision/crime-management-system/internal/dto"
 	"github.com/m7medVision/crime-management-system/internal/model"
+	"github.com/m7medVision/crime-management-system/internal/repository"
 	"github.com/m7medVision/crime-management-system/internal/service"
 )
 
 type CaseController struct {
-	caseService *service.CaseService
+	caseService     *service.CaseService
+	templateService *service.CaseTemplateService
 }
 
-func NewCaseController(caseService *service.CaseService) *CaseController {
-	return &CaseController{caseService: caseService}
+func NewCaseController(caseService *service.CaseService, templateService *service.CaseTemplateService) *CaseController {
+	return &CaseController{caseService: caseService, templateService: templateService}
+}
+
+func toCaseChanges(updateDTO *dto.CaseUpdateDTO) service.CaseChanges {
+	return service.CaseChanges{
+		Name:               updateDTO.Name,
+		Description:        updateDTO.Description,
+		Area:               updateDTO.Area,
+		CaseType:           updateDTO.CaseType,
+		AuthorizationLevel: updateDTO.AuthorizationLevel,
+		Priority:           updateDTO.Priority,
+		Address:            updateDTO.Address,
+		Latitude:           updateDTO.Latitude,
+		Longitude:          updateDTO.Longitude,
+		CustomFields:       updateDTO.CustomFields,
+	}
 }
 
 // CreateCase godoc
 // @Summary Create a new case
-// @Description Create a new criminal case record
+// @Description Create a new criminal case record. With a template, the template supplies the defaults, checklist tasks and tags; every body field becomes optional and overrides the template, and custom field values are merged with its defaults.
 // @Tags cases
 // @Accept json
 // @Produce json
+// @Param template query string false "Case template ID or name"
 // @Param case body dto.CaseDTO true "Case details"
 // @Success 201 {object} model.Case
 // @Failure 400 {object} map[string]string "Invalid case data"
 // @Failure 403 {object} map[string]string "Permission denied"
+// @Failure 404 {object} dto.ErrorDTO "Template not found"
 // @Security BasicAuth
 // @Router /cases [post]
 func (ctrl *CaseController) CreateCase(c *gin.Context) {
+	if templateRef := c.Query("template"); templateRef != "" {
+		ctrl.createCaseFromTemplate(c, templateRef)
+		return
+	}
+
 	var caseDTO dto.CaseDTO
 	if err := c.ShouldBindJSON(&caseDTO); err != nil {
 		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
@@ -56,14 +83,113 @@ func (ctrl *CaseController) CreateCase(c *gin.Context) {
 		Area:               caseDTO.Area,
 		CaseType:           caseDTO.CaseType,
 		AuthorizationLevel: caseDTO.AuthorizationLevel,
+		Priority:           caseDTO.Priority,
+		Address:            caseDTO.Address,
+		Latitude:           caseDTO.Latitude,
+		Longitude:          caseDTO.Longitude,
+		CustomFields:       caseDTO.CustomFields,
 		CreatedByID:        userID,
 	}
 
 	result, err := ctrl.caseService.CreateCase(caseData)
 	if err != nil {
-		c.JSON(http.StatusForbidden, dto.ErrorDTO{
+		status := http.StatusForbidden
+		if errors.Is(err, service.ErrInvalidInput) {
+			status = http.StatusBadRequest
+		}
+		c.JSON(status, dto.ErrorDTO{
 			Message: err.Error(),
-			Code:    http.StatusForbidden,
+			Code:    status,
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, result)
+}
+
+func (ctrl *CaseController) createCaseFromTemplate(c *gin.Context, templateRef string) {
+	var updateDTO dto.CaseUpdateDTO
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&updateDTO); err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+				Message: "Invalid case data",
+				Code:    http.StatusBadRequest,
+			})
+			return
+		}
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	result, err := ctrl.templateService.CreateCase(user.(*model.User), templateRef, toCaseChanges(&updateDTO))
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, result)
+}
+
+// ConvertReportToCase godoc
+// @Summary Open a case for a citizen report
+// @Description Create a case from a citizen report and link the report to it. The case takes the report's title, text and location; with a template it also gets the template's defaults, checklist tasks and tags, with the description skeleton placed before the report text. Body fields override both. Without a template, caseType and authorizationLevel are required.
+// @Tags reports,cases
+// @Accept json
+// @Produce json
+// @Param id path int true "Report ID"
+// @Param template query string false "Case template ID or name"
+// @Param case body dto.CaseUpdateDTO false "Case fields overriding the report and template"
+// @Success 201 {object} model.Case
+// @Failure 400 {object} dto.ErrorDTO "Invalid case data"
+// @Failure 404 {object} dto.ErrorDTO "Report or template not found"
+// @Failure 409 {object} dto.ErrorDTO "Report already linked to a case"
+// @Security BasicAuth
+// @Router /reports/{id}/case [post]
+func (ctrl *CaseController) ConvertReportToCase(c *gin.Context) {
+	reportID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid report ID",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	var updateDTO dto.CaseUpdateDTO
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&updateDTO); err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+				Message: "Invalid case data",
+				Code:    http.StatusBadRequest,
+			})
+			return
+		}
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	result, err := ctrl.templateService.ConvertReport(user.(*model.User), uint(reportID), c.Query("template"), toCaseChanges(&updateDTO))
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
 		})
 		return
 	}
@@ -73,16 +199,19 @@ func (ctrl *CaseController) CreateCase(c *gin.Context) {
 
 // UpdateCase godoc
 // @Summary Update an existing case
-// @Description Update an existing criminal case record
+// @Description Replace the editable fields of a case. Only columns whose value changed are written. Send the ETag from a previous read in If-Match to fail with 412 if someone else changed the case meanwhile.
 // @Tags cases
 // @Accept json
 // @Produce json
-// @Param id path int true "Case ID"
+// @Param id path string true "Case ID or reference number"
+// @Param If-Match header string false "ETag of the case version being edited"
 // @Param case body dto.CaseDTO true "Updated case details"
 // @Success 200 {object} model.Case
 // @Failure 400 {object} map[string]string "Invalid case data"
 // @Failure 403 {object} map[string]string "Permission denied"
 // @Failure 404 {object} map[string]string "Case not found"
+// @Failure 409 {object} dto.ErrorDTO "Case changed during the update"
+// @Failure 412 {object} dto.ErrorDTO "Case has been modified since it was read"
 // @Security BasicAuth
 // @Router /cases/{id} [put]
 func (ctrl *CaseController) UpdateCase(c *gin.Context) {
@@ -96,6 +225,57 @@ func (ctrl *CaseController) UpdateCase(c *gin.Context) {
 		return
 	}
 
+	changes := service.CaseChanges{
+		Name:               &caseDTO.Name,
+		Description:        &caseDTO.Description,
+		Area:               &caseDTO.Area,
+		CaseType:           &caseDTO.CaseType,
+		AuthorizationLevel: &caseDTO.AuthorizationLevel,
+		Address:            &caseDTO.Address,
+		Latitude:           caseDTO.Latitude,
+		Longitude:          caseDTO.Longitude,
+		// Custom fields are kept when omitted and replaced as a whole when given
+		CustomFields: caseDTO.CustomFields,
+	}
+	if caseDTO.Priority != "" {
+		changes.Priority = &caseDTO.Priority
+	}
+
+	ctrl.applyCaseChanges(c, changes)
+}
+
+// PatchCase godoc
+// @Summary Partially update a case
+// @Description Update only the supplied fields of a case. Send the ETag from a previous read in If-Match to fail with 412 if someone else changed the case meanwhile.
+// @Tags cases
+// @Accept json
+// @Produce json
+// @Param id path string true "Case ID or reference number"
+// @Param If-Match header string false "ETag of the case version being edited"
+// @Param case body dto.CaseUpdateDTO true "Fields to update"
+// @Success 200 {object} model.Case
+// @Failure 400 {object} dto.ErrorDTO "Invalid case data"
+// @Failure 403 {object} dto.ErrorDTO "Permission denied"
+// @Failure 404 {object} dto.ErrorDTO "Case not found"
+// @Failure 409 {object} dto.ErrorDTO "Case changed during the update"
+// @Failure 412 {object} dto.ErrorDTO "Case has been modified since it was read"
+// @Security BasicAuth
+// @Router /cases/{id} [patch]
+func (ctrl *CaseController) PatchCase(c *gin.Context) {
+	var updateDTO dto.CaseUpdateDTO
+	if err := c.ShouldBindJSON(&updateDTO); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid case data",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	ctrl.applyCaseChanges(c, toCaseChanges(&updateDTO))
+}
+
+// applyCaseChanges runs a full or partial case update, honouring If-Match
+func (ctrl *CaseController) applyCaseChanges(c *gin.Context, changes service.CaseChanges) {
 	caseID, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
 		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
@@ -105,6 +285,15 @@ func (ctrl *CaseController) UpdateCase(c *gin.Context) {
 		return
 	}
 
+	expectedVersion, err := ifMatchVersion(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
 	user, exists := c.Get("user")
 	if !exists {
 		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
@@ -114,32 +303,16 @@ func (ctrl *CaseController) UpdateCase(c *gin.Context) {
 		return
 	}
 
-	userID := user.(*model.User).ID
-	caseData, err := ctrl.caseService.GetCaseByID(uint(caseID))
+	result, err := ctrl.caseService.UpdateCase(user.(*model.User), uint(caseID), changes, expectedVersion)
 	if err != nil {
-		c.JSON(http.StatusNotFound, dto.ErrorDTO{
-			Message: "Case not found",
-			Code:    http.StatusNotFound,
-		})
-		return
-	}
-
-	caseData.Name = caseDTO.Name
-	caseData.Description = caseDTO.Description
-	caseData.Area = caseDTO.Area
-	caseData.CaseType = caseDTO.CaseType
-	caseData.AuthorizationLevel = caseDTO.AuthorizationLevel
-	caseData.CreatedByID = userID
-
-	result, err := ctrl.caseService.UpdateCase(caseData)
-	if err != nil {
-		c.JSON(http.StatusForbidden, dto.ErrorDTO{
+		c.JSON(errorStatus(err), dto.ErrorDTO{
 			Message: err.Error(),
-			Code:    http.StatusForbidden,
+			Code:    errorStatus(err),
 		})
 		return
 	}
 
+	setETag(c, result.Version)
 	c.JSON(http.StatusOK, result)
 }
 
@@ -149,8 +322,10 @@ func (ctrl *CaseController) UpdateCase(c *gin.Context) {
 // @Tags cases
 // @Accept json
 // @Produce json
-// @Param id path int true "Case ID"
+// @Param id path string true "Case ID or reference number"
+// @Param redirect query bool false "Redirect merged cases to the surviving case" default(true)
 // @Success 200 {object} model.Case
+// @Success 301 {object} map[string]interface{} "Case was merged into another case"
 // @Failure 400 {object} map[string]string "Invalid case ID"
 // @Failure 404 {object} map[string]string "Case not found"
 // @Security BasicAuth
@@ -174,32 +349,84 @@ func (ctrl *CaseController) GetCaseByID(c *gin.Context) {
 		return
 	}
 
+	// Merged cases redirect to the case they were merged into
+	if caseData.MergedIntoID != nil && c.DefaultQuery("redirect", "true") != "false" {
+		c.Header("Location", fmt.Sprintf("/api/cases/%d", *caseData.MergedIntoID))
+		c.JSON(http.StatusMovedPermanently, gin.H{
+			"message":      "Case has been merged into another case",
+			"mergedIntoId": *caseData.MergedIntoID,
+		})
+		return
+	}
+
+	setETag(c, caseData.Version)
 	c.JSON(http.StatusOK, caseData)
 }
 
+// parseCaseFilter reads the case list filters from the query string
+func parseCaseFilter(c *gin.Context) (repository.CaseFilter, error) {
+	query, err := service.ParseCaseQuery(c.Request.URL.Query())
+	return query.Filter, err
+}
+
+// tagQueryFromQuery reads the tag filter shared by list endpoints
+func tagQueryFromQuery(c *gin.Context) service.TagQuery {
+	return service.ParseTagQuery(c.Request.URL.Query())
+}
+
 // ListCases godoc
 // @Summary List all cases
-// @Description Get a paginated list of cases with optional search
+// @Description Get a paginated list of cases with optional search and filters
 // @Tags cases
 // @Accept json
 // @Produce json
 // @Param offset query int false "Pagination offset" default(0)
 // @Param limit query int false "Items per page" default(10)
 // @Param search query string false "Search term for case name or description"
+// @Param priority query string false "Filter by priority (low, medium, high, critical)"
+// @Param slaStatus query string false "Filter by SLA status (on_track, at_risk, breached, met)"
+// @Param bbox query string false "Bounding box as minLon,minLat,maxLon,maxLat"
+// @Param near query string false "Centre point as lat,lon for a radius search"
+// @Param radius query number false "Radius in km around near" default(5)
+// @Param tags query string false "Comma-separated tag names to filter by"
+// @Param tagMatch query string false "Match all tags (all) or any tag (any)" default(all)
+// @Param status query string false "Filter by status (pending, ongoing, closed)"
+// @Param disposition query string false "Filter closed cases by disposition (solved_arrest, solved_no_arrest, unfounded, insufficient_evidence, referred, withdrawn)"
+// @Param area query string false "Filter by area"
+// @Param caseType query string false "Filter by case type"
+// @Param field.key query string false "Filter by a custom field of the case type, e.g. field.plate=AB1234; requires caseType"
+// @Param sort query string false "Sort by createdAt, updatedAt, name, referenceNumber, status, priority or slaDueAt; prefix with - for descending"
 // @Success 200 {object} map[string]interface{} "cases and total count"
+// @Failure 400 {object} map[string]string "Invalid filter"
 // @Failure 500 {object} map[string]string "Server error"
 // @Security BasicAuth
 // @Router /cases [get]
 func (ctrl *CaseController) ListCases(c *gin.Context) {
 	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
 	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
-	search := c.DefaultQuery("search", "")
+	query, err := service.ParseCaseQuery(c.Request.URL.Query())
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
 
-	cases, total, err := ctrl.caseService.ListCases(offset, limit, search)
+	cases, total, err := ctrl.caseService.ListCases(user.(*model.User), offset, limit, query.Filter, query.Tags, query.CustomFields)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorDTO{
+		c.JSON(errorStatus(err), dto.ErrorDTO{
 			Message: err.Error(),
-			Code:    http.StatusInternalServerError,
+			Code:    errorStatus(err),
 		})
 		return
 	}
@@ -222,14 +449,96 @@ func (ctrl *CaseController) ListCases(c *gin.Context) {
 	})
 }
 
+// ExportGeoJSON godoc
+// @Summary Export case locations as GeoJSON
+// @Description Get the cases visible to the caller that have coordinates as a GeoJSON FeatureCollection. Accepts the same filters as the case list.
+// @Tags cases
+// @Accept json
+// @Produce json
+// @Param priority query string false "Filter by priority (low, medium, high, critical)"
+// @Param slaStatus query string false "Filter by SLA status (on_track, at_risk, breached, met)"
+// @Param caseType query string false "Filter by case type"
+// @Param bbox query string false "Bounding box as minLon,minLat,maxLon,maxLat"
+// @Param near query string false "Centre point as lat,lon for a radius search"
+// @Param radius query number false "Radius in km around near" default(5)
+// @Param limit query int false "Maximum number of features" default(1000)
+// @Success 200 {object} dto.GeoJSONFeatureCollection
+// @Failure 400 {object} dto.ErrorDTO "Invalid filter"
+// @Failure 500 {object} dto.ErrorDTO "Server error"
+// @Security BasicAuth
+// @Router /cases/geojson [get]
+func (ctrl *CaseController) ExportGeoJSON(c *gin.Context) {
+	filter, err := parseCaseFilter(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "1000"))
+	if limit <= 0 || limit > 5000 {
+		limit = 1000
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	cases, err := ctrl.caseService.ListGeolocatedCases(user.(*model.User), filter, limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    http.StatusInternalServerError,
+		})
+		return
+	}
+
+	collection := dto.GeoJSONFeatureCollection{
+		Type:     "FeatureCollection",
+		Features: make([]dto.GeoJSONFeature, 0, len(cases)),
+	}
+	for _, cas := range cases {
+		collection.Features = append(collection.Features, dto.GeoJSONFeature{
+			Type: "Feature",
+			ID:   cas.ID,
+			Geometry: dto.GeoJSONPoint{
+				Type:        "Point",
+				Coordinates: []float64{*cas.Longitude, *cas.Latitude},
+			},
+			Properties: map[string]interface{}{
+				"referenceNumber": cas.ReferenceNumber,
+				"name":            cas.Name,
+				"caseType":        cas.CaseType,
+				"status":          cas.Status,
+				"priority":        cas.Priority,
+				"slaStatus":       cas.SLAStatus,
+				"disposition":     cas.Disposition,
+				"area":            cas.Area,
+				"address":         cas.Address,
+				"createdAt":       cas.CreatedAt.Format(time.RFC3339),
+			},
+		})
+	}
+
+	c.Header("Content-Type", "application/geo+json")
+	c.JSON(http.StatusOK, collection)
+}
+
 // GetAssignees godoc
 // @Summary Get case assignees
-// @Description Retrieve list of users assigned to a case
+// @Description Retrieve list of users assigned to a case with their assignment role (lead, support or reviewer), lead first
 // @Tags cases,assignees
 // @Accept json
 // @Produce json
-// @Param id path int true "Case ID"
-// @Success 200 {array} model.User
+// @Param id path string true "Case ID or reference number"
+// @Success 200 {array} repository.Assignment
 // @Failure 400 {object} map[string]string "Invalid case ID"
 // @Failure 500 {object} map[string]string "Server error"
 // @Security BasicAuth
@@ -244,7 +553,7 @@ func (ctrl *CaseController) GetAssignees(c *gin.Context) {
 		return
 	}
 
-	assignees, err := ctrl.caseService.GetAssignees(uint(caseID))
+	assignees, err := ctrl.caseService.ListAssignments(uint(caseID))
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, dto.ErrorDTO{
 			Message: err.Error(),
@@ -258,15 +567,17 @@ func (ctrl *CaseController) GetAssignees(c *gin.Context) {
 
 // AddAssignee godoc
 // @Summary Add assignee to case
-// @Description Assign a user to a case
+// @Description Assign a user to a case as lead, support (the default) or reviewer. Assigning someone already on the case changes their role. A case has at most one lead.
 // @Tags cases,assignees
 // @Accept json
 // @Produce json
-// @Param id path int true "Case ID"
-// @Param assignee body dto.AssigneeDTO true "User ID to assign"
+// @Param id path string true "Case ID or reference number"
+// @Param assignee body dto.AssigneeDTO true "User ID and role to assign"
 // @Success 200 {object} map[string]string "Success message"
 // @Failure 400 {object} dto.ErrorDTO "Invalid case ID or assignee data"
 // @Failure 403 {object} dto.ErrorDTO "Permission denied"
+// @Failure 404 {object} dto.ErrorDTO "Case or user not found"
+// @Failure 409 {object} dto.ErrorDTO "Case already has a lead"
 // @Security BasicAuth
 // @Router /cases/{id}/assignees [post]
 func (ctrl *CaseController) AddAssignee(c *gin.Context) {
@@ -288,11 +599,20 @@ func (ctrl *CaseController) AddAssignee(c *gin.Context) {
 		return
 	}
 
-	err = ctrl.caseService.AddAssignee(uint(caseID), assigneeDTO.UserID)
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	err = ctrl.caseService.AddAssignee(uint(caseID), assigneeDTO.UserID, model.AssignmentRole(assigneeDTO.Role), user.(*model.User).ID)
 	if err != nil {
-		c.JSON(http.StatusForbidden, dto.ErrorDTO{
+		c.JSON(errorStatus(err), dto.ErrorDTO{
 			Message: err.Error(),
-			Code:    http.StatusForbidden,
+			Code:    errorStatus(err),
 		})
 		return
 	}
@@ -300,13 +620,144 @@ func (ctrl *CaseController) AddAssignee(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"message": "Assignee added successfully"})
 }
 
+// GetWorkload godoc
+// @Summary Get investigator workload
+// @Description Count the open cases of every active investigator, least loaded first. Used to balance assignments and by auto-assignment.
+// @Tags cases,assignees
+// @Accept json
+// @Produce json
+// @Param area query string false "Only list investigators whose home area matches"
+// @Success 200 {array} dto.WorkloadDTO
+// @Failure 500 {object} dto.ErrorDTO "Server error"
+// @Security BasicAuth
+// @Router /assignments/workload [get]
+func (ctrl *CaseController) GetWorkload(c *gin.Context) {
+	workload, err := ctrl.caseService.GetWorkload(c.Query("area"))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    http.StatusInternalServerError,
+		})
+		return
+	}
+
+	response := make([]dto.WorkloadDTO, 0, len(workload))
+	for _, row := range workload {
+		item := dto.WorkloadDTO{
+			UserID:         row.UserID,
+			Username:       row.Username,
+			FullName:       row.FullName,
+			Area:           row.Area,
+			ClearanceLevel: string(row.ClearanceLevel),
+			OpenCases:      row.OpenCases,
+			LeadCases:      row.LeadCases,
+		}
+		if row.LastAssignedAt != nil {
+			item.LastAssignedAt = row.LastAssignedAt.Format(time.RFC3339)
+		}
+		response = append(response, item)
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// PreviewAuthorizationChange godoc
+// @Summary Preview an authorization level change
+// @Description Dry run: list the assignees that would be flagged, removed or restored if the case were moved to the given authorization level. Nothing is changed.
+// @Tags cases,assignees
+// @Accept json
+// @Produce json
+// @Param id path string true "Case ID or reference number"
+// @Param authorizationLevel query string false "Proposed authorization level (low, medium, high, critical), defaults to the current one"
+// @Success 200 {object} dto.ReconciliationReportDTO
+// @Failure 400 {object} dto.ErrorDTO "Invalid case ID or authorization level"
+// @Failure 403 {object} dto.ErrorDTO "Insufficient clearance level"
+// @Failure 404 {object} dto.ErrorDTO "Case not found"
+// @Security BasicAuth
+// @Router /cases/{id}/reconciliation [get]
+func (ctrl *CaseController) PreviewAuthorizationChange(c *gin.Context) {
+	caseID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid case ID",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	level := model.ClearanceLevel(c.Query("authorizationLevel"))
+	report, err := ctrl.caseService.PreviewAuthorizationChange(user.(*model.User), uint(caseID), level)
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toReconciliationReport(report))
+}
+
+// ReconcileAssignees godoc
+// @Summary Reconcile case assignees
+// @Description Flag or remove (depending on CASE_RECONCILE_MODE) assignees who are not cleared for the case's authorization level, restore flagged ones who are cleared again, notify the case lead and record the changes in the case history. This also runs automatically when the authorization level changes.
+// @Tags cases,assignees
+// @Accept json
+// @Produce json
+// @Param id path string true "Case ID or reference number"
+// @Success 200 {object} dto.ReconciliationReportDTO
+// @Failure 400 {object} dto.ErrorDTO "Invalid case ID"
+// @Failure 403 {object} dto.ErrorDTO "Insufficient clearance level"
+// @Failure 404 {object} dto.ErrorDTO "Case not found"
+// @Security BasicAuth
+// @Router /cases/{id}/reconciliation [post]
+func (ctrl *CaseController) ReconcileAssignees(c *gin.Context) {
+	caseID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid case ID",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	report, err := ctrl.caseService.ReconcileAssignees(user.(*model.User), uint(caseID))
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toReconciliationReport(report))
+}
+
 // RemoveAssignee godoc
 // @Summary Remove assignee from case
 // @Description Unassign a user from a case
 // @Tags cases,assignees
 // @Accept json
 // @Produce json
-// @Param id path int true "Case ID"
+// @Param id path string true "Case ID or reference number"
 // @Param assignee body dto.AssigneeDTO true "User ID to unassign"
 // @Success 200 {object} map[string]string "Success message"
 // @Failure 400 {object} dto.ErrorDTO "Invalid case ID or assignee data"
@@ -332,7 +783,16 @@ func (ctrl *CaseController) RemoveAssignee(c *gin.Context) {
 		return
 	}
 
-	err = ctrl.caseService.RemoveAssignee(uint(caseID), assigneeDTO.UserID)
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	err = ctrl.caseService.RemoveAssignee(uint(caseID), assigneeDTO.UserID, user.(*model.User).ID)
 	if err != nil {
 		c.JSON(http.StatusForbidden, dto.ErrorDTO{
 			Message: err.Error(),
@@ -369,6 +829,9 @@ func (ctrl *CaseController) SubmitCrimeReport(c *gin.Context) {
 		Title:        reportDTO.Title,
 		Description:  reportDTO.Description,
 		Location:     reportDTO.Location,
+		Address:      reportDTO.Address,
+		Latitude:     reportDTO.Latitude,
+		Longitude:    reportDTO.Longitude,
 		CivilID:      reportDTO.CivilID,
 		Email:        reportDTO.Email,
 		Name:         reportDTO.Name,
@@ -377,9 +840,9 @@ func (ctrl *CaseController) SubmitCrimeReport(c *gin.Context) {
 
 	result, err := ctrl.caseService.SubmitCrimeReport(report)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorDTO{
+		c.JSON(errorStatus(err), dto.ErrorDTO{
 			Message: err.Error(),
-			Code:    http.StatusInternalServerError,
+			Code:    errorStatus(err),
 		})
 		return
 	}
@@ -393,9 +856,11 @@ func (ctrl *CaseController) SubmitCrimeReport(c *gin.Context) {
 // @Tags cases,evidence
 // @Accept json
 // @Produce json
-// @Param id path int true "Case ID"
+// @Param id path string true "Case ID or reference number"
+// @Param tags query string false "Comma-separated tag names to filter by"
+// @Param tagMatch query string false "Match all tags (all) or any tag (any)" default(all)
 // @Success 200 {array} model.Evidence
-// @Failure 400 {object} dto.ErrorDTO "Invalid case ID"
+// @Failure 400 {object} dto.ErrorDTO "Invalid case ID or unknown tag"
 // @Failure 500 {object} dto.ErrorDTO "Server error"
 // @Security BasicAuth
 // @Router /cases/{id}/evidence [get]
@@ -409,11 +874,20 @@ func (ctrl *CaseController) GetEvidence(c *gin.Context) {
 		return
 	}
 
-	evidence, err := ctrl.caseService.GetEvidence(uint(caseID))
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	evidence, err := ctrl.caseService.GetEvidence(user.(*model.User), uint(caseID), tagQueryFromQuery(c))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorDTO{
+		c.JSON(errorStatus(err), dto.ErrorDTO{
 			Message: err.Error(),
-			Code:    http.StatusInternalServerError,
+			Code:    errorStatus(err),
 		})
 		return
 	}
@@ -427,9 +901,11 @@ func (ctrl *CaseController) GetEvidence(c *gin.Context) {
 // @Tags cases,suspects
 // @Accept json
 // @Produce json
-// @Param id path int true "Case ID"
+// @Param id path string true "Case ID or reference number"
+// @Param tags query string false "Comma-separated tag names to filter by"
+// @Param tagMatch query string false "Match all tags (all) or any tag (any)" default(all)
 // @Success 200 {array} model.Suspect
-// @Failure 400 {object} dto.ErrorDTO "Invalid case ID"
+// @Failure 400 {object} dto.ErrorDTO "Invalid case ID or unknown tag"
 // @Failure 500 {object} dto.ErrorDTO "Server error"
 // @Security BasicAuth
 // @Router /cases/{id}/suspects [get]
@@ -443,11 +919,20 @@ func (ctrl *CaseController) GetSuspects(c *gin.Context) {
 		return
 	}
 
-	suspects, err := ctrl.caseService.GetSuspects(uint(caseID))
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	suspects, err := ctrl.caseService.GetSuspects(user.(*model.User), uint(caseID), tagQueryFromQuery(c))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorDTO{
+		c.JSON(errorStatus(err), dto.ErrorDTO{
 			Message: err.Error(),
-			Code:    http.StatusInternalServerError,
+			Code:    errorStatus(err),
 		})
 		return
 	}
@@ -461,9 +946,11 @@ func (ctrl *CaseController) GetSuspects(c *gin.Context) {
 // @Tags cases,victims
 // @Accept json
 // @Produce json
-// @Param id path int true "Case ID"
+// @Param id path string true "Case ID or reference number"
+// @Param tags query string false "Comma-separated tag names to filter by"
+// @Param tagMatch query string false "Match all tags (all) or any tag (any)" default(all)
 // @Success 200 {array} model.Victim
-// @Failure 400 {object} dto.ErrorDTO "Invalid case ID"
+// @Failure 400 {object} dto.ErrorDTO "Invalid case ID or unknown tag"
 // @Failure 500 {object} dto.ErrorDTO "Server error"
 // @Security BasicAuth
 // @Router /cases/{id}/victims [get]
@@ -477,11 +964,20 @@ func (ctrl *CaseController) GetVictims(c *gin.Context) {
 		return
 	}
 
-	victims, err := ctrl.caseService.GetVictims(uint(caseID))
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	victims, err := ctrl.caseService.GetVictims(user.(*model.User), uint(caseID), tagQueryFromQuery(c))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorDTO{
+		c.JSON(errorStatus(err), dto.ErrorDTO{
 			Message: err.Error(),
-			Code:    http.StatusInternalServerError,
+			Code:    errorStatus(err),
 		})
 		return
 	}
@@ -495,9 +991,11 @@ func (ctrl *CaseController) GetVictims(c *gin.Context) {
 // @Tags cases,witnesses
 // @Accept json
 // @Produce json
-// @Param id path int true "Case ID"
+// @Param id path string true "Case ID or reference number"
+// @Param tags query string false "Comma-separated tag names to filter by"
+// @Param tagMatch query string false "Match all tags (all) or any tag (any)" default(all)
 // @Success 200 {array} model.Witness
-// @Failure 400 {object} dto.ErrorDTO "Invalid case ID"
+// @Failure 400 {object} dto.ErrorDTO "Invalid case ID or unknown tag"
 // @Failure 500 {object} dto.ErrorDTO "Server error"
 // @Security BasicAuth
 // @Router /cases/{id}/witnesses [get]
@@ -511,11 +1009,20 @@ func (ctrl *CaseController) GetWitnesses(c *gin.Context) {
 		return
 	}
 
-	witnesses, err := ctrl.caseService.GetWitnesses(uint(caseID))
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	witnesses, err := ctrl.caseService.GetWitnesses(user.(*model.User), uint(caseID), tagQueryFromQuery(c))
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorDTO{
+		c.JSON(errorStatus(err), dto.ErrorDTO{
 			Message: err.Error(),
-			Code:    http.StatusInternalServerError,
+			Code:    errorStatus(err),
 		})
 		return
 	}
@@ -529,7 +1036,7 @@ func (ctrl *CaseController) GetWitnesses(c *gin.Context) {
 // @Tags cases
 // @Accept json
 // @Produce json
-// @Param id path int true "Case ID"
+// @Param id path string true "Case ID or reference number"
 // @Success 200 {object} map[string][]string "Extracted links"
 // @Failure 400 {object} dto.ErrorDTO "Invalid case ID"
 // @Failure 500 {object} dto.ErrorDTO "Server error"
@@ -607,17 +1114,20 @@ func (ctrl *CaseController) GetCaseStatusByReportID(c *gin.Context) {
 
 // UpdateCaseStatus godoc
 // @Summary Update case status
-// @Description Update the status of a case (pending, ongoing, closed)
+// @Description Update the status of a case (pending, ongoing). Cases are closed with a disposition through POST /cases/{id}/close and reopened with a reason through POST /cases/{id}/reopen.
 // @Tags cases
 // @Accept json
 // @Produce json
-// @Param id path int true "Case ID"
+// @Param id path string true "Case ID or reference number"
+// @Param If-Match header string false "ETag of the case version being edited"
 // @Param status body dto.StatusUpdateDTO true "New case status"
 // @Success 200 {object} model.Case
 // @Failure 400 {object} map[string]string "Invalid case ID or status"
 // @Failure 401 {object} map[string]string "Authentication required"
 // @Failure 403 {object} map[string]string "Permission denied"
 // @Failure 404 {object} map[string]string "Case not found"
+// @Failure 409 {object} map[string]string "Case changed during the update"
+// @Failure 412 {object} map[string]string "Case has been modified since it was read"
 // @Failure 500 {object} map[string]string "Server error"
 // @Security BasicAuth
 // @Router /cases/{id}/status [patch]
@@ -644,6 +1154,12 @@ func (ctrl *CaseController) UpdateCaseStatus(c *gin.Context) {
 		return
 	}
 
+	expectedVersion, err := ifMatchVersion(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
 	// Get current user
 	user, exists := c.Get("user")
 	if !exists {
@@ -661,7 +1177,7 @@ func (ctrl *CaseController) UpdateCaseStatus(c *gin.Context) {
 
 	// Check if user is assigned to the case
 	isAssigned := false
-	assignees, err := ctrl.caseService.GetAssignees(uint(caseID))
+	assignees, err := ctrl.caseService.ListAssignments(uint(caseID))
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to check case assignment"})
 		return
@@ -680,12 +1196,12 @@ func (ctrl *CaseController) UpdateCaseStatus(c *gin.Context) {
 	}
 
 	// Update the status
-	caseData.Status = statusUpdate.Status
-	result, err := ctrl.caseService.UpdateCase(caseData)
+	result, err := ctrl.caseService.UpdateCaseStatus(caseData, userObj.ID, statusUpdate.Status, expectedVersion)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		c.JSON(errorStatus(err), gin.H{"error": err.Error()})
 		return
 	}
 
+	setETag(c, result.Version)
 	c.JSON(http.StatusOK, result)
 }
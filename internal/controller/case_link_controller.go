@@ -0,0 +1,266 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/m7medVision/crime-management-system/internal/dto"
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"github.com/m7medVision/crime-management-system/internal/service"
+)
+
+type CaseLinkController struct {
+	caseLinkService *service.CaseLinkService
+}
+
+func NewCaseLinkController(caseLinkService *service.CaseLinkService) *CaseLinkController {
+	return &CaseLinkController{caseLinkService: caseLinkService}
+}
+
+// ListRelatedCases godoc
+// @Summary List related cases
+// @Description Retrieve all cases linked to a case, with the link type seen from this case
+// @Tags cases,links
+// @Accept json
+// @Produce json
+// @Param id path string true "Case ID or reference number"
+// @Success 200 {array} dto.RelatedCaseResponseDTO
+// @Failure 400 {object} dto.ErrorDTO "Invalid case ID"
+// @Failure 403 {object} dto.ErrorDTO "Insufficient clearance level"
+// @Failure 404 {object} dto.ErrorDTO "Case not found"
+// @Security BasicAuth
+// @Router /cases/{id}/related [get]
+func (ctrl *CaseLinkController) ListRelatedCases(c *gin.Context) {
+	caseID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid case ID",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	related, err := ctrl.caseLinkService.ListRelatedCases(user.(*model.User), uint(caseID))
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	response := make([]dto.RelatedCaseResponseDTO, 0, len(related))
+	for _, item := range related {
+		response = append(response, dto.RelatedCaseResponseDTO{
+			LinkID:          item.Link.ID,
+			LinkType:        string(item.LinkType),
+			CaseID:          item.Case.ID,
+			ReferenceNumber: item.Case.ReferenceNumber,
+			Name:            item.Case.Name,
+			Status:          string(item.Case.Status),
+			Notes:           item.Link.Notes,
+			CreatedBy:       item.Link.CreatedBy.FullName,
+			CreatedAt:       item.Link.CreatedAt.Format(time.RFC3339),
+		})
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// LinkCase godoc
+// @Summary Link two cases
+// @Description Create a typed link (related, duplicate_of, duplicated_by, parent_of, child_of, same_suspect) from this case to another case
+// @Tags cases,links
+// @Accept json
+// @Produce json
+// @Param id path string true "Case ID or reference number"
+// @Param link body dto.CaseLinkDTO true "Link details"
+// @Success 201 {object} model.CaseLink
+// @Failure 400 {object} dto.ErrorDTO "Invalid link data"
+// @Failure 403 {object} dto.ErrorDTO "Insufficient clearance level"
+// @Failure 404 {object} dto.ErrorDTO "Case not found"
+// @Failure 409 {object} dto.ErrorDTO "Cases are already linked"
+// @Security BasicAuth
+// @Router /cases/{id}/related [post]
+func (ctrl *CaseLinkController) LinkCase(c *gin.Context) {
+	caseID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid case ID",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	var linkDTO dto.CaseLinkDTO
+	if err := c.ShouldBindJSON(&linkDTO); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid link data",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	targetCaseID := linkDTO.TargetCaseID
+	if linkDTO.TargetCaseReference != "" {
+		targetCaseID, err = ctrl.caseLinkService.ResolveCaseID(linkDTO.TargetCaseReference)
+		if err != nil {
+			c.JSON(http.StatusNotFound, dto.ErrorDTO{
+				Message: "Case not found",
+				Code:    http.StatusNotFound,
+			})
+			return
+		}
+	}
+
+	link, err := ctrl.caseLinkService.LinkCases(user.(*model.User), uint(caseID), targetCaseID, linkDTO.LinkType, linkDTO.Notes)
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, link)
+}
+
+// UnlinkCase godoc
+// @Summary Remove a case link
+// @Description Remove a link between this case and another case
+// @Tags cases,links
+// @Accept json
+// @Produce json
+// @Param id path string true "Case ID or reference number"
+// @Param linkId path int true "Link ID"
+// @Success 200 {object} map[string]string "Success message"
+// @Failure 400 {object} dto.ErrorDTO "Invalid case or link ID"
+// @Failure 403 {object} dto.ErrorDTO "Insufficient clearance level"
+// @Failure 404 {object} dto.ErrorDTO "Link not found"
+// @Security BasicAuth
+// @Router /cases/{id}/related/{linkId} [delete]
+func (ctrl *CaseLinkController) UnlinkCase(c *gin.Context) {
+	caseID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid case ID",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	linkID, err := strconv.Atoi(c.Param("linkId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid link ID",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	if err := ctrl.caseLinkService.UnlinkCases(user.(*model.User), uint(caseID), uint(linkID)); err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Link removed successfully"})
+}
+
+// MergeCase godoc
+// @Summary Merge a case into another case
+// @Description Move evidence, suspects, victims, witnesses, comments, tasks, tags, assignees, watchers and linked reports into the surviving case. The merged case is closed and redirects to the surviving case; its pending handovers, closure and reopen requests are settled. The surviving case must have at least the authorization level of the merged case, and moved assignees who are not cleared for it are reconciled.
+// @Tags cases,links
+// @Accept json
+// @Produce json
+// @Param id path string true "ID or reference number of the case to merge"
+// @Param merge body dto.MergeCaseDTO true "Surviving case"
+// @Success 200 {object} model.Case
+// @Failure 400 {object} dto.ErrorDTO "Invalid merge data"
+// @Failure 403 {object} dto.ErrorDTO "Insufficient clearance level"
+// @Failure 404 {object} dto.ErrorDTO "Case not found"
+// @Failure 409 {object} dto.ErrorDTO "Case already merged, or the surviving case has a lower authorization level"
+// @Security BasicAuth
+// @Router /cases/{id}/merge [post]
+func (ctrl *CaseLinkController) MergeCase(c *gin.Context) {
+	caseID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid case ID",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	var mergeDTO dto.MergeCaseDTO
+	if err := c.ShouldBindJSON(&mergeDTO); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid merge data",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	intoCaseID := mergeDTO.IntoCaseID
+	if mergeDTO.IntoCaseReference != "" {
+		intoCaseID, err = ctrl.caseLinkService.ResolveCaseID(mergeDTO.IntoCaseReference)
+		if err != nil {
+			c.JSON(http.StatusNotFound, dto.ErrorDTO{
+				Message: "Case not found",
+				Code:    http.StatusNotFound,
+			})
+			return
+		}
+	}
+
+	result, err := ctrl.caseLinkService.MergeCases(user.(*model.User), uint(caseID), intoCaseID, c.ClientIP())
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
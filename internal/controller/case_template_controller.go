@@ -0,0 +1,237 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/m7medVision/crime-management-system/internal/dto"
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"github.com/m7medVision/crime-management-system/internal/service"
+)
+
+type CaseTemplateController struct {
+	templateService *service.CaseTemplateService
+}
+
+func NewCaseTemplateController(templateService *service.CaseTemplateService) *CaseTemplateController {
+	return &CaseTemplateController{templateService: templateService}
+}
+
+func toCaseTemplateResponse(template *model.CaseTemplate) dto.CaseTemplateResponseDTO {
+	tasks := make([]dto.TemplateTaskDTO, 0, len(template.Tasks))
+	for _, task := range template.Tasks {
+		tasks = append(tasks, dto.TemplateTaskDTO{
+			Title:       task.Title,
+			Description: task.Description,
+			Priority:    task.Priority,
+			DueInDays:   task.DueInDays,
+		})
+	}
+	customFields := template.CustomFields
+	if customFields == nil {
+		customFields = model.CustomFields{}
+	}
+	return dto.CaseTemplateResponseDTO{
+		ID:                 template.ID,
+		Name:               template.Name,
+		Description:        template.Description,
+		CaseType:           template.CaseType,
+		CaseName:           template.CaseName,
+		CaseDescription:    template.CaseDescription,
+		Priority:           string(template.Priority),
+		AuthorizationLevel: string(template.AuthorizationLevel),
+		CustomFields:       customFields,
+		Tasks:              tasks,
+		Tags:               toTagResponses(template.Tags),
+		UpdatedAt:          template.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func toCaseTemplate(templateDTO *dto.CaseTemplateDTO) *model.CaseTemplate {
+	tasks := make(model.TemplateTasks, 0, len(templateDTO.Tasks))
+	for _, task := range templateDTO.Tasks {
+		tasks = append(tasks, model.TemplateTask{
+			Title:       task.Title,
+			Description: task.Description,
+			Priority:    task.Priority,
+			DueInDays:   task.DueInDays,
+		})
+	}
+	return &model.CaseTemplate{
+		Name:               templateDTO.Name,
+		Description:        templateDTO.Description,
+		CaseType:           templateDTO.CaseType,
+		CaseName:           templateDTO.CaseName,
+		CaseDescription:    templateDTO.CaseDescription,
+		Priority:           templateDTO.Priority,
+		AuthorizationLevel: templateDTO.AuthorizationLevel,
+		CustomFields:       templateDTO.CustomFields,
+		Tasks:              tasks,
+	}
+}
+
+// ListTemplates godoc
+// @Summary List case templates
+// @Description Get the templates cases of recurring crime types can be created from
+// @Tags case-templates
+// @Accept json
+// @Produce json
+// @Success 200 {array} dto.CaseTemplateResponseDTO
+// @Failure 500 {object} dto.ErrorDTO "Server error"
+// @Security BasicAuth
+// @Router /case-templates [get]
+func (ctrl *CaseTemplateController) ListTemplates(c *gin.Context) {
+	templates, err := ctrl.templateService.ListTemplates()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    http.StatusInternalServerError,
+		})
+		return
+	}
+
+	response := make([]dto.CaseTemplateResponseDTO, 0, len(templates))
+	for i := range templates {
+		response = append(response, toCaseTemplateResponse(&templates[i]))
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// GetTemplate godoc
+// @Summary Get a case template
+// @Description Get a case template by ID or name
+// @Tags case-templates
+// @Accept json
+// @Produce json
+// @Param id path string true "Template ID or name"
+// @Success 200 {object} dto.CaseTemplateResponseDTO
+// @Failure 404 {object} dto.ErrorDTO "Template not found"
+// @Security BasicAuth
+// @Router /case-templates/{id} [get]
+func (ctrl *CaseTemplateController) GetTemplate(c *gin.Context) {
+	template, err := ctrl.templateService.GetTemplate(c.Param("id"))
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toCaseTemplateResponse(template))
+}
+
+// CreateTemplate godoc
+// @Summary Create a case template
+// @Description Define the defaults, checklist tasks, tags and custom field defaults of cases of a recurring crime type
+// @Tags case-templates
+// @Accept json
+// @Produce json
+// @Param template body dto.CaseTemplateDTO true "Case template"
+// @Success 201 {object} dto.CaseTemplateResponseDTO
+// @Failure 400 {object} dto.ErrorDTO "Invalid template"
+// @Failure 404 {object} dto.ErrorDTO "Tag not found"
+// @Failure 409 {object} dto.ErrorDTO "Template name already in use"
+// @Security BasicAuth
+// @Router /case-templates [post]
+func (ctrl *CaseTemplateController) CreateTemplate(c *gin.Context) {
+	var templateDTO dto.CaseTemplateDTO
+	if err := c.ShouldBindJSON(&templateDTO); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid template data",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	template, err := ctrl.templateService.CreateTemplate(toCaseTemplate(&templateDTO), templateDTO.TagIDs)
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, toCaseTemplateResponse(template))
+}
+
+// UpdateTemplate godoc
+// @Summary Replace a case template
+// @Description Replace a case template. Cases already created from it are not changed.
+// @Tags case-templates
+// @Accept json
+// @Produce json
+// @Param id path int true "Template ID"
+// @Param template body dto.CaseTemplateDTO true "Case template"
+// @Success 200 {object} dto.CaseTemplateResponseDTO
+// @Failure 400 {object} dto.ErrorDTO "Invalid template"
+// @Failure 404 {object} dto.ErrorDTO "Template or tag not found"
+// @Failure 409 {object} dto.ErrorDTO "Template name already in use"
+// @Security BasicAuth
+// @Router /case-templates/{id} [put]
+func (ctrl *CaseTemplateController) UpdateTemplate(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid template ID",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	var templateDTO dto.CaseTemplateDTO
+	if err := c.ShouldBindJSON(&templateDTO); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid template data",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	template, err := ctrl.templateService.UpdateTemplate(uint(id), toCaseTemplate(&templateDTO), templateDTO.TagIDs)
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toCaseTemplateResponse(template))
+}
+
+// DeleteTemplate godoc
+// @Summary Delete a case template
+// @Description Remove a case template. Cases created from it are kept as they are.
+// @Tags case-templates
+// @Accept json
+// @Produce json
+// @Param id path int true "Template ID"
+// @Success 200 {object} map[string]string "Success message"
+// @Failure 400 {object} dto.ErrorDTO "Invalid template ID"
+// @Failure 404 {object} dto.ErrorDTO "Template not found"
+// @Security BasicAuth
+// @Router /case-templates/{id} [delete]
+func (ctrl *CaseTemplateController) DeleteTemplate(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid template ID",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	if err := ctrl.templateService.DeleteTemplate(uint(id)); err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Case template deleted successfully"})
+}
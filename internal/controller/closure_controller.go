@@ -0,0 +1,353 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/m7medVision/crime-management-system/internal/dto"
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"github.com/m7medVision/crime-management-system/internal/service"
+)
+
+type ClosureController struct {
+	closureService *service.ClosureService
+}
+
+func NewClosureController(closureService *service.ClosureService) *ClosureController {
+	return &ClosureController{closureService: closureService}
+}
+
+func toClosureResponse(closure *model.CaseClosure) dto.ClosureResponseDTO {
+	response := dto.ClosureResponseDTO{
+		ID:              closure.ID,
+		CaseID:          closure.CaseID,
+		ReferenceNumber: closure.Case.ReferenceNumber,
+		Disposition:     string(closure.Disposition),
+		Summary:         closure.Summary,
+		Checklist: dto.ClosureChecklistDTO{
+			EvidenceReviewed:   closure.Checklist.EvidenceReviewed,
+			TasksDone:          closure.Checklist.TasksDone,
+			SuspectStatusesSet: closure.Checklist.SuspectStatusesSet,
+		},
+		Status:        string(closure.Status),
+		RequestedByID: closure.RequestedByID,
+		RequestedBy:   closure.RequestedBy.FullName,
+		DecidedByID:   closure.DecidedByID,
+		Response:      closure.Response,
+		CreatedAt:     closure.CreatedAt.Format(time.RFC3339),
+	}
+	if closure.DecidedBy != nil {
+		response.DecidedBy = closure.DecidedBy.FullName
+	}
+	if closure.DecidedAt != nil {
+		response.DecidedAt = closure.DecidedAt.Format(time.RFC3339)
+	}
+	return response
+}
+
+func toClosureResponses(closures []model.CaseClosure) []dto.ClosureResponseDTO {
+	response := make([]dto.ClosureResponseDTO, 0, len(closures))
+	for i := range closures {
+		response = append(response, toClosureResponse(&closures[i]))
+	}
+	return response
+}
+
+// GetClosureCheck godoc
+// @Summary Check whether a case can be closed
+// @Description Get the evidence, open tasks and suspects the closure checklist covers, the dispositions a case can be closed with, whether your closure needs a supervisor's approval and any closure waiting for approval
+// @Tags cases,closures
+// @Accept json
+// @Produce json
+// @Param id path string true "Case ID or reference number"
+// @Success 200 {object} dto.ClosureCheckDTO
+// @Failure 400 {object} dto.ErrorDTO "Invalid case ID"
+// @Failure 403 {object} dto.ErrorDTO "Insufficient clearance"
+// @Failure 404 {object} dto.ErrorDTO "Case not found"
+// @Security BasicAuth
+// @Router /cases/{id}/closure [get]
+func (ctrl *ClosureController) GetClosureCheck(c *gin.Context) {
+	caseID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid case ID",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	check, err := ctrl.closureService.CheckClosure(user.(*model.User), uint(caseID))
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	response := dto.ClosureCheckDTO{
+		Evidence:         check.Evidence,
+		OpenTasks:        check.OpenTasks,
+		Suspects:         check.Suspects,
+		ArrestedSuspects: check.ArrestedSuspects,
+		ApprovalRequired: check.ApprovalRequired,
+		Dispositions:     make([]string, 0, len(model.CaseDispositions)),
+	}
+	for _, disposition := range model.CaseDispositions {
+		response.Dispositions = append(response.Dispositions, string(disposition))
+	}
+	if check.Pending != nil {
+		pending := toClosureResponse(check.Pending)
+		response.Pending = &pending
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// CloseCase godoc
+// @Summary Close a case
+// @Description Close a case with a disposition and closing summary. Every checklist item must be confirmed, the case must have no open tasks and solved_arrest needs an arrested suspect. When supervisor approval is required the closure waits for approval and the case stays open (202). Officers must be assigned to the case.
+// @Tags cases,closures
+// @Accept json
+// @Produce json
+// @Param id path string true "Case ID or reference number"
+// @Param If-Match header string false "ETag of the case version being closed"
+// @Param closure body dto.CloseCaseDTO true "Disposition, closing summary and checklist"
+// @Success 201 {object} dto.ClosureResponseDTO "Case closed"
+// @Success 202 {object} dto.ClosureResponseDTO "Closure waiting for approval"
+// @Failure 400 {object} dto.ErrorDTO "Invalid closure or incomplete checklist"
+// @Failure 403 {object} dto.ErrorDTO "Permission denied"
+// @Failure 404 {object} dto.ErrorDTO "Case not found"
+// @Failure 409 {object} dto.ErrorDTO "Case is closed, has open tasks or a closure is already pending"
+// @Failure 412 {object} dto.ErrorDTO "Case has been modified since it was read"
+// @Security BasicAuth
+// @Router /cases/{id}/close [post]
+func (ctrl *ClosureController) CloseCase(c *gin.Context) {
+	caseID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid case ID",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	var closeDTO dto.CloseCaseDTO
+	if err := c.ShouldBindJSON(&closeDTO); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid closure data",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	expectedVersion, err := ifMatchVersion(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	closure, err := ctrl.closureService.CloseCase(user.(*model.User), uint(caseID), service.ClosureRequest{
+		Disposition: closeDTO.Disposition,
+		Summary:     closeDTO.Summary,
+		Checklist: model.ClosureChecklist{
+			EvidenceReviewed:   closeDTO.Checklist.EvidenceReviewed,
+			TasksDone:          closeDTO.Checklist.TasksDone,
+			SuspectStatusesSet: closeDTO.Checklist.SuspectStatusesSet,
+		},
+	}, expectedVersion)
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	status := http.StatusCreated
+	if closure.Status == model.ClosurePending {
+		status = http.StatusAccepted
+	}
+	c.JSON(status, toClosureResponse(closure))
+}
+
+// ListCaseClosures godoc
+// @Summary List the closures of a case
+// @Description Get the closure history of a case, including closures that were rejected, newest first
+// @Tags cases,closures
+// @Accept json
+// @Produce json
+// @Param id path string true "Case ID or reference number"
+// @Success 200 {array} dto.ClosureResponseDTO
+// @Failure 400 {object} dto.ErrorDTO "Invalid case ID"
+// @Failure 403 {object} dto.ErrorDTO "Insufficient clearance"
+// @Failure 404 {object} dto.ErrorDTO "Case not found"
+// @Security BasicAuth
+// @Router /cases/{id}/closures [get]
+func (ctrl *ClosureController) ListCaseClosures(c *gin.Context) {
+	caseID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid case ID",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	closures, err := ctrl.closureService.ListCaseClosures(user.(*model.User), uint(caseID))
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toClosureResponses(closures))
+}
+
+// ListPendingClosures godoc
+// @Summary List closures waiting for approval
+// @Description Get the closures waiting for a supervisor's approval on cases within your clearance, oldest first
+// @Tags closures
+// @Accept json
+// @Produce json
+// @Success 200 {array} dto.ClosureResponseDTO
+// @Failure 403 {object} dto.ErrorDTO "Supervisor access required"
+// @Failure 500 {object} dto.ErrorDTO "Server error"
+// @Security BasicAuth
+// @Router /closures/pending [get]
+func (ctrl *ClosureController) ListPendingClosures(c *gin.Context) {
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	closures, err := ctrl.closureService.ListPending(user.(*model.User))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    http.StatusInternalServerError,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toClosureResponses(closures))
+}
+
+// ApproveClosure godoc
+// @Summary Approve a closure
+// @Description Close the case of a pending closure with its disposition. The checks are run again, since the case may have changed while the closure was waiting.
+// @Tags closures
+// @Accept json
+// @Produce json
+// @Param id path int true "Closure ID"
+// @Param decision body dto.ClosureDecisionDTO false "Optional response to the requester"
+// @Success 200 {object} dto.ClosureResponseDTO
+// @Failure 400 {object} dto.ErrorDTO "Invalid closure ID"
+// @Failure 403 {object} dto.ErrorDTO "Supervisor access required"
+// @Failure 404 {object} dto.ErrorDTO "Closure not found"
+// @Failure 409 {object} dto.ErrorDTO "Closure is no longer pending or the case cannot be closed"
+// @Security BasicAuth
+// @Router /closures/{id}/approve [post]
+func (ctrl *ClosureController) ApproveClosure(c *gin.Context) {
+	ctrl.decide(c, ctrl.closureService.Approve)
+}
+
+// RejectClosure godoc
+// @Summary Reject a closure
+// @Description Turn down a pending closure with a reason. The case stays open.
+// @Tags closures
+// @Accept json
+// @Produce json
+// @Param id path int true "Closure ID"
+// @Param decision body dto.ClosureDecisionDTO true "Reason for the rejection"
+// @Success 200 {object} dto.ClosureResponseDTO
+// @Failure 400 {object} dto.ErrorDTO "Invalid closure ID or missing reason"
+// @Failure 403 {object} dto.ErrorDTO "Supervisor access required"
+// @Failure 404 {object} dto.ErrorDTO "Closure not found"
+// @Failure 409 {object} dto.ErrorDTO "Closure is no longer pending"
+// @Security BasicAuth
+// @Router /closures/{id}/reject [post]
+func (ctrl *ClosureController) RejectClosure(c *gin.Context) {
+	ctrl.decide(c, ctrl.closureService.Reject)
+}
+
+// decide runs an approval decision on the closure in the path
+func (ctrl *ClosureController) decide(c *gin.Context, action func(user *model.User, id uint, response string) (*model.CaseClosure, error)) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid closure ID",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	// The body is optional for approvals
+	var decisionDTO dto.ClosureDecisionDTO
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&decisionDTO); err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+				Message: "Invalid decision data",
+				Code:    http.StatusBadRequest,
+			})
+			return
+		}
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	closure, err := action(user.(*model.User), uint(id), decisionDTO.Response)
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toClosureResponse(closure))
+}
@@ -0,0 +1,306 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/m7medVision/crime-management-system/internal/dto"
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"github.com/m7medVision/crime-management-system/internal/service"
+)
+
+type CommentController struct {
+	commentService *service.CommentService
+}
+
+func NewCommentController(commentService *service.CommentService) *CommentController {
+	return &CommentController{commentService: commentService}
+}
+
+func toCommentResponse(comment *model.Comment) dto.CommentResponseDTO {
+	response := dto.CommentResponseDTO{
+		ID:        comment.ID,
+		CaseID:    comment.CaseID,
+		ParentID:  comment.ParentID,
+		Content:   comment.Content,
+		AuthorID:  comment.UserID,
+		Author:    comment.User.FullName,
+		IsDeleted: comment.IsDeleted,
+		CreatedAt: comment.CreatedAt.Format(time.RFC3339),
+	}
+	if comment.EditedAt != nil {
+		response.EditedAt = comment.EditedAt.Format(time.RFC3339)
+	}
+	// Deleted comments stay in the thread as placeholders
+	if comment.IsDeleted {
+		response.Content = ""
+	}
+	return response
+}
+
+// CreateComment godoc
+// @Summary Add a comment to a case
+// @Description Post a comment or a reply on a case. Users mentioned with @username are notified if they are cleared for the case.
+// @Tags cases,comments
+// @Accept json
+// @Produce json
+// @Param id path string true "Case ID or reference number"
+// @Param comment body dto.CreateCommentDTO true "Comment content"
+// @Success 201 {object} dto.CommentResponseDTO
+// @Failure 400 {object} dto.ErrorDTO "Invalid comment data"
+// @Failure 403 {object} dto.ErrorDTO "Insufficient clearance level"
+// @Failure 404 {object} dto.ErrorDTO "Case or parent comment not found"
+// @Security BasicAuth
+// @Router /cases/{id}/comments [post]
+func (ctrl *CommentController) CreateComment(c *gin.Context) {
+	caseID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid case ID",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	var commentDTO dto.CreateCommentDTO
+	if err := c.ShouldBindJSON(&commentDTO); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid comment data",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	comment, err := ctrl.commentService.CreateComment(user.(*model.User), uint(caseID), commentDTO.ParentID, commentDTO.Content, c.ClientIP())
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, toCommentResponse(comment))
+}
+
+// ListComments godoc
+// @Summary List case comments
+// @Description Get a paginated list of comment threads on a case, newest first, each with its replies
+// @Tags cases,comments
+// @Accept json
+// @Produce json
+// @Param id path string true "Case ID or reference number"
+// @Param offset query int false "Pagination offset" default(0)
+// @Param limit query int false "Threads per page" default(10)
+// @Success 200 {object} map[string]interface{} "comments and total count"
+// @Failure 400 {object} dto.ErrorDTO "Invalid case ID"
+// @Failure 403 {object} dto.ErrorDTO "Insufficient clearance level"
+// @Failure 404 {object} dto.ErrorDTO "Case not found"
+// @Security BasicAuth
+// @Router /cases/{id}/comments [get]
+func (ctrl *CommentController) ListComments(c *gin.Context) {
+	caseID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid case ID",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	threads, total, err := ctrl.commentService.ListCaseComments(user.(*model.User), uint(caseID), offset, limit)
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	comments := make([]dto.CommentResponseDTO, 0, len(threads))
+	for i := range threads {
+		response := toCommentResponse(&threads[i].Comment)
+		for j := range threads[i].Replies {
+			response.Replies = append(response.Replies, toCommentResponse(&threads[i].Replies[j]))
+		}
+		comments = append(comments, response)
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"comments": comments,
+		"total":    total,
+	})
+}
+
+// UpdateComment godoc
+// @Summary Edit a comment
+// @Description Edit a comment's content. Only the author may edit; the previous content is kept in the edit history.
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Param id path int true "Comment ID"
+// @Param comment body dto.UpdateCommentDTO true "New content"
+// @Success 200 {object} dto.CommentResponseDTO
+// @Failure 400 {object} dto.ErrorDTO "Invalid comment data"
+// @Failure 403 {object} dto.ErrorDTO "Permission denied"
+// @Failure 404 {object} dto.ErrorDTO "Comment not found"
+// @Security BasicAuth
+// @Router /comments/{id} [put]
+func (ctrl *CommentController) UpdateComment(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid comment ID",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	var updateDTO dto.UpdateCommentDTO
+	if err := c.ShouldBindJSON(&updateDTO); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid comment data",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	comment, err := ctrl.commentService.UpdateComment(user.(*model.User), uint(id), updateDTO.Content)
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toCommentResponse(comment))
+}
+
+// DeleteComment godoc
+// @Summary Delete a comment
+// @Description Soft delete a comment. Only the author or an admin may delete; replies remain in the thread.
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Param id path int true "Comment ID"
+// @Success 200 {object} map[string]string "Success message"
+// @Failure 400 {object} dto.ErrorDTO "Invalid comment ID"
+// @Failure 403 {object} dto.ErrorDTO "Permission denied"
+// @Failure 404 {object} dto.ErrorDTO "Comment not found"
+// @Security BasicAuth
+// @Router /comments/{id} [delete]
+func (ctrl *CommentController) DeleteComment(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid comment ID",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	if err := ctrl.commentService.DeleteComment(user.(*model.User), uint(id)); err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Comment deleted successfully"})
+}
+
+// GetCommentHistory godoc
+// @Summary Get comment edit history
+// @Description Retrieve the previous versions of a comment, oldest first
+// @Tags comments
+// @Accept json
+// @Produce json
+// @Param id path int true "Comment ID"
+// @Success 200 {array} dto.CommentRevisionDTO
+// @Failure 400 {object} dto.ErrorDTO "Invalid comment ID"
+// @Failure 403 {object} dto.ErrorDTO "Insufficient clearance level"
+// @Failure 404 {object} dto.ErrorDTO "Comment not found"
+// @Security BasicAuth
+// @Router /comments/{id}/history [get]
+func (ctrl *CommentController) GetCommentHistory(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid comment ID",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	revisions, err := ctrl.commentService.GetCommentHistory(user.(*model.User), uint(id))
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	response := make([]dto.CommentRevisionDTO, 0, len(revisions))
+	for _, revision := range revisions {
+		response = append(response, dto.CommentRevisionDTO{
+			Content:  revision.Content,
+			EditedBy: revision.EditedBy.FullName,
+			EditedAt: revision.CreatedAt.Format(time.RFC3339),
+		})
+	}
+
+	c.JSON(http.StatusOK, response)
+}
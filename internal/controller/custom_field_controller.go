@@ -0,0 +1,167 @@
+package controller
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/m7medVision/crime-management-system/internal/dto"
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"github.com/m7medVision/crime-management-system/internal/service"
+)
+
+type CustomFieldController struct {
+	customFieldService *service.CustomFieldService
+}
+
+func NewCustomFieldController(customFieldService *service.CustomFieldService) *CustomFieldController {
+	return &CustomFieldController{customFieldService: customFieldService}
+}
+
+func toCustomFieldSchemaResponse(schema *model.CustomFieldSchema) dto.CustomFieldSchemaResponseDTO {
+	fields := make([]dto.CustomFieldDefinitionDTO, 0, len(schema.Fields))
+	for _, field := range schema.Fields {
+		fields = append(fields, dto.CustomFieldDefinitionDTO{
+			Key:       field.Key,
+			Label:     field.Label,
+			Type:      string(field.Type),
+			Required:  field.Required,
+			Options:   field.Options,
+			Min:       field.Min,
+			Max:       field.Max,
+			MaxLength: field.MaxLength,
+			Pattern:   field.Pattern,
+		})
+	}
+	return dto.CustomFieldSchemaResponseDTO{
+		ID:        schema.ID,
+		CaseType:  schema.CaseType,
+		Fields:    fields,
+		UpdatedAt: schema.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+// ListSchemas godoc
+// @Summary List custom field schemas
+// @Description Get the custom fields defined for each case type
+// @Tags custom-fields
+// @Accept json
+// @Produce json
+// @Success 200 {array} dto.CustomFieldSchemaResponseDTO
+// @Failure 500 {object} dto.ErrorDTO "Server error"
+// @Security BasicAuth
+// @Router /custom-field-schemas [get]
+func (ctrl *CustomFieldController) ListSchemas(c *gin.Context) {
+	schemas, err := ctrl.customFieldService.ListSchemas()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    http.StatusInternalServerError,
+		})
+		return
+	}
+
+	response := make([]dto.CustomFieldSchemaResponseDTO, 0, len(schemas))
+	for i := range schemas {
+		response = append(response, toCustomFieldSchemaResponse(&schemas[i]))
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// GetSchema godoc
+// @Summary Get the custom fields of a case type
+// @Description Get the custom field schema that case values of this type are validated against
+// @Tags custom-fields
+// @Accept json
+// @Produce json
+// @Param caseType path string true "Case type"
+// @Success 200 {object} dto.CustomFieldSchemaResponseDTO
+// @Failure 404 {object} dto.ErrorDTO "No custom fields defined for the case type"
+// @Security BasicAuth
+// @Router /custom-field-schemas/{caseType} [get]
+func (ctrl *CustomFieldController) GetSchema(c *gin.Context) {
+	schema, err := ctrl.customFieldService.GetSchema(c.Param("caseType"))
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toCustomFieldSchemaResponse(schema))
+}
+
+// SaveSchema godoc
+// @Summary Create or replace the custom fields of a case type
+// @Description Define the structured fields cases of a type carry. Existing case values are validated against the new schema when the case is next updated.
+// @Tags custom-fields
+// @Accept json
+// @Produce json
+// @Param schema body dto.CustomFieldSchemaDTO true "Custom field schema"
+// @Success 200 {object} dto.CustomFieldSchemaResponseDTO
+// @Failure 400 {object} dto.ErrorDTO "Invalid schema"
+// @Security BasicAuth
+// @Router /custom-field-schemas [put]
+func (ctrl *CustomFieldController) SaveSchema(c *gin.Context) {
+	var schemaDTO dto.CustomFieldSchemaDTO
+	if err := c.ShouldBindJSON(&schemaDTO); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid schema data",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	fields := make(model.CustomFieldDefinitions, 0, len(schemaDTO.Fields))
+	for _, field := range schemaDTO.Fields {
+		fields = append(fields, model.CustomFieldDefinition{
+			Key:       field.Key,
+			Label:     field.Label,
+			Type:      model.CustomFieldType(field.Type),
+			Required:  field.Required,
+			Options:   field.Options,
+			Min:       field.Min,
+			Max:       field.Max,
+			MaxLength: field.MaxLength,
+			Pattern:   field.Pattern,
+		})
+	}
+
+	schema, err := ctrl.customFieldService.SaveSchema(&model.CustomFieldSchema{
+		CaseType: schemaDTO.CaseType,
+		Fields:   fields,
+	})
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toCustomFieldSchemaResponse(schema))
+}
+
+// DeleteSchema godoc
+// @Summary Delete the custom fields of a case type
+// @Description Remove a case type's custom field schema. Values already stored on cases are kept.
+// @Tags custom-fields
+// @Accept json
+// @Produce json
+// @Param caseType path string true "Case type"
+// @Success 200 {object} map[string]string "Success message"
+// @Failure 404 {object} dto.ErrorDTO "No custom fields defined for the case type"
+// @Security BasicAuth
+// @Router /custom-field-schemas/{caseType} [delete]
+func (ctrl *CustomFieldController) DeleteSchema(c *gin.Context) {
+	if err := ctrl.customFieldService.DeleteSchema(c.Param("caseType")); err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Custom field schema deleted successfully"})
+}
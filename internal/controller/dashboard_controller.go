@@ -0,0 +1,112 @@
+package controller
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/m7medVision/crime-management-system/internal/dto"
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"github.com/m7medVision/crime-management-system/internal/service"
+)
+
+type DashboardController struct {
+	dashboardService *service.DashboardService
+}
+
+func NewDashboardController(dashboardService *service.DashboardService) *DashboardController {
+	return &DashboardController{dashboardService: dashboardService}
+}
+
+func toDashboardCase(cas *model.Case, assignedAt *time.Time) dto.DashboardCaseDTO {
+	response := dto.DashboardCaseDTO{
+		ID:              cas.ID,
+		ReferenceNumber: cas.ReferenceNumber,
+		Name:            cas.Name,
+		Status:          string(cas.Status),
+		Priority:        string(cas.Priority),
+		SLAStatus:       string(cas.SLAStatus),
+	}
+	if cas.SLADueAt != nil {
+		response.SLADueAt = cas.SLADueAt.Format(time.RFC3339)
+	}
+	if assignedAt != nil {
+		response.AssignedAt = assignedAt.Format(time.RFC3339)
+	}
+	return response
+}
+
+// GetDashboard godoc
+// @Summary Get my dashboard
+// @Description Summary of the current user's workload: assigned cases by status, cases assigned in the last 7 days, overdue tasks, cases in breach of SLA, recent evidence on assigned cases and untriaged public reports in the user's area with their likely duplicates. Results are cached for a short time.
+// @Tags me
+// @Accept json
+// @Produce json
+// @Param refresh query bool false "Bypass the cache" default(false)
+// @Success 200 {object} dto.DashboardDTO
+// @Failure 401 {object} dto.ErrorDTO "Authentication required"
+// @Failure 500 {object} dto.ErrorDTO "Server error"
+// @Security BasicAuth
+// @Router /me/dashboard [get]
+func (ctrl *DashboardController) GetDashboard(c *gin.Context) {
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	dashboard, err := ctrl.dashboardService.GetDashboard(user.(*model.User), c.Query("refresh") == "true")
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    http.StatusInternalServerError,
+		})
+		return
+	}
+
+	response := dto.DashboardDTO{
+		CasesByStatus:         map[string]int64{},
+		NewlyAssignedCases:    make([]dto.DashboardCaseDTO, 0, len(dashboard.NewlyAssignedCases)),
+		OverdueTasks:          toTaskResponses(dashboard.OverdueTasks),
+		BreachedCases:         make([]dto.DashboardCaseDTO, 0, len(dashboard.BreachedCases)),
+		RecentEvidence:        make([]dto.DashboardEvidenceDTO, 0, len(dashboard.RecentEvidence)),
+		UntriagedReports:      make([]dto.DashboardReportDTO, 0, len(dashboard.UntriagedReports)),
+		UntriagedReportsTotal: dashboard.UntriagedReportsCount,
+		GeneratedAt:           dashboard.GeneratedAt.Format(time.RFC3339),
+	}
+	for status, count := range dashboard.CasesByStatus {
+		response.CasesByStatus[string(status)] = count
+		response.AssignedCases += count
+	}
+	for i := range dashboard.NewlyAssignedCases {
+		assigned := &dashboard.NewlyAssignedCases[i]
+		response.NewlyAssignedCases = append(response.NewlyAssignedCases, toDashboardCase(&assigned.Case, assigned.AssignedAt))
+	}
+	for i := range dashboard.BreachedCases {
+		response.BreachedCases = append(response.BreachedCases, toDashboardCase(&dashboard.BreachedCases[i], nil))
+	}
+	for _, evidence := range dashboard.RecentEvidence {
+		response.RecentEvidence = append(response.RecentEvidence, dto.DashboardEvidenceDTO{
+			ID:        evidence.ID,
+			CaseID:    evidence.CaseID,
+			Type:      string(evidence.Type),
+			Remarks:   evidence.Remarks,
+			AddedBy:   evidence.AddedBy.FullName,
+			CreatedAt: evidence.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	for _, report := range dashboard.UntriagedReports {
+		response.UntriagedReports = append(response.UntriagedReports, dto.DashboardReportDTO{
+			ID:               report.ID,
+			Title:            report.Title,
+			Location:         report.Location,
+			CreatedAt:        report.CreatedAt.Format(time.RFC3339),
+			LikelyDuplicates: toDuplicateMatches(dashboard.ReportDuplicates[report.ID]),
+		})
+	}
+
+	c.JSON(http.StatusOK, response)
+}
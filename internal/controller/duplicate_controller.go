@@ -0,0 +1,143 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/m7medVision/crime-management-system/internal/dto"
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"github.com/m7medVision/crime-management-system/internal/service"
+)
+
+type DuplicateController struct {
+	duplicateService *service.DuplicateService
+}
+
+func NewDuplicateController(duplicateService *service.DuplicateService) *DuplicateController {
+	return &DuplicateController{duplicateService: duplicateService}
+}
+
+func toDuplicateMatches(matches []service.DuplicateMatch) []dto.DuplicateMatchDTO {
+	response := make([]dto.DuplicateMatchDTO, 0, len(matches))
+	for _, match := range matches {
+		response = append(response, dto.DuplicateMatchDTO{
+			Kind:            string(match.Kind),
+			ID:              match.ID,
+			Title:           match.Title,
+			ReferenceNumber: match.ReferenceNumber,
+			CaseID:          match.CaseID,
+			CaseReference:   match.CaseReference,
+			Score:           match.Score,
+			TextScore:       match.TextScore,
+			LocationScore:   match.LocationScore,
+			TimeScore:       match.TimeScore,
+			DistanceKm:      match.DistanceKm,
+			CreatedAt:       match.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	return response
+}
+
+// FindDuplicates godoc
+// @Summary Find likely duplicates of a report
+// @Description Score a citizen report against the reports and open cases filed around the same time, on text similarity, location proximity and time. Matches from the configured minimum score are returned, best first, each with the case the report can be linked to.
+// @Tags reports
+// @Accept json
+// @Produce json
+// @Param id path int true "Report ID"
+// @Success 200 {array} dto.DuplicateMatchDTO
+// @Failure 400 {object} dto.ErrorDTO "Invalid report ID"
+// @Failure 404 {object} dto.ErrorDTO "Report not found"
+// @Security BasicAuth
+// @Router /reports/{id}/duplicates [get]
+func (ctrl *DuplicateController) FindDuplicates(c *gin.Context) {
+	reportID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid report ID",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	matches, err := ctrl.duplicateService.FindDuplicates(user.(*model.User), uint(reportID))
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toDuplicateMatches(matches))
+}
+
+// LinkReportToCase godoc
+// @Summary Link a report to an existing case
+// @Description File a citizen report under a case, typically one suggested as a likely duplicate. The report leaves the triage list.
+// @Tags reports
+// @Accept json
+// @Produce json
+// @Param id path int true "Report ID"
+// @Param link body dto.LinkReportDTO true "Case to link the report to"
+// @Success 200 {object} dto.LinkedReportDTO
+// @Failure 400 {object} dto.ErrorDTO "Invalid report ID or link data"
+// @Failure 403 {object} dto.ErrorDTO "Insufficient clearance level"
+// @Failure 404 {object} dto.ErrorDTO "Report or case not found"
+// @Failure 409 {object} dto.ErrorDTO "Report already linked to the case"
+// @Security BasicAuth
+// @Router /reports/{id}/link [post]
+func (ctrl *DuplicateController) LinkReportToCase(c *gin.Context) {
+	reportID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid report ID",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	var linkDTO dto.LinkReportDTO
+	if err := c.ShouldBindJSON(&linkDTO); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid link data",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	report, err := ctrl.duplicateService.LinkReportToCase(user.(*model.User), uint(reportID), linkDTO.CaseID)
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	response := dto.LinkedReportDTO{ID: report.ID, Title: report.Title, CaseIDs: make([]uint, 0, len(report.Cases))}
+	for _, linked := range report.Cases {
+		response.CaseIDs = append(response.CaseIDs, linked.ID)
+	}
+	c.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,26 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/m7medVision/crime-management-system/internal/service"
+)
+
+// errorStatus maps errors returned by services to HTTP status codes
+func errorStatus(err error) int {
+	switch {
+	case errors.Is(err, service.ErrCaseNotFound), errors.Is(err, service.ErrNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, service.ErrInsufficientClearance), errors.Is(err, service.ErrForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, service.ErrInvalidInput):
+		return http.StatusBadRequest
+	case errors.Is(err, service.ErrConflict):
+		return http.StatusConflict
+	case errors.Is(err, service.ErrPreconditionFailed):
+		return http.StatusPreconditionFailed
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -50,9 +50,22 @@ func (ctrl *EvidenceController) CreateTextEvidence(c *gin.Context) {
 		return
 	}
 
+	caseID := evidenceDTO.CaseID
+	if evidenceDTO.CaseReference != "" {
+		resolvedID, err := ctrl.evidenceService.ResolveCaseID(evidenceDTO.CaseReference)
+		if err != nil {
+			c.JSON(http.StatusNotFound, dto.ErrorDTO{
+				Message: "Case not found",
+				Code:    http.StatusNotFound,
+			})
+			return
+		}
+		caseID = resolvedID
+	}
+
 	userID := user.(*model.User).ID
 	evidence, err := ctrl.evidenceService.CreateTextEvidence(
-		evidenceDTO.CaseID,
+		caseID,
 		userID,
 		evidenceDTO.Content,
 		evidenceDTO.Remarks,
@@ -74,7 +87,7 @@ func (ctrl *EvidenceController) CreateTextEvidence(c *gin.Context) {
 // @Tags evidence
 // @Accept multipart/form-data
 // @Produce json
-// @Param caseId formData int true "Case ID"
+// @Param caseId formData string true "Case ID or reference number"
 // @Param remarks formData string false "Optional remarks about the evidence"
 // @Param image formData file true "Image file"
 // @Success 201 {object} model.Evidence
@@ -84,7 +97,7 @@ func (ctrl *EvidenceController) CreateTextEvidence(c *gin.Context) {
 // @Security BasicAuth
 // @Router /evidence/image [post]
 func (ctrl *EvidenceController) CreateImageEvidence(c *gin.Context) {
-	caseID, err := strconv.Atoi(c.PostForm("caseId"))
+	caseID, err := ctrl.evidenceService.ResolveCaseID(c.PostForm("caseId"))
 	if err != nil {
 		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
 			Message: "Invalid case ID",
@@ -113,7 +126,7 @@ func (ctrl *EvidenceController) CreateImageEvidence(c *gin.Context) {
 	}
 
 	userID := user.(*model.User).ID
-	evidence, err := ctrl.evidenceService.CreateImageEvidence(uint(caseID), userID, file, remarks)
+	evidence, err := ctrl.evidenceService.CreateImageEvidence(caseID, userID, file, remarks)
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, dto.ErrorDTO{
 			Message: err.Error(),
@@ -156,6 +169,7 @@ func (ctrl *EvidenceController) GetEvidenceByID(c *gin.Context) {
 		return
 	}
 
+	setETag(c, evidence.Version)
 	c.JSON(http.StatusOK, evidence)
 }
 
@@ -202,18 +216,23 @@ func (ctrl *EvidenceController) GetEvidenceImage(c *gin.Context) {
 
 // UpdateEvidence godoc
 // @Summary Update evidence
-// @Description Update evidence remarks
+// @Description Update evidence remarks. Omitted remarks are left unchanged. Send the ETag from a previous read in If-Match to fail with 412 if someone else changed the evidence meanwhile.
 // @Tags evidence
 // @Accept json
 // @Produce json
 // @Param id path int true "Evidence ID"
+// @Param If-Match header string false "ETag of the evidence version being edited"
 // @Param evidence body dto.UpdateEvidenceDTO true "Updated evidence details"
 // @Success 200 {object} model.Evidence
 // @Failure 400 {object} dto.ErrorDTO "Invalid evidence ID or data"
 // @Failure 401 {object} dto.ErrorDTO "Authentication required"
+// @Failure 404 {object} dto.ErrorDTO "Evidence not found"
+// @Failure 409 {object} dto.ErrorDTO "Evidence changed during the update or is archived with its closed case"
+// @Failure 412 {object} dto.ErrorDTO "Evidence has been modified since it was read"
 // @Failure 500 {object} dto.ErrorDTO "Server error"
 // @Security BasicAuth
 // @Router /evidence/{id} [put]
+// @Router /evidence/{id} [patch]
 func (ctrl *EvidenceController) UpdateEvidence(c *gin.Context) {
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
@@ -233,6 +252,15 @@ func (ctrl *EvidenceController) UpdateEvidence(c *gin.Context) {
 		return
 	}
 
+	expectedVersion, err := ifMatchVersion(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
 	user, exists := c.Get("user")
 	if !exists {
 		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
@@ -243,15 +271,16 @@ func (ctrl *EvidenceController) UpdateEvidence(c *gin.Context) {
 	}
 
 	userID := user.(*model.User).ID
-	evidence, err := ctrl.evidenceService.UpdateEvidence(uint(id), userID, updateDTO.Remarks)
+	evidence, err := ctrl.evidenceService.UpdateEvidence(uint(id), userID, updateDTO.Remarks, expectedVersion)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorDTO{
+		c.JSON(errorStatus(err), dto.ErrorDTO{
 			Message: err.Error(),
-			Code:    http.StatusInternalServerError,
+			Code:    errorStatus(err),
 		})
 		return
 	}
 
+	setETag(c, evidence.Version)
 	c.JSON(http.StatusOK, evidence)
 }
 
@@ -265,6 +294,7 @@ func (ctrl *EvidenceController) UpdateEvidence(c *gin.Context) {
 // @Success 200 {object} map[string]string "Success message"
 // @Failure 400 {object} dto.ErrorDTO "Invalid evidence ID"
 // @Failure 401 {object} dto.ErrorDTO "Authentication required"
+// @Failure 409 {object} dto.ErrorDTO "Evidence is archived with its closed case"
 // @Failure 500 {object} dto.ErrorDTO "Server error"
 // @Security BasicAuth
 // @Router /evidence/{id} [delete]
@@ -290,9 +320,9 @@ func (ctrl *EvidenceController) SoftDeleteEvidence(c *gin.Context) {
 	userID := user.(*model.User).ID
 	err = ctrl.evidenceService.SoftDeleteEvidence(uint(id), userID)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorDTO{
+		c.JSON(errorStatus(err), dto.ErrorDTO{
 			Message: err.Error(),
-			Code:    http.StatusInternalServerError,
+			Code:    errorStatus(err),
 		})
 		return
 	}
@@ -0,0 +1,365 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/m7medVision/crime-management-system/internal/dto"
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"github.com/m7medVision/crime-management-system/internal/service"
+)
+
+type HandoverController struct {
+	handoverService *service.HandoverService
+}
+
+func NewHandoverController(handoverService *service.HandoverService) *HandoverController {
+	return &HandoverController{handoverService: handoverService}
+}
+
+func toHandoverResponse(handover *model.CaseHandover) dto.HandoverResponseDTO {
+	response := dto.HandoverResponseDTO{
+		ID:              handover.ID,
+		CaseID:          handover.CaseID,
+		ReferenceNumber: handover.Case.ReferenceNumber,
+		FromUserID:      handover.FromUserID,
+		FromUser:        handover.FromUser.FullName,
+		ToUserID:        handover.ToUserID,
+		ToUser:          handover.ToUser.FullName,
+		Role:            string(handover.Role),
+		Note:            handover.Note,
+		Status:          string(handover.Status),
+		RequestedByID:   handover.RequestedByID,
+		Response:        handover.Response,
+		CreatedAt:       handover.CreatedAt.Format(time.RFC3339),
+	}
+	if handover.DecidedAt != nil {
+		response.DecidedAt = handover.DecidedAt.Format(time.RFC3339)
+	}
+	return response
+}
+
+func toHandoverResponses(handovers []model.CaseHandover) []dto.HandoverResponseDTO {
+	response := make([]dto.HandoverResponseDTO, 0, len(handovers))
+	for i := range handovers {
+		response = append(response, toHandoverResponse(&handovers[i]))
+	}
+	return response
+}
+
+func toHandoverBatchResult(result *service.HandoverBatchResult) dto.HandoverBatchResultDTO {
+	response := dto.HandoverBatchResultDTO{
+		Requested: result.Requested,
+		Failed:    result.Failed,
+		Items:     make([]dto.HandoverBatchItemDTO, 0, len(result.Items)),
+	}
+	for _, item := range result.Items {
+		itemDTO := dto.HandoverBatchItemDTO{
+			CaseID:          item.CaseID,
+			ReferenceNumber: item.ReferenceNumber,
+			Error:           item.Error,
+		}
+		if item.Handover != nil {
+			handover := toHandoverResponse(item.Handover)
+			itemDTO.Handover = &handover
+		}
+		response.Items = append(response.Items, itemDTO)
+	}
+	return response
+}
+
+// RequestHandover godoc
+// @Summary Request a case handover
+// @Description Ask an investigator to take over your assignment on a case, with a handover note. You stay assigned until they accept. Admins may hand over someone else's assignment with fromUserId.
+// @Tags cases,handovers
+// @Accept json
+// @Produce json
+// @Param id path string true "Case ID or reference number"
+// @Param handover body dto.CreateHandoverDTO true "Incoming investigator and handover note"
+// @Success 201 {object} dto.HandoverResponseDTO
+// @Failure 400 {object} dto.ErrorDTO "Invalid handover data"
+// @Failure 403 {object} dto.ErrorDTO "Permission denied"
+// @Failure 404 {object} dto.ErrorDTO "Case or user not found"
+// @Failure 409 {object} dto.ErrorDTO "A handover is already pending"
+// @Security BasicAuth
+// @Router /cases/{id}/handovers [post]
+func (ctrl *HandoverController) RequestHandover(c *gin.Context) {
+	caseID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid case ID",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	var handoverDTO dto.CreateHandoverDTO
+	if err := c.ShouldBindJSON(&handoverDTO); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid handover data",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+	currentUser := user.(*model.User)
+
+	fromUserID := currentUser.ID
+	if handoverDTO.FromUserID != nil {
+		fromUserID = *handoverDTO.FromUserID
+	}
+
+	handover, err := ctrl.handoverService.RequestHandover(currentUser, uint(caseID), fromUserID, handoverDTO.ToUserID, handoverDTO.Note)
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, toHandoverResponse(handover))
+}
+
+// ListCaseHandovers godoc
+// @Summary List case handovers
+// @Description Get the handover history of a case, newest first
+// @Tags cases,handovers
+// @Accept json
+// @Produce json
+// @Param id path string true "Case ID or reference number"
+// @Success 200 {array} dto.HandoverResponseDTO
+// @Failure 400 {object} dto.ErrorDTO "Invalid case ID"
+// @Failure 403 {object} dto.ErrorDTO "Insufficient clearance level"
+// @Failure 404 {object} dto.ErrorDTO "Case not found"
+// @Security BasicAuth
+// @Router /cases/{id}/handovers [get]
+func (ctrl *HandoverController) ListCaseHandovers(c *gin.Context) {
+	caseID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid case ID",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	handovers, err := ctrl.handoverService.ListCaseHandovers(user.(*model.User), uint(caseID))
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toHandoverResponses(handovers))
+}
+
+// ListMyHandovers godoc
+// @Summary List my handovers
+// @Description Get the handovers you are handing over or asked to accept, newest first
+// @Tags handovers
+// @Accept json
+// @Produce json
+// @Param direction query string false "incoming or outgoing, both when omitted"
+// @Param status query string false "pending (default), accepted, declined, cancelled or all"
+// @Success 200 {array} dto.HandoverResponseDTO
+// @Failure 400 {object} dto.ErrorDTO "Invalid filter"
+// @Security BasicAuth
+// @Router /me/handovers [get]
+func (ctrl *HandoverController) ListMyHandovers(c *gin.Context) {
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	status := model.HandoverStatus(c.DefaultQuery("status", string(model.HandoverPending)))
+	if status == "all" {
+		status = ""
+	}
+
+	handovers, err := ctrl.handoverService.ListMyHandovers(user.(*model.User), c.Query("direction"), status)
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toHandoverResponses(handovers))
+}
+
+// HandOverAllMyCases godoc
+// @Summary Hand over all my cases
+// @Description Request a handover to one investigator for every open case you are assigned to. Each case is handled separately and reported in the result.
+// @Tags handovers
+// @Accept json
+// @Produce json
+// @Param handover body dto.HandOverAllDTO true "Incoming investigator and handover note"
+// @Success 200 {object} dto.HandoverBatchResultDTO
+// @Failure 400 {object} dto.ErrorDTO "Invalid handover data"
+// @Failure 404 {object} dto.ErrorDTO "User not found"
+// @Security BasicAuth
+// @Router /me/handovers/all [post]
+func (ctrl *HandoverController) HandOverAllMyCases(c *gin.Context) {
+	var handoverDTO dto.HandOverAllDTO
+	if err := c.ShouldBindJSON(&handoverDTO); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid handover data",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+	currentUser := user.(*model.User)
+
+	result, err := ctrl.handoverService.HandOverAll(currentUser, currentUser.ID, handoverDTO.ToUserID, handoverDTO.Note)
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toHandoverBatchResult(result))
+}
+
+// AcceptHandover godoc
+// @Summary Accept a handover
+// @Description Take over the assignment described by a pending handover. The outgoing user is unassigned and the acceptance is recorded in the case history.
+// @Tags handovers
+// @Accept json
+// @Produce json
+// @Param id path int true "Handover ID"
+// @Param decision body dto.HandoverDecisionDTO false "Optional response"
+// @Success 200 {object} dto.HandoverResponseDTO
+// @Failure 400 {object} dto.ErrorDTO "Invalid handover ID"
+// @Failure 403 {object} dto.ErrorDTO "Only the incoming investigator can accept"
+// @Failure 404 {object} dto.ErrorDTO "Handover not found"
+// @Failure 409 {object} dto.ErrorDTO "Handover is no longer pending"
+// @Security BasicAuth
+// @Router /handovers/{id}/accept [post]
+func (ctrl *HandoverController) AcceptHandover(c *gin.Context) {
+	ctrl.decide(c, func(user *model.User, id uint, response string) (*model.CaseHandover, error) {
+		return ctrl.handoverService.Accept(user, id, response)
+	})
+}
+
+// DeclineHandover godoc
+// @Summary Decline a handover
+// @Description Refuse a pending handover with a reason. The outgoing user stays assigned.
+// @Tags handovers
+// @Accept json
+// @Produce json
+// @Param id path int true "Handover ID"
+// @Param decision body dto.HandoverDecisionDTO true "Reason for declining"
+// @Success 200 {object} dto.HandoverResponseDTO
+// @Failure 400 {object} dto.ErrorDTO "Invalid handover ID or missing reason"
+// @Failure 403 {object} dto.ErrorDTO "Only the incoming investigator can decline"
+// @Failure 404 {object} dto.ErrorDTO "Handover not found"
+// @Failure 409 {object} dto.ErrorDTO "Handover is no longer pending"
+// @Security BasicAuth
+// @Router /handovers/{id}/decline [post]
+func (ctrl *HandoverController) DeclineHandover(c *gin.Context) {
+	ctrl.decide(c, func(user *model.User, id uint, response string) (*model.CaseHandover, error) {
+		return ctrl.handoverService.Decline(user, id, response)
+	})
+}
+
+// CancelHandover godoc
+// @Summary Cancel a handover
+// @Description Withdraw a pending handover. Allowed for the outgoing user, whoever requested it and admins.
+// @Tags handovers
+// @Accept json
+// @Produce json
+// @Param id path int true "Handover ID"
+// @Success 200 {object} dto.HandoverResponseDTO
+// @Failure 400 {object} dto.ErrorDTO "Invalid handover ID"
+// @Failure 403 {object} dto.ErrorDTO "Permission denied"
+// @Failure 404 {object} dto.ErrorDTO "Handover not found"
+// @Failure 409 {object} dto.ErrorDTO "Handover is no longer pending"
+// @Security BasicAuth
+// @Router /handovers/{id}/cancel [post]
+func (ctrl *HandoverController) CancelHandover(c *gin.Context) {
+	ctrl.decide(c, func(user *model.User, id uint, _ string) (*model.CaseHandover, error) {
+		return ctrl.handoverService.Cancel(user, id)
+	})
+}
+
+// decide runs one of the handover decisions on the handover in the path
+func (ctrl *HandoverController) decide(c *gin.Context, action func(user *model.User, id uint, response string) (*model.CaseHandover, error)) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid handover ID",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	// The body is optional for accept and cancel
+	var decisionDTO dto.HandoverDecisionDTO
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&decisionDTO); err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+				Message: "Invalid decision data",
+				Code:    http.StatusBadRequest,
+			})
+			return
+		}
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	handover, err := action(user.(*model.User), uint(id), decisionDTO.Response)
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toHandoverResponse(handover))
+}
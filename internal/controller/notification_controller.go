@@ -0,0 +1,258 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/m7medVision/crime-management-system/internal/dto"
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"github.com/m7medVision/crime-management-system/internal/service"
+)
+
+type NotificationController struct {
+	notificationService *service.NotificationService
+}
+
+func NewNotificationController(notificationService *service.NotificationService) *NotificationController {
+	return &NotificationController{notificationService: notificationService}
+}
+
+func toNotificationResponse(notification *model.Notification) dto.NotificationResponseDTO {
+	response := dto.NotificationResponseDTO{
+		ID:        notification.ID,
+		Type:      string(notification.Type),
+		Title:     notification.Title,
+		Message:   notification.Message,
+		CaseID:    notification.CaseID,
+		ActorID:   notification.ActorID,
+		Read:      notification.ReadAt != nil,
+		CreatedAt: notification.CreatedAt.Format(time.RFC3339),
+	}
+	if notification.ReadAt != nil {
+		response.ReadAt = notification.ReadAt.Format(time.RFC3339)
+	}
+	return response
+}
+
+func toNotificationPreferences(preferences []service.NotificationPreference) []dto.NotificationPreferenceDTO {
+	response := make([]dto.NotificationPreferenceDTO, 0, len(preferences))
+	for _, preference := range preferences {
+		response = append(response, dto.NotificationPreferenceDTO{
+			Type:  string(preference.Type),
+			InApp: preference.InApp,
+			Email: preference.Email,
+		})
+	}
+	return response
+}
+
+// ListNotifications godoc
+// @Summary List my notifications
+// @Description Get a paginated list of the current user's notifications, newest first
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Param unread query bool false "Only list unread notifications"
+// @Param offset query int false "Pagination offset" default(0)
+// @Param limit query int false "Items per page" default(10)
+// @Success 200 {object} map[string]interface{} "notifications, total count and unread count"
+// @Failure 401 {object} dto.ErrorDTO "Authentication required"
+// @Failure 500 {object} dto.ErrorDTO "Server error"
+// @Security BasicAuth
+// @Router /me/notifications [get]
+func (ctrl *NotificationController) ListNotifications(c *gin.Context) {
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	unreadOnly := c.Query("unread") == "true"
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	notifications, total, unread, err := ctrl.notificationService.ListNotifications(user.(*model.User).ID, unreadOnly, offset, limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    http.StatusInternalServerError,
+		})
+		return
+	}
+
+	response := make([]dto.NotificationResponseDTO, 0, len(notifications))
+	for i := range notifications {
+		response = append(response, toNotificationResponse(&notifications[i]))
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"notifications": response,
+		"total":         total,
+		"unreadCount":   unread,
+	})
+}
+
+// MarkNotificationRead godoc
+// @Summary Mark a notification as read
+// @Description Mark one of the current user's notifications as read
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Param id path int true "Notification ID"
+// @Success 200 {object} map[string]string "Success message"
+// @Failure 400 {object} dto.ErrorDTO "Invalid notification ID"
+// @Failure 404 {object} dto.ErrorDTO "Notification not found"
+// @Security BasicAuth
+// @Router /me/notifications/{id}/read [post]
+func (ctrl *NotificationController) MarkNotificationRead(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid notification ID",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	if err := ctrl.notificationService.MarkRead(user.(*model.User).ID, uint(id)); err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Notification marked as read"})
+}
+
+// MarkAllNotificationsRead godoc
+// @Summary Mark all notifications as read
+// @Description Mark every unread notification of the current user as read
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Success 200 {object} map[string]interface{} "Number of notifications marked as read"
+// @Failure 401 {object} dto.ErrorDTO "Authentication required"
+// @Failure 500 {object} dto.ErrorDTO "Server error"
+// @Security BasicAuth
+// @Router /me/notifications/read-all [post]
+func (ctrl *NotificationController) MarkAllNotificationsRead(c *gin.Context) {
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	count, err := ctrl.notificationService.MarkAllRead(user.(*model.User).ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    http.StatusInternalServerError,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"marked": count})
+}
+
+// GetNotificationPreferences godoc
+// @Summary Get my notification preferences
+// @Description Get how the current user receives each notification type. Types without a saved preference are delivered in-app only.
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Success 200 {array} dto.NotificationPreferenceDTO
+// @Failure 401 {object} dto.ErrorDTO "Authentication required"
+// @Failure 500 {object} dto.ErrorDTO "Server error"
+// @Security BasicAuth
+// @Router /me/notification-preferences [get]
+func (ctrl *NotificationController) GetNotificationPreferences(c *gin.Context) {
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	preferences, err := ctrl.notificationService.GetPreferences(user.(*model.User).ID)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    http.StatusInternalServerError,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toNotificationPreferences(preferences))
+}
+
+// UpdateNotificationPreferences godoc
+// @Summary Update my notification preferences
+// @Description Choose in-app and email delivery per notification type. Types that are not listed keep their current setting.
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Param preferences body dto.UpdateNotificationPreferencesDTO true "Preferences per notification type"
+// @Success 200 {array} dto.NotificationPreferenceDTO
+// @Failure 400 {object} dto.ErrorDTO "Invalid preferences"
+// @Failure 401 {object} dto.ErrorDTO "Authentication required"
+// @Security BasicAuth
+// @Router /me/notification-preferences [put]
+func (ctrl *NotificationController) UpdateNotificationPreferences(c *gin.Context) {
+	var preferencesDTO dto.UpdateNotificationPreferencesDTO
+	if err := c.ShouldBindJSON(&preferencesDTO); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid preferences data",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	changes := make([]service.NotificationPreference, 0, len(preferencesDTO.Preferences))
+	for _, preference := range preferencesDTO.Preferences {
+		changes = append(changes, service.NotificationPreference{
+			Type:  model.NotificationType(preference.Type),
+			InApp: preference.InApp,
+			Email: preference.Email,
+		})
+	}
+
+	preferences, err := ctrl.notificationService.SavePreferences(user.(*model.User).ID, changes)
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toNotificationPreferences(preferences))
+}
@@ -0,0 +1,336 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/m7medVision/crime-management-system/internal/dto"
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"github.com/m7medVision/crime-management-system/internal/service"
+)
+
+type PersonController struct {
+	personService *service.PersonService
+}
+
+func NewPersonController(personService *service.PersonService) *PersonController {
+	return &PersonController{personService: personService}
+}
+
+func toPersonChanges(personDTO *dto.PersonDTO) service.PersonChanges {
+	return service.PersonChanges{
+		FirstName:         personDTO.FirstName,
+		LastName:          personDTO.LastName,
+		Age:               personDTO.Age,
+		Gender:            personDTO.Gender,
+		Address:           personDTO.Address,
+		PhoneNumber:       personDTO.PhoneNumber,
+		Notes:             personDTO.Notes,
+		Description:       personDTO.Description,
+		IsArrested:        personDTO.IsArrested,
+		InjuryDescription: personDTO.InjuryDescription,
+		Statement:         personDTO.Statement,
+	}
+}
+
+// CreateSuspect godoc
+// @Summary Add a suspect
+// @Description Add a suspect to a case. firstName and lastName are required; besides the common fields a suspect has description and isArrested. Officers must be assigned to the case, and the people of a closed case cannot be changed until it is reopened.
+// @Tags cases,suspects
+// @Accept json
+// @Produce json
+// @Param id path string true "Case ID or reference number"
+// @Param suspect body dto.PersonDTO true "Suspect details"
+// @Success 201 {object} model.Suspect
+// @Failure 400 {object} dto.ErrorDTO "Invalid suspect data"
+// @Failure 403 {object} dto.ErrorDTO "Permission denied"
+// @Failure 404 {object} dto.ErrorDTO "Case not found"
+// @Failure 409 {object} dto.ErrorDTO "Case is closed"
+// @Security BasicAuth
+// @Router /cases/{id}/suspects [post]
+func (ctrl *PersonController) CreateSuspect(c *gin.Context) {
+	ctrl.create(c, model.PersonSuspect)
+}
+
+// UpdateSuspect godoc
+// @Summary Update a suspect
+// @Description Change the given fields of a suspect; omitted fields are left unchanged. Every changed field is recorded in the case history.
+// @Tags cases,suspects
+// @Accept json
+// @Produce json
+// @Param id path string true "Case ID or reference number"
+// @Param personId path int true "Suspect ID"
+// @Param suspect body dto.PersonDTO true "Fields to change"
+// @Success 200 {object} model.Suspect
+// @Failure 400 {object} dto.ErrorDTO "Invalid suspect data"
+// @Failure 403 {object} dto.ErrorDTO "Permission denied"
+// @Failure 404 {object} dto.ErrorDTO "Case or suspect not found"
+// @Failure 409 {object} dto.ErrorDTO "Case is closed"
+// @Security BasicAuth
+// @Router /cases/{id}/suspects/{personId} [put]
+func (ctrl *PersonController) UpdateSuspect(c *gin.Context) {
+	ctrl.update(c, model.PersonSuspect)
+}
+
+// DeleteSuspect godoc
+// @Summary Remove a suspect
+// @Description Remove a suspect from a case
+// @Tags cases,suspects
+// @Accept json
+// @Produce json
+// @Param id path string true "Case ID or reference number"
+// @Param personId path int true "Suspect ID"
+// @Success 200 {object} map[string]string "Success message"
+// @Failure 400 {object} dto.ErrorDTO "Invalid case or suspect ID"
+// @Failure 403 {object} dto.ErrorDTO "Permission denied"
+// @Failure 404 {object} dto.ErrorDTO "Case or suspect not found"
+// @Failure 409 {object} dto.ErrorDTO "Case is closed"
+// @Security BasicAuth
+// @Router /cases/{id}/suspects/{personId} [delete]
+func (ctrl *PersonController) DeleteSuspect(c *gin.Context) {
+	ctrl.delete(c, model.PersonSuspect)
+}
+
+// CreateVictim godoc
+// @Summary Add a victim
+// @Description Add a victim to a case. firstName and lastName are required; besides the common fields a victim has injuryDescription. Officers must be assigned to the case, and the people of a closed case cannot be changed until it is reopened.
+// @Tags cases,victims
+// @Accept json
+// @Produce json
+// @Param id path string true "Case ID or reference number"
+// @Param victim body dto.PersonDTO true "Victim details"
+// @Success 201 {object} model.Victim
+// @Failure 400 {object} dto.ErrorDTO "Invalid victim data"
+// @Failure 403 {object} dto.ErrorDTO "Permission denied"
+// @Failure 404 {object} dto.ErrorDTO "Case not found"
+// @Failure 409 {object} dto.ErrorDTO "Case is closed"
+// @Security BasicAuth
+// @Router /cases/{id}/victims [post]
+func (ctrl *PersonController) CreateVictim(c *gin.Context) {
+	ctrl.create(c, model.PersonVictim)
+}
+
+// UpdateVictim godoc
+// @Summary Update a victim
+// @Description Change the given fields of a victim; omitted fields are left unchanged. Every changed field is recorded in the case history.
+// @Tags cases,victims
+// @Accept json
+// @Produce json
+// @Param id path string true "Case ID or reference number"
+// @Param personId path int true "Victim ID"
+// @Param victim body dto.PersonDTO true "Fields to change"
+// @Success 200 {object} model.Victim
+// @Failure 400 {object} dto.ErrorDTO "Invalid victim data"
+// @Failure 403 {object} dto.ErrorDTO "Permission denied"
+// @Failure 404 {object} dto.ErrorDTO "Case or victim not found"
+// @Failure 409 {object} dto.ErrorDTO "Case is closed"
+// @Security BasicAuth
+// @Router /cases/{id}/victims/{personId} [put]
+func (ctrl *PersonController) UpdateVictim(c *gin.Context) {
+	ctrl.update(c, model.PersonVictim)
+}
+
+// DeleteVictim godoc
+// @Summary Remove a victim
+// @Description Remove a victim from a case
+// @Tags cases,victims
+// @Accept json
+// @Produce json
+// @Param id path string true "Case ID or reference number"
+// @Param personId path int true "Victim ID"
+// @Success 200 {object} map[string]string "Success message"
+// @Failure 400 {object} dto.ErrorDTO "Invalid case or victim ID"
+// @Failure 403 {object} dto.ErrorDTO "Permission denied"
+// @Failure 404 {object} dto.ErrorDTO "Case or victim not found"
+// @Failure 409 {object} dto.ErrorDTO "Case is closed"
+// @Security BasicAuth
+// @Router /cases/{id}/victims/{personId} [delete]
+func (ctrl *PersonController) DeleteVictim(c *gin.Context) {
+	ctrl.delete(c, model.PersonVictim)
+}
+
+// CreateWitness godoc
+// @Summary Add a witness
+// @Description Add a witness to a case. firstName and lastName are required; besides the common fields a witness has statement. Officers must be assigned to the case, and the people of a closed case cannot be changed until it is reopened.
+// @Tags cases,witnesses
+// @Accept json
+// @Produce json
+// @Param id path string true "Case ID or reference number"
+// @Param witness body dto.PersonDTO true "Witness details"
+// @Success 201 {object} model.Witness
+// @Failure 400 {object} dto.ErrorDTO "Invalid witness data"
+// @Failure 403 {object} dto.ErrorDTO "Permission denied"
+// @Failure 404 {object} dto.ErrorDTO "Case not found"
+// @Failure 409 {object} dto.ErrorDTO "Case is closed"
+// @Security BasicAuth
+// @Router /cases/{id}/witnesses [post]
+func (ctrl *PersonController) CreateWitness(c *gin.Context) {
+	ctrl.create(c, model.PersonWitness)
+}
+
+// UpdateWitness godoc
+// @Summary Update a witness
+// @Description Change the given fields of a witness; omitted fields are left unchanged. Every changed field is recorded in the case history.
+// @Tags cases,witnesses
+// @Accept json
+// @Produce json
+// @Param id path string true "Case ID or reference number"
+// @Param personId path int true "Witness ID"
+// @Param witness body dto.PersonDTO true "Fields to change"
+// @Success 200 {object} model.Witness
+// @Failure 400 {object} dto.ErrorDTO "Invalid witness data"
+// @Failure 403 {object} dto.ErrorDTO "Permission denied"
+// @Failure 404 {object} dto.ErrorDTO "Case or witness not found"
+// @Failure 409 {object} dto.ErrorDTO "Case is closed"
+// @Security BasicAuth
+// @Router /cases/{id}/witnesses/{personId} [put]
+func (ctrl *PersonController) UpdateWitness(c *gin.Context) {
+	ctrl.update(c, model.PersonWitness)
+}
+
+// DeleteWitness godoc
+// @Summary Remove a witness
+// @Description Remove a witness from a case
+// @Tags cases,witnesses
+// @Accept json
+// @Produce json
+// @Param id path string true "Case ID or reference number"
+// @Param personId path int true "Witness ID"
+// @Success 200 {object} map[string]string "Success message"
+// @Failure 400 {object} dto.ErrorDTO "Invalid case or witness ID"
+// @Failure 403 {object} dto.ErrorDTO "Permission denied"
+// @Failure 404 {object} dto.ErrorDTO "Case or witness not found"
+// @Failure 409 {object} dto.ErrorDTO "Case is closed"
+// @Security BasicAuth
+// @Router /cases/{id}/witnesses/{personId} [delete]
+func (ctrl *PersonController) DeleteWitness(c *gin.Context) {
+	ctrl.delete(c, model.PersonWitness)
+}
+
+// create adds a person of the given type to the case in the path
+func (ctrl *PersonController) create(c *gin.Context, personType model.PersonType) {
+	caseID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid case ID",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	var personDTO dto.PersonDTO
+	if err := c.ShouldBindJSON(&personDTO); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid " + string(personType) + " data",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	person, err := ctrl.personService.CreatePerson(user.(*model.User), uint(caseID), personType, toPersonChanges(&personDTO))
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, person)
+}
+
+// update changes a person of the given type on the case in the path
+func (ctrl *PersonController) update(c *gin.Context, personType model.PersonType) {
+	caseID, personID, ok := personParams(c, personType)
+	if !ok {
+		return
+	}
+
+	var personDTO dto.PersonDTO
+	if err := c.ShouldBindJSON(&personDTO); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid " + string(personType) + " data",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	person, err := ctrl.personService.UpdatePerson(user.(*model.User), caseID, personID, personType, toPersonChanges(&personDTO))
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, person)
+}
+
+// delete removes a person of the given type from the case in the path
+func (ctrl *PersonController) delete(c *gin.Context, personType model.PersonType) {
+	caseID, personID, ok := personParams(c, personType)
+	if !ok {
+		return
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	if err := ctrl.personService.DeletePerson(user.(*model.User), caseID, personID, personType); err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Person removed successfully"})
+}
+
+// personParams parses the case and person IDs in the path, responding with 400 if either is invalid
+func personParams(c *gin.Context, personType model.PersonType) (uint, uint, bool) {
+	caseID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid case ID",
+			Code:    http.StatusBadRequest,
+		})
+		return 0, 0, false
+	}
+
+	personID, err := strconv.Atoi(c.Param("personId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid " + string(personType) + " ID",
+			Code:    http.StatusBadRequest,
+		})
+		return 0, 0, false
+	}
+	return uint(caseID), uint(personID), true
+}
@@ -0,0 +1,27 @@
+package controller
+
+import (
+	"github.com/m7medVision/crime-management-system/internal/dto"
+	"github.com/m7medVision/crime-management-system/internal/service"
+)
+
+func toReconciliationReport(report *service.ReconciliationReport) dto.ReconciliationReportDTO {
+	response := dto.ReconciliationReportDTO{
+		DryRun: report.DryRun,
+		Mode:   report.Mode,
+		Items:  make([]dto.ReconciliationItemDTO, 0, len(report.Items)),
+	}
+	for _, item := range report.Items {
+		response.Items = append(response.Items, dto.ReconciliationItemDTO{
+			CaseID:             item.CaseID,
+			ReferenceNumber:    item.ReferenceNumber,
+			AuthorizationLevel: string(item.AuthorizationLevel),
+			UserID:             item.UserID,
+			FullName:           item.FullName,
+			ClearanceLevel:     string(item.ClearanceLevel),
+			Role:               string(item.Role),
+			Action:             string(item.Action),
+		})
+	}
+	return response
+}
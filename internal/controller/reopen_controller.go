@@ -0,0 +1,285 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/m7medVision/crime-management-system/internal/dto"
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"github.com/m7medVision/crime-management-system/internal/service"
+)
+
+type ReopenController struct {
+	reopenService *service.ReopenService
+}
+
+func NewReopenController(reopenService *service.ReopenService) *ReopenController {
+	return &ReopenController{reopenService: reopenService}
+}
+
+func toReopenResponse(reopen *model.CaseReopen) dto.ReopenResponseDTO {
+	response := dto.ReopenResponseDTO{
+		ID:                  reopen.ID,
+		CaseID:              reopen.CaseID,
+		ReferenceNumber:     reopen.Case.ReferenceNumber,
+		Reason:              reopen.Reason,
+		PreviousDisposition: string(reopen.PreviousDisposition),
+		Status:              string(reopen.Status),
+		RequestedByID:       reopen.RequestedByID,
+		RequestedBy:         reopen.RequestedBy.FullName,
+		DecidedByID:         reopen.DecidedByID,
+		Response:            reopen.Response,
+		RestoredEvidence:    reopen.RestoredEvidence,
+		CreatedAt:           reopen.CreatedAt.Format(time.RFC3339),
+	}
+	if reopen.ClosedAt != nil {
+		response.ClosedAt = reopen.ClosedAt.Format(time.RFC3339)
+	}
+	if reopen.DecidedBy != nil {
+		response.DecidedBy = reopen.DecidedBy.FullName
+	}
+	if reopen.DecidedAt != nil {
+		response.DecidedAt = reopen.DecidedAt.Format(time.RFC3339)
+	}
+	return response
+}
+
+func toReopenResponses(reopens []model.CaseReopen) []dto.ReopenResponseDTO {
+	response := make([]dto.ReopenResponseDTO, 0, len(reopens))
+	for i := range reopens {
+		response = append(response, toReopenResponse(&reopens[i]))
+	}
+	return response
+}
+
+// ReopenCase godoc
+// @Summary Reopen a closed case
+// @Description Move a closed case back to ongoing with a reason and restore its archived evidence. Cases closed longer than the configured period wait for a supervisor's approval unless a supervisor reopens them (202). Officers must be assigned to the case.
+// @Tags cases,reopens
+// @Accept json
+// @Produce json
+// @Param id path string true "Case ID or reference number"
+// @Param If-Match header string false "ETag of the case version being reopened"
+// @Param reopen body dto.ReopenCaseDTO true "Reason for reopening"
+// @Success 201 {object} dto.ReopenResponseDTO "Case reopened"
+// @Success 202 {object} dto.ReopenResponseDTO "Reopen request waiting for approval"
+// @Failure 400 {object} dto.ErrorDTO "Invalid case ID or missing reason"
+// @Failure 403 {object} dto.ErrorDTO "Permission denied"
+// @Failure 404 {object} dto.ErrorDTO "Case not found"
+// @Failure 409 {object} dto.ErrorDTO "Case is not closed or a reopen request is already pending"
+// @Failure 412 {object} dto.ErrorDTO "Case has been modified since it was read"
+// @Security BasicAuth
+// @Router /cases/{id}/reopen [post]
+func (ctrl *ReopenController) ReopenCase(c *gin.Context) {
+	caseID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid case ID",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	var reopenDTO dto.ReopenCaseDTO
+	if err := c.ShouldBindJSON(&reopenDTO); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid reopen data",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	expectedVersion, err := ifMatchVersion(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	reopen, err := ctrl.reopenService.ReopenCase(user.(*model.User), uint(caseID), reopenDTO.Reason, expectedVersion)
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	status := http.StatusCreated
+	if reopen.Status == model.ReopenPending {
+		status = http.StatusAccepted
+	}
+	c.JSON(status, toReopenResponse(reopen))
+}
+
+// ListCaseReopens godoc
+// @Summary List the reopen requests of a case
+// @Description Get the reopen history of a case, including requests that were rejected, newest first
+// @Tags cases,reopens
+// @Accept json
+// @Produce json
+// @Param id path string true "Case ID or reference number"
+// @Success 200 {array} dto.ReopenResponseDTO
+// @Failure 400 {object} dto.ErrorDTO "Invalid case ID"
+// @Failure 403 {object} dto.ErrorDTO "Insufficient clearance"
+// @Failure 404 {object} dto.ErrorDTO "Case not found"
+// @Security BasicAuth
+// @Router /cases/{id}/reopens [get]
+func (ctrl *ReopenController) ListCaseReopens(c *gin.Context) {
+	caseID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid case ID",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	reopens, err := ctrl.reopenService.ListCaseReopens(user.(*model.User), uint(caseID))
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toReopenResponses(reopens))
+}
+
+// ListPendingReopens godoc
+// @Summary List reopen requests waiting for approval
+// @Description Get the reopen requests waiting for a supervisor's approval on cases within your clearance, oldest first
+// @Tags reopens
+// @Accept json
+// @Produce json
+// @Success 200 {array} dto.ReopenResponseDTO
+// @Failure 403 {object} dto.ErrorDTO "Supervisor access required"
+// @Failure 500 {object} dto.ErrorDTO "Server error"
+// @Security BasicAuth
+// @Router /reopens/pending [get]
+func (ctrl *ReopenController) ListPendingReopens(c *gin.Context) {
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	reopens, err := ctrl.reopenService.ListPending(user.(*model.User))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    http.StatusInternalServerError,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toReopenResponses(reopens))
+}
+
+// ApproveReopen godoc
+// @Summary Approve a reopen request
+// @Description Reopen the case of a pending request and restore its archived evidence
+// @Tags reopens
+// @Accept json
+// @Produce json
+// @Param id path int true "Reopen request ID"
+// @Param decision body dto.ReopenDecisionDTO false "Optional response to the requester"
+// @Success 200 {object} dto.ReopenResponseDTO
+// @Failure 400 {object} dto.ErrorDTO "Invalid reopen request ID"
+// @Failure 403 {object} dto.ErrorDTO "Supervisor access required"
+// @Failure 404 {object} dto.ErrorDTO "Reopen request not found"
+// @Failure 409 {object} dto.ErrorDTO "Request is no longer pending or the case is no longer closed"
+// @Security BasicAuth
+// @Router /reopens/{id}/approve [post]
+func (ctrl *ReopenController) ApproveReopen(c *gin.Context) {
+	ctrl.decide(c, ctrl.reopenService.Approve)
+}
+
+// RejectReopen godoc
+// @Summary Reject a reopen request
+// @Description Turn down a pending reopen request with a reason. The case stays closed.
+// @Tags reopens
+// @Accept json
+// @Produce json
+// @Param id path int true "Reopen request ID"
+// @Param decision body dto.ReopenDecisionDTO true "Reason for the rejection"
+// @Success 200 {object} dto.ReopenResponseDTO
+// @Failure 400 {object} dto.ErrorDTO "Invalid reopen request ID or missing reason"
+// @Failure 403 {object} dto.ErrorDTO "Supervisor access required"
+// @Failure 404 {object} dto.ErrorDTO "Reopen request not found"
+// @Failure 409 {object} dto.ErrorDTO "Request is no longer pending"
+// @Security BasicAuth
+// @Router /reopens/{id}/reject [post]
+func (ctrl *ReopenController) RejectReopen(c *gin.Context) {
+	ctrl.decide(c, ctrl.reopenService.Reject)
+}
+
+// decide runs an approval decision on the reopen request in the path
+func (ctrl *ReopenController) decide(c *gin.Context, action func(user *model.User, id uint, response string) (*model.CaseReopen, error)) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid reopen request ID",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	// The body is optional for approvals
+	var decisionDTO dto.ReopenDecisionDTO
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&decisionDTO); err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+				Message: "Invalid decision data",
+				Code:    http.StatusBadRequest,
+			})
+			return
+		}
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	reopen, err := action(user.(*model.User), uint(id), decisionDTO.Response)
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toReopenResponse(reopen))
+}
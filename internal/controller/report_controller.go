@@ -23,7 +23,7 @@ func NewReportController(reportService *service.ReportService) *ReportController
 // @Tags reports
 // @Accept json
 // @Produce application/pdf
-// @Param id path int true "Case ID"
+// @Param id path string true "Case ID or reference number"
 // @Success 200 {file} binary "PDF report file"
 // @Failure 400 {object} dto.ErrorDTO "Invalid case ID"
 // @Failure 500 {object} dto.ErrorDTO "Server error"
@@ -0,0 +1,345 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/m7medVision/crime-management-system/internal/dto"
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"github.com/m7medVision/crime-management-system/internal/service"
+)
+
+type SavedViewController struct {
+	viewService *service.SavedViewService
+}
+
+func NewSavedViewController(viewService *service.SavedViewService) *SavedViewController {
+	return &SavedViewController{viewService: viewService}
+}
+
+// viewColumns returns the columns a view shows, all of them when none were chosen
+func viewColumns(view *model.SavedView) []string {
+	if view.Columns == "" {
+		return service.SavedViewColumns
+	}
+	return strings.Split(view.Columns, ",")
+}
+
+func toSavedViewResponse(view *model.SavedView) dto.SavedViewResponseDTO {
+	response := dto.SavedViewResponseDTO{
+		ID:          view.ID,
+		Name:        view.Name,
+		Description: view.Description,
+		Query:       view.Query,
+		Columns:     viewColumns(view),
+		OwnerID:     view.OwnerID,
+		OwnerName:   view.Owner.FullName,
+		SharedArea:  view.SharedArea,
+		Watch:       view.Watch,
+		UpdatedAt:   view.UpdatedAt.Format(time.RFC3339),
+	}
+	if view.Watch && view.LastCheckedAt != nil {
+		response.LastCheckedAt = view.LastCheckedAt.Format(time.RFC3339)
+	}
+	return response
+}
+
+func toSavedViewInput(viewDTO *dto.SavedViewDTO) service.SavedViewInput {
+	return service.SavedViewInput{
+		Name:        viewDTO.Name,
+		Description: viewDTO.Description,
+		Query:       viewDTO.Query,
+		Columns:     viewDTO.Columns,
+		Shared:      viewDTO.Shared,
+		Watch:       viewDTO.Watch,
+	}
+}
+
+// caseColumn returns the value of one saved view column of a case
+func caseColumn(caseData *model.Case, column string) interface{} {
+	formatTime := func(t *time.Time) interface{} {
+		if t == nil {
+			return nil
+		}
+		return t.Format(time.RFC3339)
+	}
+
+	switch column {
+	case "id":
+		return caseData.ID
+	case "referenceNumber":
+		return caseData.ReferenceNumber
+	case "name":
+		return caseData.Name
+	case "status":
+		return caseData.Status
+	case "priority":
+		return caseData.Priority
+	case "area":
+		return caseData.Area
+	case "caseType":
+		return caseData.CaseType
+	case "authorizationLevel":
+		return caseData.AuthorizationLevel
+	case "slaStatus":
+		return caseData.SLAStatus
+	case "slaDueAt":
+		return formatTime(caseData.SLADueAt)
+	case "disposition":
+		return caseData.Disposition
+	case "createdAt":
+		return caseData.CreatedAt.Format(time.RFC3339)
+	case "updatedAt":
+		return caseData.UpdatedAt.Format(time.RFC3339)
+	case "createdBy":
+		return caseData.CreatedBy.FullName
+	}
+	return nil
+}
+
+// ListViews godoc
+// @Summary List saved case views
+// @Description Get your own saved case views and the views shared with your home area
+// @Tags views
+// @Accept json
+// @Produce json
+// @Success 200 {array} dto.SavedViewResponseDTO
+// @Failure 401 {object} dto.ErrorDTO "Unauthorized"
+// @Failure 500 {object} dto.ErrorDTO "Server error"
+// @Security BasicAuth
+// @Router /views [get]
+func (ctrl *SavedViewController) ListViews(c *gin.Context) {
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	views, err := ctrl.viewService.ListViews(user.(*model.User))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    http.StatusInternalServerError,
+		})
+		return
+	}
+
+	response := make([]dto.SavedViewResponseDTO, 0, len(views))
+	for i := range views {
+		response = append(response, toSavedViewResponse(&views[i]))
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// CreateView godoc
+// @Summary Save a case view
+// @Description Save a named case list query with its sort and columns. The query takes the same parameters as GET /cases. Shared views are visible to the users of your home area. Watched views notify you when new cases match.
+// @Tags views
+// @Accept json
+// @Produce json
+// @Param view body dto.SavedViewDTO true "Saved view"
+// @Success 201 {object} dto.SavedViewResponseDTO
+// @Failure 400 {object} dto.ErrorDTO "Invalid view"
+// @Failure 401 {object} dto.ErrorDTO "Unauthorized"
+// @Failure 409 {object} dto.ErrorDTO "View name already in use"
+// @Security BasicAuth
+// @Router /views [post]
+func (ctrl *SavedViewController) CreateView(c *gin.Context) {
+	var viewDTO dto.SavedViewDTO
+	if err := c.ShouldBindJSON(&viewDTO); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid view data",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	view, err := ctrl.viewService.CreateView(user.(*model.User), toSavedViewInput(&viewDTO))
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, toSavedViewResponse(view))
+}
+
+// RunView godoc
+// @Summary Run a saved case view
+// @Description Get the cases matching a saved view, limited to the columns of the view. Results depend on the clearance of the caller.
+// @Tags views
+// @Accept json
+// @Produce json
+// @Param id path int true "View ID"
+// @Param offset query int false "Offset for pagination" default(0)
+// @Param limit query int false "Limit for pagination" default(10)
+// @Success 200 {object} dto.SavedViewResultDTO
+// @Failure 400 {object} dto.ErrorDTO "Invalid view ID"
+// @Failure 401 {object} dto.ErrorDTO "Unauthorized"
+// @Failure 404 {object} dto.ErrorDTO "View not found"
+// @Security BasicAuth
+// @Router /views/{id} [get]
+func (ctrl *SavedViewController) RunView(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid view ID",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	view, cases, total, err := ctrl.viewService.RunView(user.(*model.User), uint(id), offset, limit)
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	columns := viewColumns(view)
+	rows := make([]map[string]interface{}, 0, len(cases))
+	for i := range cases {
+		row := make(map[string]interface{}, len(columns))
+		for _, column := range columns {
+			row[column] = caseColumn(&cases[i], column)
+		}
+		rows = append(rows, row)
+	}
+
+	c.JSON(http.StatusOK, dto.SavedViewResultDTO{
+		View:    toSavedViewResponse(view),
+		Columns: columns,
+		Rows:    rows,
+		Total:   total,
+	})
+}
+
+// UpdateView godoc
+// @Summary Update a saved case view
+// @Description Replace the query, columns, sharing and watch settings of a saved view. Only its owner and admins may change it.
+// @Tags views
+// @Accept json
+// @Produce json
+// @Param id path int true "View ID"
+// @Param view body dto.SavedViewDTO true "Saved view"
+// @Success 200 {object} dto.SavedViewResponseDTO
+// @Failure 400 {object} dto.ErrorDTO "Invalid view"
+// @Failure 401 {object} dto.ErrorDTO "Unauthorized"
+// @Failure 403 {object} dto.ErrorDTO "Permission denied"
+// @Failure 404 {object} dto.ErrorDTO "View not found"
+// @Failure 409 {object} dto.ErrorDTO "View name already in use"
+// @Security BasicAuth
+// @Router /views/{id} [put]
+func (ctrl *SavedViewController) UpdateView(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid view ID",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	var viewDTO dto.SavedViewDTO
+	if err := c.ShouldBindJSON(&viewDTO); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid view data",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	view, err := ctrl.viewService.UpdateView(user.(*model.User), uint(id), toSavedViewInput(&viewDTO))
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toSavedViewResponse(view))
+}
+
+// DeleteView godoc
+// @Summary Delete a saved case view
+// @Description Delete a saved view. Only its owner and admins may delete it.
+// @Tags views
+// @Accept json
+// @Produce json
+// @Param id path int true "View ID"
+// @Success 200 {object} map[string]string "Success message"
+// @Failure 400 {object} dto.ErrorDTO "Invalid view ID"
+// @Failure 401 {object} dto.ErrorDTO "Unauthorized"
+// @Failure 403 {object} dto.ErrorDTO "Permission denied"
+// @Failure 404 {object} dto.ErrorDTO "View not found"
+// @Security BasicAuth
+// @Router /views/{id} [delete]
+func (ctrl *SavedViewController) DeleteView(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid view ID",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	if err := ctrl.viewService.DeleteView(user.(*model.User), uint(id)); err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Saved view deleted successfully"})
+}
@@ -0,0 +1,92 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/m7medVision/crime-management-system/internal/dto"
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"github.com/m7medVision/crime-management-system/internal/service"
+)
+
+type SearchController struct {
+	searchService *service.SearchService
+}
+
+func NewSearchController(searchService *service.SearchService) *SearchController {
+	return &SearchController{searchService: searchService}
+}
+
+// Search godoc
+// @Summary Full-text search
+// @Description Search a keyword across case descriptions, text evidence, witness statements, suspect descriptions and comments. Results are ranked and limited to cases the caller is cleared for. Snippets are HTML: matches are wrapped in <b> tags and all other text is escaped.
+// @Tags search
+// @Accept json
+// @Produce json
+// @Param q query string true "Search query (supports quoted phrases, OR and -exclusion)"
+// @Param types query string false "Comma-separated entity types to search (case, evidence, witness, suspect, comment)"
+// @Param offset query int false "Pagination offset" default(0)
+// @Param limit query int false "Items per page" default(10)
+// @Success 200 {object} map[string]interface{} "results and total count"
+// @Failure 400 {object} dto.ErrorDTO "Invalid search query"
+// @Failure 401 {object} dto.ErrorDTO "Authentication required"
+// @Failure 500 {object} dto.ErrorDTO "Server error"
+// @Security BasicAuth
+// @Router /search [get]
+func (ctrl *SearchController) Search(c *gin.Context) {
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "10"))
+	query := c.Query("q")
+
+	var entityTypes []string
+	if types := c.Query("types"); types != "" {
+		entityTypes = strings.Split(types, ",")
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	results, total, err := ctrl.searchService.Search(user.(*model.User), query, entityTypes, offset, limit)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidSearchQuery) {
+			c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+				Message: err.Error(),
+				Code:    http.StatusBadRequest,
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    http.StatusInternalServerError,
+		})
+		return
+	}
+
+	response := make([]dto.SearchResultDTO, 0, len(results))
+	for _, result := range results {
+		response = append(response, dto.SearchResultDTO{
+			EntityType: result.EntityType,
+			EntityID:   result.EntityID,
+			CaseID:     result.CaseID,
+			CaseName:   result.CaseName,
+			Rank:       result.Rank,
+			Snippet:    result.Snippet,
+			CreatedAt:  result.CreatedAt.Format(time.RFC3339),
+		})
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"results": response,
+		"total":   total,
+	})
+}
@@ -0,0 +1,114 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/m7medVision/crime-management-system/internal/dto"
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"github.com/m7medVision/crime-management-system/internal/service"
+)
+
+type SLAController struct {
+	slaService *service.SLAService
+}
+
+func NewSLAController(slaService *service.SLAService) *SLAController {
+	return &SLAController{slaService: slaService}
+}
+
+// ListPolicies godoc
+// @Summary List SLA policies
+// @Description Get the SLA targets for every case type and priority. Policies with an empty case type apply to all case types without their own policy.
+// @Tags sla
+// @Accept json
+// @Produce json
+// @Success 200 {array} model.SLAPolicy
+// @Failure 500 {object} dto.ErrorDTO "Server error"
+// @Security BasicAuth
+// @Router /sla-policies [get]
+func (ctrl *SLAController) ListPolicies(c *gin.Context) {
+	policies, err := ctrl.slaService.ListPolicies()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    http.StatusInternalServerError,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, policies)
+}
+
+// SavePolicy godoc
+// @Summary Create or replace an SLA policy
+// @Description Set the SLA targets, in minutes, for a case type and priority. A target of 0 means no target.
+// @Tags sla
+// @Accept json
+// @Produce json
+// @Param policy body dto.SLAPolicyDTO true "SLA policy"
+// @Success 200 {object} model.SLAPolicy
+// @Failure 400 {object} dto.ErrorDTO "Invalid policy data"
+// @Security BasicAuth
+// @Router /sla-policies [put]
+func (ctrl *SLAController) SavePolicy(c *gin.Context) {
+	var policyDTO dto.SLAPolicyDTO
+	if err := c.ShouldBindJSON(&policyDTO); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid policy data",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	policy, err := ctrl.slaService.SavePolicy(&model.SLAPolicy{
+		CaseType:               policyDTO.CaseType,
+		Priority:               policyDTO.Priority,
+		FirstAssignmentMinutes: policyDTO.FirstAssignmentMinutes,
+		FirstUpdateMinutes:     policyDTO.FirstUpdateMinutes,
+		ClosureMinutes:         policyDTO.ClosureMinutes,
+	})
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, policy)
+}
+
+// DeletePolicy godoc
+// @Summary Delete an SLA policy
+// @Description Remove an SLA policy. Cases it covered fall back to the default policy for their priority.
+// @Tags sla
+// @Accept json
+// @Produce json
+// @Param id path int true "Policy ID"
+// @Success 200 {object} map[string]string "Success message"
+// @Failure 400 {object} dto.ErrorDTO "Invalid policy ID"
+// @Failure 404 {object} dto.ErrorDTO "Policy not found"
+// @Security BasicAuth
+// @Router /sla-policies/{id} [delete]
+func (ctrl *SLAController) DeletePolicy(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid policy ID",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	if err := ctrl.slaService.DeletePolicy(uint(id)); err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "SLA policy deleted successfully"})
+}
@@ -0,0 +1,296 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/m7medVision/crime-management-system/internal/dto"
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"github.com/m7medVision/crime-management-system/internal/repository"
+	"github.com/m7medVision/crime-management-system/internal/service"
+)
+
+type TagController struct {
+	tagService *service.TagService
+}
+
+func NewTagController(tagService *service.TagService) *TagController {
+	return &TagController{tagService: tagService}
+}
+
+func toTagResponse(tag *model.Tag) dto.TagResponseDTO {
+	return dto.TagResponseDTO{
+		ID:           tag.ID,
+		Name:         tag.Name,
+		Color:        tag.Color,
+		Description:  tag.Description,
+		MinClearance: string(tag.MinClearance),
+	}
+}
+
+func toTagResponses(tags []model.Tag) []dto.TagResponseDTO {
+	response := make([]dto.TagResponseDTO, 0, len(tags))
+	for i := range tags {
+		response = append(response, toTagResponse(&tags[i]))
+	}
+	return response
+}
+
+// ListTags godoc
+// @Summary List tags
+// @Description Get the tag vocabulary, limited to tags the caller is cleared to see
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Success 200 {array} dto.TagResponseDTO
+// @Failure 500 {object} dto.ErrorDTO "Server error"
+// @Security BasicAuth
+// @Router /tags [get]
+func (ctrl *TagController) ListTags(c *gin.Context) {
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	tags, err := ctrl.tagService.ListTags(user.(*model.User))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    http.StatusInternalServerError,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toTagResponses(tags))
+}
+
+// CreateTag godoc
+// @Summary Create a tag
+// @Description Admin endpoint to add a tag to the vocabulary. Users below the tag's minimum clearance can neither see nor apply it.
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Param tag body dto.CreateTagDTO true "Tag details"
+// @Success 201 {object} dto.TagResponseDTO
+// @Failure 400 {object} dto.ErrorDTO "Invalid tag data"
+// @Failure 409 {object} dto.ErrorDTO "Tag already exists"
+// @Security BasicAuth
+// @Router /tags [post]
+func (ctrl *TagController) CreateTag(c *gin.Context) {
+	var tagDTO dto.CreateTagDTO
+	if err := c.ShouldBindJSON(&tagDTO); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid tag data",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	tag, err := ctrl.tagService.CreateTag(&model.Tag{
+		Name:         tagDTO.Name,
+		Color:        tagDTO.Color,
+		Description:  tagDTO.Description,
+		MinClearance: model.ClearanceLevel(tagDTO.MinClearance),
+	})
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, toTagResponse(tag))
+}
+
+// UpdateTag godoc
+// @Summary Update a tag
+// @Description Admin endpoint to rename, recolour or change the minimum clearance of a tag
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Param id path int true "Tag ID"
+// @Param tag body dto.UpdateTagDTO true "Fields to update"
+// @Success 200 {object} dto.TagResponseDTO
+// @Failure 400 {object} dto.ErrorDTO "Invalid tag data"
+// @Failure 404 {object} dto.ErrorDTO "Tag not found"
+// @Failure 409 {object} dto.ErrorDTO "Tag name already in use"
+// @Security BasicAuth
+// @Router /tags/{id} [put]
+func (ctrl *TagController) UpdateTag(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid tag ID",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	var updateDTO dto.UpdateTagDTO
+	if err := c.ShouldBindJSON(&updateDTO); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid tag data",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	changes := service.TagChanges{
+		Name:        updateDTO.Name,
+		Color:       updateDTO.Color,
+		Description: updateDTO.Description,
+	}
+	if updateDTO.MinClearance != nil {
+		clearance := model.ClearanceLevel(*updateDTO.MinClearance)
+		changes.MinClearance = &clearance
+	}
+
+	tag, err := ctrl.tagService.UpdateTag(uint(id), changes)
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toTagResponse(tag))
+}
+
+// DeleteTag godoc
+// @Summary Delete a tag
+// @Description Admin endpoint to remove a tag from the vocabulary and from everything it is attached to
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Param id path int true "Tag ID"
+// @Success 200 {object} map[string]string "Success message"
+// @Failure 400 {object} dto.ErrorDTO "Invalid tag ID"
+// @Failure 404 {object} dto.ErrorDTO "Tag not found"
+// @Security BasicAuth
+// @Router /tags/{id} [delete]
+func (ctrl *TagController) DeleteTag(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid tag ID",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	if err := ctrl.tagService.DeleteTag(uint(id)); err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Tag deleted successfully"})
+}
+
+// ListEntityTags godoc
+// @Summary List the tags on an entity
+// @Description Get the tags attached to a case, evidence item, suspect, victim or witness
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Param entityType path string true "Entity type: case, evidence, suspect, victim or witness"
+// @Param entityId path int true "Entity ID"
+// @Success 200 {array} dto.TagResponseDTO
+// @Failure 400 {object} dto.ErrorDTO "Invalid entity"
+// @Failure 403 {object} dto.ErrorDTO "Insufficient clearance level"
+// @Failure 404 {object} dto.ErrorDTO "Entity not found"
+// @Security BasicAuth
+// @Router /tags/entity/{entityType}/{entityId} [get]
+func (ctrl *TagController) ListEntityTags(c *gin.Context) {
+	entityID, err := strconv.Atoi(c.Param("entityId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid entity ID",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	entity := repository.TaggedEntity{Type: model.TaggableType(c.Param("entityType")), ID: uint(entityID)}
+	tags, err := ctrl.tagService.ListEntityTags(user.(*model.User), entity)
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toTagResponses(tags))
+}
+
+// BulkTag godoc
+// @Summary Tag or untag entities in bulk
+// @Description Add or remove tags on any number of cases, evidence items and people in one atomic operation. Fails without changes if the caller cannot use one of the tags or access one of the entities.
+// @Tags tags
+// @Accept json
+// @Produce json
+// @Param request body dto.BulkTagDTO true "Tags and entities"
+// @Success 200 {object} map[string]string "Success message"
+// @Failure 400 {object} dto.ErrorDTO "Invalid request"
+// @Failure 403 {object} dto.ErrorDTO "Insufficient clearance level"
+// @Failure 404 {object} dto.ErrorDTO "Tag or entity not found"
+// @Security BasicAuth
+// @Router /tags/bulk [post]
+func (ctrl *TagController) BulkTag(c *gin.Context) {
+	var bulkDTO dto.BulkTagDTO
+	if err := c.ShouldBindJSON(&bulkDTO); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid request",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	entities := make([]repository.TaggedEntity, 0, len(bulkDTO.Entities))
+	for _, entity := range bulkDTO.Entities {
+		entities = append(entities, repository.TaggedEntity{Type: model.TaggableType(entity.Type), ID: entity.ID})
+	}
+
+	remove := bulkDTO.Action == "untag"
+	if err := ctrl.tagService.BulkTag(user.(*model.User), bulkDTO.TagIDs, entities, remove, c.ClientIP()); err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	message := "Tags added successfully"
+	if remove {
+		message = "Tags removed successfully"
+	}
+	c.JSON(http.StatusOK, gin.H{"message": message})
+}
@@ -0,0 +1,359 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/m7medVision/crime-management-system/internal/dto"
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"github.com/m7medVision/crime-management-system/internal/repository"
+	"github.com/m7medVision/crime-management-system/internal/service"
+)
+
+type TaskController struct {
+	taskService *service.TaskService
+}
+
+func NewTaskController(taskService *service.TaskService) *TaskController {
+	return &TaskController{taskService: taskService}
+}
+
+func toTaskResponse(task *model.Task, now time.Time) dto.TaskResponseDTO {
+	response := dto.TaskResponseDTO{
+		ID:          task.ID,
+		CaseID:      task.CaseID,
+		Title:       task.Title,
+		Description: task.Description,
+		AssigneeID:  task.AssigneeID,
+		Priority:    string(task.Priority),
+		Status:      string(task.Status),
+		IsOverdue:   task.IsOverdue(now),
+		CreatedBy:   task.CreatedBy.FullName,
+		CreatedAt:   task.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   task.UpdatedAt.Format(time.RFC3339),
+	}
+	if task.Assignee != nil {
+		response.Assignee = task.Assignee.FullName
+	}
+	if task.DueDate != nil {
+		response.DueDate = task.DueDate.Format(time.RFC3339)
+	}
+	if task.CompletedAt != nil {
+		response.CompletedAt = task.CompletedAt.Format(time.RFC3339)
+	}
+	return response
+}
+
+func toTaskResponses(tasks []model.Task) []dto.TaskResponseDTO {
+	now := time.Now()
+	response := make([]dto.TaskResponseDTO, 0, len(tasks))
+	for i := range tasks {
+		response = append(response, toTaskResponse(&tasks[i], now))
+	}
+	return response
+}
+
+func taskFilterFromQuery(c *gin.Context) repository.TaskFilter {
+	return repository.TaskFilter{
+		Status:      model.TaskStatus(c.Query("status")),
+		OverdueOnly: c.Query("overdue") == "true",
+	}
+}
+
+// CreateTask godoc
+// @Summary Create a case task
+// @Description Create an action item on a case with optional assignee, due date and priority
+// @Tags cases,tasks
+// @Accept json
+// @Produce json
+// @Param id path string true "Case ID or reference number"
+// @Param task body dto.CreateTaskDTO true "Task details"
+// @Success 201 {object} dto.TaskResponseDTO
+// @Failure 400 {object} dto.ErrorDTO "Invalid task data"
+// @Failure 403 {object} dto.ErrorDTO "Insufficient clearance level"
+// @Failure 404 {object} dto.ErrorDTO "Case not found"
+// @Security BasicAuth
+// @Router /cases/{id}/tasks [post]
+func (ctrl *TaskController) CreateTask(c *gin.Context) {
+	caseID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid case ID",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	var taskDTO dto.CreateTaskDTO
+	if err := c.ShouldBindJSON(&taskDTO); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid task data",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	task := &model.Task{
+		CaseID:      uint(caseID),
+		Title:       taskDTO.Title,
+		Description: taskDTO.Description,
+		AssigneeID:  taskDTO.AssigneeID,
+		DueDate:     taskDTO.DueDate,
+		Priority:    taskDTO.Priority,
+	}
+
+	result, err := ctrl.taskService.CreateTask(user.(*model.User), task)
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusCreated, toTaskResponse(result, time.Now()))
+}
+
+// ListCaseTasks godoc
+// @Summary List case tasks
+// @Description Retrieve the tasks of a case, optionally filtered by status or overdue state
+// @Tags cases,tasks
+// @Accept json
+// @Produce json
+// @Param id path string true "Case ID or reference number"
+// @Param status query string false "Task status (open, in_progress, done, cancelled)"
+// @Param overdue query bool false "Only overdue tasks"
+// @Success 200 {array} dto.TaskResponseDTO
+// @Failure 400 {object} dto.ErrorDTO "Invalid case ID"
+// @Failure 403 {object} dto.ErrorDTO "Insufficient clearance level"
+// @Failure 404 {object} dto.ErrorDTO "Case not found"
+// @Security BasicAuth
+// @Router /cases/{id}/tasks [get]
+func (ctrl *TaskController) ListCaseTasks(c *gin.Context) {
+	caseID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid case ID",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	tasks, err := ctrl.taskService.ListCaseTasks(user.(*model.User), uint(caseID), taskFilterFromQuery(c))
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toTaskResponses(tasks))
+}
+
+// ListMyTasks godoc
+// @Summary List my tasks
+// @Description Retrieve the tasks assigned to the current user across all cases
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param status query string false "Task status (open, in_progress, done, cancelled)"
+// @Param overdue query bool false "Only overdue tasks"
+// @Success 200 {array} dto.TaskResponseDTO
+// @Failure 401 {object} dto.ErrorDTO "Authentication required"
+// @Failure 500 {object} dto.ErrorDTO "Server error"
+// @Security BasicAuth
+// @Router /me/tasks [get]
+func (ctrl *TaskController) ListMyTasks(c *gin.Context) {
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	tasks, err := ctrl.taskService.ListMyTasks(user.(*model.User), taskFilterFromQuery(c))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    http.StatusInternalServerError,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toTaskResponses(tasks))
+}
+
+// GetTask godoc
+// @Summary Get task details
+// @Description Retrieve a single case task
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param id path int true "Task ID"
+// @Success 200 {object} dto.TaskResponseDTO
+// @Failure 400 {object} dto.ErrorDTO "Invalid task ID"
+// @Failure 403 {object} dto.ErrorDTO "Insufficient clearance level"
+// @Failure 404 {object} dto.ErrorDTO "Task not found"
+// @Security BasicAuth
+// @Router /tasks/{id} [get]
+func (ctrl *TaskController) GetTask(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid task ID",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	task, err := ctrl.taskService.GetTask(user.(*model.User), uint(id))
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toTaskResponse(task, time.Now()))
+}
+
+// UpdateTask godoc
+// @Summary Update a task
+// @Description Update the supplied fields of a task. Officers may only update tasks assigned to them.
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param id path int true "Task ID"
+// @Param task body dto.UpdateTaskDTO true "Fields to update"
+// @Success 200 {object} dto.TaskResponseDTO
+// @Failure 400 {object} dto.ErrorDTO "Invalid task data"
+// @Failure 403 {object} dto.ErrorDTO "Permission denied"
+// @Failure 404 {object} dto.ErrorDTO "Task not found"
+// @Security BasicAuth
+// @Router /tasks/{id} [put]
+func (ctrl *TaskController) UpdateTask(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid task ID",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	var updateDTO dto.UpdateTaskDTO
+	if err := c.ShouldBindJSON(&updateDTO); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid task data",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	changes := service.TaskChanges{
+		Title:         updateDTO.Title,
+		Description:   updateDTO.Description,
+		AssigneeID:    updateDTO.AssigneeID,
+		ClearAssignee: updateDTO.Unassign,
+		DueDate:       updateDTO.DueDate,
+		ClearDueDate:  updateDTO.ClearDueDate,
+		Priority:      updateDTO.Priority,
+		Status:        updateDTO.Status,
+	}
+
+	task, err := ctrl.taskService.UpdateTask(user.(*model.User), uint(id), changes)
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toTaskResponse(task, time.Now()))
+}
+
+// DeleteTask godoc
+// @Summary Delete a task
+// @Description Delete a case task
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Param id path int true "Task ID"
+// @Success 200 {object} map[string]string "Success message"
+// @Failure 400 {object} dto.ErrorDTO "Invalid task ID"
+// @Failure 403 {object} dto.ErrorDTO "Insufficient clearance level"
+// @Failure 404 {object} dto.ErrorDTO "Task not found"
+// @Security BasicAuth
+// @Router /tasks/{id} [delete]
+func (ctrl *TaskController) DeleteTask(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid task ID",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	if err := ctrl.taskService.DeleteTask(user.(*model.User), uint(id)); err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Task deleted successfully"})
+}
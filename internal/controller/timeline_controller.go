@@ -0,0 +1,139 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/m7medVision/crime-management-system/internal/dto"
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"github.com/m7medVision/crime-management-system/internal/repository"
+	"github.com/m7medVision/crime-management-system/internal/service"
+)
+
+type TimelineController struct {
+	timelineService *service.TimelineService
+}
+
+func NewTimelineController(timelineService *service.TimelineService) *TimelineController {
+	return &TimelineController{timelineService: timelineService}
+}
+
+// parseTimeQuery reads an optional RFC3339 timestamp from the query string
+func parseTimeQuery(c *gin.Context, name string) (*time.Time, error) {
+	value := c.Query(name)
+	if value == "" {
+		return nil, nil
+	}
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+	return &parsed, nil
+}
+
+// GetCaseTimeline godoc
+// @Summary Get case activity timeline
+// @Description Get a chronological, paginated feed of everything that happened on a case: status changes, assignments, evidence, people, comments, report links and more. Raw before/after values are only included for admins.
+// @Tags cases
+// @Accept json
+// @Produce json
+// @Param id path string true "Case ID or reference number"
+// @Param types query string false "Comma-separated event types to include (e.g. status_changed,evidence_added)"
+// @Param actor query int false "Only include events by this user ID"
+// @Param since query string false "Only include events at or after this time (RFC3339)"
+// @Param until query string false "Only include events at or before this time (RFC3339)"
+// @Param order query string false "Sort order: desc (newest first) or asc" default(desc)
+// @Param offset query int false "Pagination offset" default(0)
+// @Param limit query int false "Items per page" default(20)
+// @Success 200 {object} map[string]interface{} "events and total count"
+// @Failure 400 {object} dto.ErrorDTO "Invalid filter"
+// @Failure 403 {object} dto.ErrorDTO "Insufficient clearance level"
+// @Failure 404 {object} dto.ErrorDTO "Case not found"
+// @Security BasicAuth
+// @Router /cases/{id}/timeline [get]
+func (ctrl *TimelineController) GetCaseTimeline(c *gin.Context) {
+	caseID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid case ID",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	offset, _ := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+
+	filter := repository.TimelineFilter{Ascending: c.Query("order") == "asc"}
+	if types := c.Query("types"); types != "" {
+		filter.Types = strings.Split(types, ",")
+	}
+	if actor := c.Query("actor"); actor != "" {
+		actorID, err := strconv.Atoi(actor)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+				Message: "Invalid actor ID",
+				Code:    http.StatusBadRequest,
+			})
+			return
+		}
+		id := uint(actorID)
+		filter.ActorID = &id
+	}
+	if filter.Since, err = parseTimeQuery(c, "since"); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid since time, expected RFC3339",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+	if filter.Until, err = parseTimeQuery(c, "until"); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid until time, expected RFC3339",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	items, total, err := ctrl.timelineService.GetCaseTimeline(user.(*model.User), uint(caseID), filter, offset, limit)
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	events := make([]dto.TimelineItemDTO, 0, len(items))
+	for _, item := range items {
+		events = append(events, dto.TimelineItemDTO{
+			Type:       item.Type,
+			EntityType: item.EntityType,
+			EntityID:   item.EntityID,
+			OccurredAt: item.OccurredAt.Format(time.RFC3339),
+			ActorID:    item.ActorID,
+			Actor:      item.ActorName,
+			Summary:    item.Summary,
+			Detail:     item.Detail,
+			OldValue:   item.OldValue,
+			NewValue:   item.NewValue,
+		})
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"events": events,
+		"total":  total,
+	})
+}
@@ -59,6 +59,8 @@ func (ctrl *UserController) CreateUser(c *gin.Context) {
 		Role:           model.Role(userDTO.Role),
 		ClearanceLevel: model.ClearanceLevel(userDTO.ClearanceLevel),
 		IsActive:       userDTO.IsActive,
+		IsSupervisor:   userDTO.IsSupervisor,
+		Area:           userDTO.Area,
 	}
 
 	result, err := ctrl.userService.CreateUser(user)
@@ -145,8 +147,23 @@ func (ctrl *UserController) UpdateUser(c *gin.Context) {
 	if updateDTO.IsActive != nil {
 		existingUser.IsActive = *updateDTO.IsActive
 	}
+	if updateDTO.IsSupervisor != nil {
+		existingUser.IsSupervisor = *updateDTO.IsSupervisor
+	}
+	if updateDTO.Area != nil {
+		existingUser.Area = *updateDTO.Area
+	}
+
+	actor, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
 
-	result, err := ctrl.userService.UpdateUser(existingUser)
+	result, err := ctrl.userService.UpdateUser(existingUser, actor.(*model.User).ID)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
 			Message: err.Error(),
@@ -266,3 +283,146 @@ func (ctrl *UserController) GetUser(c *gin.Context) {
 	user.Password = ""
 	c.JSON(http.StatusOK, user)
 }
+
+// PreviewClearanceChange godoc
+// @Summary Preview a clearance change
+// @Description Dry run: list the case assignments that would be flagged, removed or restored if the user's clearance were changed to the given level. Nothing is changed.
+// @Tags users,assignees
+// @Accept json
+// @Produce json
+// @Param id path int true "User ID"
+// @Param clearanceLevel query string false "Proposed clearance level (low, medium, high, critical), defaults to the current one"
+// @Success 200 {object} dto.ReconciliationReportDTO
+// @Failure 400 {object} dto.ErrorDTO "Invalid user ID or clearance level"
+// @Failure 404 {object} dto.ErrorDTO "User not found"
+// @Security BasicAuth
+// @Router /users/{id}/reconciliation [get]
+func (ctrl *UserController) PreviewClearanceChange(c *gin.Context) {
+	userID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid user ID",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	actor, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	level := model.ClearanceLevel(c.Query("clearanceLevel"))
+	report, err := ctrl.userService.PreviewClearanceChange(uint(userID), level, actor.(*model.User).ID)
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toReconciliationReport(report))
+}
+
+// ReconcileAssignments godoc
+// @Summary Reconcile a user's assignments
+// @Description Flag or remove (depending on CASE_RECONCILE_MODE) the user's assignments to cases they are no longer cleared for, restore flagged ones they are cleared for again, notify the case leads and record the changes in the case history. This also runs automatically when the user's clearance level changes.
+// @Tags users,assignees
+// @Accept json
+// @Produce json
+// @Param id path int true "User ID"
+// @Success 200 {object} dto.ReconciliationReportDTO
+// @Failure 400 {object} dto.ErrorDTO "Invalid user ID"
+// @Failure 404 {object} dto.ErrorDTO "User not found"
+// @Security BasicAuth
+// @Router /users/{id}/reconciliation [post]
+func (ctrl *UserController) ReconcileAssignments(c *gin.Context) {
+	userID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid user ID",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	actor, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	report, err := ctrl.userService.ReconcileAssignments(uint(userID), actor.(*model.User).ID)
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toReconciliationReport(report))
+}
+
+// DeactivateUser godoc
+// @Summary Deactivate a user
+// @Description Disable a user's account. With handoverToUserId, a handover of every open case the user is assigned to is requested first; the user stays assigned to each case until the handover is accepted.
+// @Tags users,handovers
+// @Accept json
+// @Produce json
+// @Param id path int true "User ID"
+// @Param deactivation body dto.DeactivateUserDTO false "Optional handover of the user's cases"
+// @Success 200 {object} dto.HandoverBatchResultDTO
+// @Failure 400 {object} dto.ErrorDTO "Invalid user ID or handover data"
+// @Failure 404 {object} dto.ErrorDTO "User not found"
+// @Security BasicAuth
+// @Router /users/{id}/deactivate [post]
+func (ctrl *UserController) DeactivateUser(c *gin.Context) {
+	userID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid user ID",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	var deactivateDTO dto.DeactivateUserDTO
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&deactivateDTO); err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+				Message: "Invalid deactivation data",
+				Code:    http.StatusBadRequest,
+			})
+			return
+		}
+	}
+
+	actor, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	result, err := ctrl.userService.DeactivateUser(actor.(*model.User), uint(userID), deactivateDTO.HandoverToUserID, deactivateDTO.Note)
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, toHandoverBatchResult(result))
+}
@@ -0,0 +1,189 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/m7medVision/crime-management-system/internal/dto"
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"github.com/m7medVision/crime-management-system/internal/service"
+)
+
+type WatcherController struct {
+	watcherService *service.WatcherService
+}
+
+func NewWatcherController(watcherService *service.WatcherService) *WatcherController {
+	return &WatcherController{watcherService: watcherService}
+}
+
+// WatchCase godoc
+// @Summary Watch a case
+// @Description Follow a case without being assigned to it. Watchers are notified of status changes, new evidence, new comments and new assignees.
+// @Tags cases,notifications
+// @Accept json
+// @Produce json
+// @Param id path string true "Case ID or reference number"
+// @Success 200 {object} map[string]string "Success message"
+// @Failure 400 {object} dto.ErrorDTO "Invalid case ID"
+// @Failure 403 {object} dto.ErrorDTO "Insufficient clearance level"
+// @Failure 404 {object} dto.ErrorDTO "Case not found"
+// @Security BasicAuth
+// @Router /cases/{id}/watch [post]
+func (ctrl *WatcherController) WatchCase(c *gin.Context) {
+	caseID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid case ID",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	if err := ctrl.watcherService.Watch(user.(*model.User), uint(caseID)); err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Watching case"})
+}
+
+// UnwatchCase godoc
+// @Summary Stop watching a case
+// @Description Stop following a case
+// @Tags cases,notifications
+// @Accept json
+// @Produce json
+// @Param id path string true "Case ID or reference number"
+// @Success 200 {object} map[string]string "Success message"
+// @Failure 400 {object} dto.ErrorDTO "Invalid case ID"
+// @Security BasicAuth
+// @Router /cases/{id}/watch [delete]
+func (ctrl *WatcherController) UnwatchCase(c *gin.Context) {
+	caseID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid case ID",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	if err := ctrl.watcherService.Unwatch(user.(*model.User), uint(caseID)); err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"message": "Stopped watching case"})
+}
+
+// ListWatchers godoc
+// @Summary List case watchers
+// @Description Get the users watching a case
+// @Tags cases,notifications
+// @Accept json
+// @Produce json
+// @Param id path string true "Case ID or reference number"
+// @Success 200 {array} dto.WatcherResponseDTO
+// @Failure 400 {object} dto.ErrorDTO "Invalid case ID"
+// @Failure 403 {object} dto.ErrorDTO "Insufficient clearance level"
+// @Failure 404 {object} dto.ErrorDTO "Case not found"
+// @Security BasicAuth
+// @Router /cases/{id}/watchers [get]
+func (ctrl *WatcherController) ListWatchers(c *gin.Context) {
+	caseID, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorDTO{
+			Message: "Invalid case ID",
+			Code:    http.StatusBadRequest,
+		})
+		return
+	}
+
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	watchers, err := ctrl.watcherService.ListWatchers(user.(*model.User), uint(caseID))
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    errorStatus(err),
+		})
+		return
+	}
+
+	response := make([]dto.WatcherResponseDTO, 0, len(watchers))
+	for _, watcher := range watchers {
+		response = append(response, dto.WatcherResponseDTO{
+			ID:       watcher.ID,
+			Username: watcher.Username,
+			FullName: watcher.FullName,
+		})
+	}
+
+	c.JSON(http.StatusOK, response)
+}
+
+// ListWatchedCases godoc
+// @Summary List the cases I watch
+// @Description Get the cases the current user watches, most recently watched first
+// @Tags cases,notifications
+// @Accept json
+// @Produce json
+// @Success 200 {array} model.Case
+// @Failure 401 {object} dto.ErrorDTO "Authentication required"
+// @Failure 500 {object} dto.ErrorDTO "Server error"
+// @Security BasicAuth
+// @Router /me/watching [get]
+func (ctrl *WatcherController) ListWatchedCases(c *gin.Context) {
+	user, exists := c.Get("user")
+	if !exists {
+		c.JSON(http.StatusUnauthorized, dto.ErrorDTO{
+			Message: "Authentication required",
+			Code:    http.StatusUnauthorized,
+		})
+		return
+	}
+
+	cases, err := ctrl.watcherService.ListWatchedCases(user.(*model.User))
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorDTO{
+			Message: err.Error(),
+			Code:    http.StatusInternalServerError,
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, cases)
+}
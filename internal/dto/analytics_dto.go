@@ -0,0 +1,27 @@
+package dto
+
+type CountDTO struct {
+	Key   string `json:"key"`
+	Count int64  `json:"count"`
+}
+
+type TimeBucketDTO struct {
+	Start string `json:"start"`
+	Count int64  `json:"count"`
+}
+
+type TrendDTO struct {
+	Key           string   `json:"key"`
+	Current       int64    `json:"current"`
+	Previous      int64    `json:"previous"`
+	Change        int64    `json:"change"`
+	ChangePercent *float64 `json:"changePercent,omitempty"`
+}
+
+type HotspotDTO struct {
+	Area      string   `json:"area"`
+	Count     int64    `json:"count"`
+	Share     float64  `json:"share"`
+	Latitude  *float64 `json:"latitude,omitempty"`
+	Longitude *float64 `json:"longitude,omitempty"`
+}
@@ -1,5 +1,18 @@
 package dto
 
 type AssigneeDTO struct {
-	UserID uint `json:"userId" binding:"required"`
+	UserID uint   `json:"userId" binding:"required"`
+	Role   string `json:"role" binding:"omitempty,oneof=lead support reviewer"` // defaults to support
+}
+
+// WorkloadDTO is the open case load of one investigator
+type WorkloadDTO struct {
+	UserID         uint   `json:"userId"`
+	Username       string `json:"username"`
+	FullName       string `json:"fullName"`
+	Area           string `json:"area,omitempty"`
+	ClearanceLevel string `json:"clearanceLevel"`
+	OpenCases      int64  `json:"openCases"`
+	LeadCases      int64  `json:"leadCases"`
+	LastAssignedAt string `json:"lastAssignedAt,omitempty"`
 }
@@ -0,0 +1,38 @@
+package dto
+
+// BulkCaseFilterDTO selects cases the same way as the case list query parameters
+type BulkCaseFilterDTO struct {
+	Search    string   `json:"search"`
+	Priority  string   `json:"priority" binding:"omitempty,oneof=low medium high critical"`
+	SLAStatus string   `json:"slaStatus" binding:"omitempty,oneof=on_track at_risk breached met"`
+	Status    string   `json:"status" binding:"omitempty,oneof=pending ongoing closed"`
+	Area      string   `json:"area"`
+	CaseType  string   `json:"caseType"`
+	Tags      []string `json:"tags"`
+	TagMatch  string   `json:"tagMatch" binding:"omitempty,oneof=all any"`
+}
+
+type BulkCaseDTO struct {
+	Operation          string             `json:"operation" binding:"required,oneof=assign unassign status tag untag authorization_level"`
+	CaseIDs            []uint             `json:"caseIds"`
+	Filter             *BulkCaseFilterDTO `json:"filter"`
+	UserID             uint               `json:"userId"`                                               // assign, unassign
+	Role               string             `json:"role" binding:"omitempty,oneof=lead support reviewer"` // assign
+	Status             string             `json:"status"`                                               // status
+	TagIDs             []uint             `json:"tagIds"`                                               // tag, untag
+	AuthorizationLevel string             `json:"authorizationLevel"`                                   // authorization_level
+	Atomic             bool               `json:"atomic"`                                               // apply to all cases or to none
+}
+
+type BulkItemResultDTO struct {
+	CaseID uint   `json:"caseId"`
+	Status string `json:"status"` // applied, unchanged, failed or rolled_back
+	Error  string `json:"error,omitempty"`
+}
+
+type BulkCaseResultDTO struct {
+	Applied   int                 `json:"applied"`
+	Unchanged int                 `json:"unchanged"`
+	Failed    int                 `json:"failed"`
+	Items     []BulkItemResultDTO `json:"items"`
+}
@@ -5,9 +5,28 @@ import (
 )
 
 type CaseDTO struct {
-	Name               string               `json:"name" binding:"required"`
-	Description        string               `json:"description" binding:"required"`
-	Area               string               `json:"area" binding:"required"`
-	CaseType           string               `json:"caseType" binding:"required"`
-	AuthorizationLevel model.ClearanceLevel `json:"authorizationLevel" binding:"required"`
+	Name               string                 `json:"name" binding:"required"`
+	Description        string                 `json:"description" binding:"required"`
+	Area               string                 `json:"area" binding:"required"`
+	CaseType           string                 `json:"caseType" binding:"required"`
+	AuthorizationLevel model.ClearanceLevel   `json:"authorizationLevel" binding:"required"`
+	Priority           model.CasePriority     `json:"priority" binding:"omitempty,oneof=low medium high critical"`
+	Address            string                 `json:"address"`
+	Latitude           *float64               `json:"latitude" binding:"omitempty,min=-90,max=90"`
+	Longitude          *float64               `json:"longitude" binding:"omitempty,min=-180,max=180"`
+	CustomFields       map[string]interface{} `json:"customFields"` // validated against the custom field schema of the case type
+}
+
+// CaseUpdateDTO is a partial case update; omitted fields are left unchanged
+type CaseUpdateDTO struct {
+	Name               *string                `json:"name,omitempty" binding:"omitempty,min=1"`
+	Description        *string                `json:"description,omitempty" binding:"omitempty,min=1"`
+	Area               *string                `json:"area,omitempty" binding:"omitempty,min=1"`
+	CaseType           *string                `json:"caseType,omitempty" binding:"omitempty,min=1"`
+	AuthorizationLevel *model.ClearanceLevel  `json:"authorizationLevel,omitempty" binding:"omitempty,oneof=low medium high critical"`
+	Priority           *model.CasePriority    `json:"priority,omitempty" binding:"omitempty,oneof=low medium high critical"`
+	Address            *string                `json:"address,omitempty"`
+	Latitude           *float64               `json:"latitude,omitempty" binding:"omitempty,min=-90,max=90"`
+	Longitude          *float64               `json:"longitude,omitempty" binding:"omitempty,min=-180,max=180"`
+	CustomFields       map[string]interface{} `json:"customFields,omitempty"`
 }
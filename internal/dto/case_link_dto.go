@@ -0,0 +1,27 @@
+package dto
+
+import "github.com/m7medVision/crime-management-system/internal/model"
+
+type CaseLinkDTO struct {
+	TargetCaseID        uint               `json:"targetCaseId" binding:"required_without=TargetCaseReference"`
+	TargetCaseReference string             `json:"targetCaseReference" binding:"required_without=TargetCaseID"`
+	LinkType            model.CaseLinkType `json:"linkType" binding:"required,oneof=related duplicate_of duplicated_by parent_of child_of same_suspect"`
+	Notes               string             `json:"notes"`
+}
+
+type MergeCaseDTO struct {
+	IntoCaseID        uint   `json:"intoCaseId" binding:"required_without=IntoCaseReference"`
+	IntoCaseReference string `json:"intoCaseReference" binding:"required_without=IntoCaseID"`
+}
+
+type RelatedCaseResponseDTO struct {
+	LinkID          uint   `json:"linkId"`
+	LinkType        string `json:"linkType"`
+	CaseID          uint   `json:"caseId"`
+	ReferenceNumber string `json:"referenceNumber"`
+	Name            string `json:"name"`
+	Status          string `json:"status"`
+	Notes           string `json:"notes,omitempty"`
+	CreatedBy       string `json:"createdBy"`
+	CreatedAt       string `json:"createdAt"`
+}
@@ -0,0 +1,40 @@
+package dto
+
+import (
+	"github.com/m7medVision/crime-management-system/internal/model"
+)
+
+type TemplateTaskDTO struct {
+	Title       string             `json:"title" binding:"required"`
+	Description string             `json:"description"`
+	Priority    model.TaskPriority `json:"priority" binding:"omitempty,oneof=low medium high urgent"`
+	DueInDays   int                `json:"dueInDays" binding:"min=0"` // due date relative to case creation, 0 for none
+}
+
+type CaseTemplateDTO struct {
+	Name               string                 `json:"name" binding:"required,max=64"`
+	Description        string                 `json:"description"`
+	CaseType           string                 `json:"caseType" binding:"required"`
+	CaseName           string                 `json:"caseName"`
+	CaseDescription    string                 `json:"caseDescription"` // description skeleton
+	Priority           model.CasePriority     `json:"priority" binding:"omitempty,oneof=low medium high critical"`
+	AuthorizationLevel model.ClearanceLevel   `json:"authorizationLevel" binding:"required,oneof=low medium high critical"`
+	CustomFields       map[string]interface{} `json:"customFields"` // custom field defaults
+	Tasks              []TemplateTaskDTO      `json:"tasks" binding:"dive"`
+	TagIDs             []uint                 `json:"tagIds"`
+}
+
+type CaseTemplateResponseDTO struct {
+	ID                 uint                   `json:"id"`
+	Name               string                 `json:"name"`
+	Description        string                 `json:"description,omitempty"`
+	CaseType           string                 `json:"caseType"`
+	CaseName           string                 `json:"caseName,omitempty"`
+	CaseDescription    string                 `json:"caseDescription,omitempty"`
+	Priority           string                 `json:"priority"`
+	AuthorizationLevel string                 `json:"authorizationLevel"`
+	CustomFields       map[string]interface{} `json:"customFields"`
+	Tasks              []TemplateTaskDTO      `json:"tasks"`
+	Tags               []TagResponseDTO       `json:"tags"`
+	UpdatedAt          string                 `json:"updatedAt"`
+}
@@ -0,0 +1,47 @@
+package dto
+
+import "github.com/m7medVision/crime-management-system/internal/model"
+
+type ClosureChecklistDTO struct {
+	EvidenceReviewed   bool `json:"evidenceReviewed"`
+	TasksDone          bool `json:"tasksDone"`
+	SuspectStatusesSet bool `json:"suspectStatusesSet"` // arrest status of every suspect is up to date
+}
+
+type CloseCaseDTO struct {
+	Disposition model.CaseDisposition `json:"disposition" binding:"required,oneof=solved_arrest solved_no_arrest unfounded insufficient_evidence referred withdrawn"`
+	Summary     string                `json:"summary" binding:"required"`
+	Checklist   ClosureChecklistDTO   `json:"checklist"`
+}
+
+type ClosureDecisionDTO struct {
+	Response string `json:"response"` // required to reject
+}
+
+type ClosureResponseDTO struct {
+	ID              uint                `json:"id"`
+	CaseID          uint                `json:"caseId"`
+	ReferenceNumber string              `json:"referenceNumber"`
+	Disposition     string              `json:"disposition"`
+	Summary         string              `json:"summary"`
+	Checklist       ClosureChecklistDTO `json:"checklist"`
+	Status          string              `json:"status"`
+	RequestedByID   uint                `json:"requestedById"`
+	RequestedBy     string              `json:"requestedBy"`
+	DecidedByID     *uint               `json:"decidedById,omitempty"`
+	DecidedBy       string              `json:"decidedBy,omitempty"`
+	Response        string              `json:"response,omitempty"`
+	CreatedAt       string              `json:"createdAt"`
+	DecidedAt       string              `json:"decidedAt,omitempty"`
+}
+
+// ClosureCheckDTO shows what the closure checklist of a case is checked against
+type ClosureCheckDTO struct {
+	Evidence         int64               `json:"evidence"`
+	OpenTasks        int64               `json:"openTasks"`
+	Suspects         int64               `json:"suspects"`
+	ArrestedSuspects int64               `json:"arrestedSuspects"`
+	ApprovalRequired bool                `json:"approvalRequired"`
+	Dispositions     []string            `json:"dispositions"`
+	Pending          *ClosureResponseDTO `json:"pending,omitempty"`
+}
@@ -0,0 +1,29 @@
+package dto
+
+type CreateCommentDTO struct {
+	Content  string `json:"content" binding:"required"`
+	ParentID *uint  `json:"parentId"`
+}
+
+type UpdateCommentDTO struct {
+	Content string `json:"content" binding:"required"`
+}
+
+type CommentResponseDTO struct {
+	ID        uint                 `json:"id"`
+	CaseID    uint                 `json:"caseId"`
+	ParentID  *uint                `json:"parentId,omitempty"`
+	Content   string               `json:"content"`
+	AuthorID  uint                 `json:"authorId"`
+	Author    string               `json:"author"`
+	IsDeleted bool                 `json:"isDeleted"`
+	EditedAt  string               `json:"editedAt,omitempty"`
+	CreatedAt string               `json:"createdAt"`
+	Replies   []CommentResponseDTO `json:"replies,omitempty"`
+}
+
+type CommentRevisionDTO struct {
+	Content  string `json:"content"`
+	EditedBy string `json:"editedBy"`
+	EditedAt string `json:"editedAt"`
+}
@@ -0,0 +1,25 @@
+package dto
+
+type CustomFieldDefinitionDTO struct {
+	Key       string   `json:"key" binding:"required"`
+	Label     string   `json:"label"`
+	Type      string   `json:"type" binding:"required,oneof=string number integer boolean date enum"`
+	Required  bool     `json:"required"`
+	Options   []string `json:"options,omitempty"`
+	Min       *float64 `json:"min,omitempty"`
+	Max       *float64 `json:"max,omitempty"`
+	MaxLength int      `json:"maxLength,omitempty"`
+	Pattern   string   `json:"pattern,omitempty"`
+}
+
+type CustomFieldSchemaDTO struct {
+	CaseType string                     `json:"caseType" binding:"required"`
+	Fields   []CustomFieldDefinitionDTO `json:"fields" binding:"dive"`
+}
+
+type CustomFieldSchemaResponseDTO struct {
+	ID        uint                       `json:"id"`
+	CaseType  string                     `json:"caseType"`
+	Fields    []CustomFieldDefinitionDTO `json:"fields"`
+	UpdatedAt string                     `json:"updatedAt"`
+}
@@ -0,0 +1,41 @@
+package dto
+
+type DashboardCaseDTO struct {
+	ID              uint   `json:"id"`
+	ReferenceNumber string `json:"referenceNumber"`
+	Name            string `json:"name"`
+	Status          string `json:"status"`
+	Priority        string `json:"priority"`
+	SLAStatus       string `json:"slaStatus"`
+	SLADueAt        string `json:"slaDueAt,omitempty"`
+	AssignedAt      string `json:"assignedAt,omitempty"`
+}
+
+type DashboardEvidenceDTO struct {
+	ID        uint   `json:"id"`
+	CaseID    uint   `json:"caseId"`
+	Type      string `json:"type"`
+	Remarks   string `json:"remarks,omitempty"`
+	AddedBy   string `json:"addedBy"`
+	CreatedAt string `json:"createdAt"`
+}
+
+type DashboardReportDTO struct {
+	ID               uint                `json:"id"`
+	Title            string              `json:"title"`
+	Location         string              `json:"location"`
+	CreatedAt        string              `json:"createdAt"`
+	LikelyDuplicates []DuplicateMatchDTO `json:"likelyDuplicates"`
+}
+
+type DashboardDTO struct {
+	AssignedCases         int64                  `json:"assignedCases"`
+	CasesByStatus         map[string]int64       `json:"casesByStatus"`
+	NewlyAssignedCases    []DashboardCaseDTO     `json:"newlyAssignedCases"`
+	OverdueTasks          []TaskResponseDTO      `json:"overdueTasks"`
+	BreachedCases         []DashboardCaseDTO     `json:"breachedCases"`
+	RecentEvidence        []DashboardEvidenceDTO `json:"recentEvidence"`
+	UntriagedReports      []DashboardReportDTO   `json:"untriagedReports"`
+	UntriagedReportsTotal int64                  `json:"untriagedReportsTotal"`
+	GeneratedAt           string                 `json:"generatedAt"`
+}
@@ -1,13 +1,14 @@
 package dto
 
 type CreateTextEvidenceDTO struct {
-	CaseID  uint   `json:"caseId" binding:"required"`
-	Content string `json:"content" binding:"required"`
-	Remarks string `json:"remarks"`
+	CaseID        uint   `json:"caseId" binding:"required_without=CaseReference"`
+	CaseReference string `json:"caseReference" binding:"required_without=CaseID"`
+	Content       string `json:"content" binding:"required"`
+	Remarks       string `json:"remarks"`
 }
 
 type UpdateEvidenceDTO struct {
-	Remarks string `json:"remarks"`
+	Remarks *string `json:"remarks"` // omitted remarks are left unchanged
 }
 
 type EvidenceResponseDTO struct {
@@ -0,0 +1,20 @@
+package dto
+
+// GeoJSONFeatureCollection is a GeoJSON (RFC 7946) FeatureCollection
+type GeoJSONFeatureCollection struct {
+	Type     string           `json:"type" example:"FeatureCollection"`
+	Features []GeoJSONFeature `json:"features"`
+}
+
+type GeoJSONFeature struct {
+	Type       string                 `json:"type" example:"Feature"`
+	ID         uint                   `json:"id"`
+	Geometry   GeoJSONPoint           `json:"geometry"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+// GeoJSONPoint holds coordinates in GeoJSON order: longitude, latitude
+type GeoJSONPoint struct {
+	Type        string    `json:"type" example:"Point"`
+	Coordinates []float64 `json:"coordinates"`
+}
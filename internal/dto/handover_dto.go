@@ -0,0 +1,51 @@
+package dto
+
+type CreateHandoverDTO struct {
+	ToUserID   uint   `json:"toUserId" binding:"required"`
+	Note       string `json:"note" binding:"required"`
+	FromUserID *uint  `json:"fromUserId"` // admins only, defaults to the caller
+}
+
+type HandOverAllDTO struct {
+	ToUserID uint   `json:"toUserId" binding:"required"`
+	Note     string `json:"note" binding:"required"`
+}
+
+type HandoverDecisionDTO struct {
+	Response string `json:"response"` // required to decline
+}
+
+type DeactivateUserDTO struct {
+	HandoverToUserID *uint  `json:"handoverToUserId"` // hand over all open cases to this investigator first
+	Note             string `json:"note"`
+}
+
+type HandoverResponseDTO struct {
+	ID              uint   `json:"id"`
+	CaseID          uint   `json:"caseId"`
+	ReferenceNumber string `json:"referenceNumber"`
+	FromUserID      uint   `json:"fromUserId"`
+	FromUser        string `json:"fromUser"`
+	ToUserID        uint   `json:"toUserId"`
+	ToUser          string `json:"toUser"`
+	Role            string `json:"role"`
+	Note            string `json:"note"`
+	Status          string `json:"status"`
+	RequestedByID   uint   `json:"requestedById"`
+	Response        string `json:"response,omitempty"`
+	CreatedAt       string `json:"createdAt"`
+	DecidedAt       string `json:"decidedAt,omitempty"`
+}
+
+type HandoverBatchItemDTO struct {
+	CaseID          uint                 `json:"caseId"`
+	ReferenceNumber string               `json:"referenceNumber"`
+	Handover        *HandoverResponseDTO `json:"handover,omitempty"`
+	Error           string               `json:"error,omitempty"`
+}
+
+type HandoverBatchResultDTO struct {
+	Requested int                    `json:"requested"`
+	Failed    int                    `json:"failed"`
+	Items     []HandoverBatchItemDTO `json:"items"`
+}
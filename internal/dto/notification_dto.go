@@ -0,0 +1,29 @@
+package dto
+
+type NotificationResponseDTO struct {
+	ID        uint   `json:"id"`
+	Type      string `json:"type"`
+	Title     string `json:"title"`
+	Message   string `json:"message,omitempty"`
+	CaseID    *uint  `json:"caseId,omitempty"`
+	ActorID   *uint  `json:"actorId,omitempty"`
+	Read      bool   `json:"read"`
+	ReadAt    string `json:"readAt,omitempty"`
+	CreatedAt string `json:"createdAt"`
+}
+
+type NotificationPreferenceDTO struct {
+	Type  string `json:"type" binding:"required"`
+	InApp bool   `json:"inApp"`
+	Email bool   `json:"email"`
+}
+
+type UpdateNotificationPreferencesDTO struct {
+	Preferences []NotificationPreferenceDTO `json:"preferences" binding:"required,dive"`
+}
+
+type WatcherResponseDTO struct {
+	ID       uint   `json:"id"`
+	Username string `json:"username"`
+	FullName string `json:"fullName"`
+}
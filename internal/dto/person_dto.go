@@ -0,0 +1,17 @@
+package dto
+
+// PersonDTO adds or updates a suspect, victim or witness. firstName and lastName are
+// required to add a person; on update omitted fields are left unchanged.
+type PersonDTO struct {
+	FirstName         *string `json:"firstName,omitempty" binding:"omitempty,min=1,max=100"`
+	LastName          *string `json:"lastName,omitempty" binding:"omitempty,min=1,max=100"`
+	Age               *int    `json:"age,omitempty" binding:"omitempty,min=0,max=150"`
+	Gender            *string `json:"gender,omitempty" binding:"omitempty,max=32"`
+	Address           *string `json:"address,omitempty" binding:"omitempty,max=255"`
+	PhoneNumber       *string `json:"phoneNumber,omitempty" binding:"omitempty,max=32"`
+	Notes             *string `json:"notes,omitempty"`
+	Description       *string `json:"description,omitempty"`       // suspects only
+	IsArrested        *bool   `json:"isArrested,omitempty"`        // suspects only
+	InjuryDescription *string `json:"injuryDescription,omitempty"` // victims only
+	Statement         *string `json:"statement,omitempty"`         // witnesses only
+}
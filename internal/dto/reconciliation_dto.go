@@ -0,0 +1,18 @@
+package dto
+
+type ReconciliationItemDTO struct {
+	CaseID             uint   `json:"caseId"`
+	ReferenceNumber    string `json:"referenceNumber"`
+	AuthorizationLevel string `json:"authorizationLevel"`
+	UserID             uint   `json:"userId"`
+	FullName           string `json:"fullName"`
+	ClearanceLevel     string `json:"clearanceLevel"`
+	Role               string `json:"role"`
+	Action             string `json:"action"` // removed, flagged or restored
+}
+
+type ReconciliationReportDTO struct {
+	DryRun bool                    `json:"dryRun"`
+	Mode   string                  `json:"mode"` // flag or remove
+	Items  []ReconciliationItemDTO `json:"items"`
+}
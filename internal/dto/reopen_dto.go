@@ -0,0 +1,27 @@
+package dto
+
+type ReopenCaseDTO struct {
+	Reason string `json:"reason" binding:"required"`
+}
+
+type ReopenDecisionDTO struct {
+	Response string `json:"response"` // required to reject
+}
+
+type ReopenResponseDTO struct {
+	ID                  uint   `json:"id"`
+	CaseID              uint   `json:"caseId"`
+	ReferenceNumber     string `json:"referenceNumber"`
+	Reason              string `json:"reason"`
+	PreviousDisposition string `json:"previousDisposition,omitempty"`
+	ClosedAt            string `json:"closedAt,omitempty"`
+	Status              string `json:"status"`
+	RequestedByID       uint   `json:"requestedById"`
+	RequestedBy         string `json:"requestedBy"`
+	DecidedByID         *uint  `json:"decidedById,omitempty"`
+	DecidedBy           string `json:"decidedBy,omitempty"`
+	Response            string `json:"response,omitempty"`
+	RestoredEvidence    int64  `json:"restoredEvidence"`
+	CreatedAt           string `json:"createdAt"`
+	DecidedAt           string `json:"decidedAt,omitempty"`
+}
@@ -1,10 +1,38 @@
 package dto
 
 type ReportDTO struct {
-	Title       string `json:"title" binding:"required"`
-	Description string `json:"description" binding:"required"`
-	Location    string `json:"location" binding:"required"`
-	CivilID     string `json:"civil_id" binding:"required"`
-	Email       string `json:"email" binding:"required"`
-	Name        string `json:"name" binding:"required"`
+	Title       string   `json:"title" binding:"required"`
+	Description string   `json:"description" binding:"required"`
+	Location    string   `json:"location" binding:"required"`
+	CivilID     string   `json:"civil_id" binding:"required"`
+	Email       string   `json:"email" binding:"required"`
+	Name        string   `json:"name" binding:"required"`
+	Address     string   `json:"address"`
+	Latitude    *float64 `json:"latitude" binding:"omitempty,min=-90,max=90"`
+	Longitude   *float64 `json:"longitude" binding:"omitempty,min=-180,max=180"`
+}
+
+type DuplicateMatchDTO struct {
+	Kind            string   `json:"kind"` // report or case
+	ID              uint     `json:"id"`
+	Title           string   `json:"title"`
+	ReferenceNumber string   `json:"referenceNumber,omitempty"`
+	CaseID          *uint    `json:"caseId,omitempty"` // case to link the report to
+	CaseReference   string   `json:"caseReference,omitempty"`
+	Score           int      `json:"score"`
+	TextScore       int      `json:"textScore"`
+	LocationScore   int      `json:"locationScore"`
+	TimeScore       int      `json:"timeScore"`
+	DistanceKm      *float64 `json:"distanceKm,omitempty"`
+	CreatedAt       string   `json:"createdAt"`
+}
+
+type LinkReportDTO struct {
+	CaseID uint `json:"caseId" binding:"required"`
+}
+
+type LinkedReportDTO struct {
+	ID      uint   `json:"id"`
+	Title   string `json:"title"`
+	CaseIDs []uint `json:"caseIds"`
 }
@@ -0,0 +1,31 @@
+package dto
+
+type SavedViewDTO struct {
+	Name        string   `json:"name" binding:"required,max=100"`
+	Description string   `json:"description"`
+	Query       string   `json:"query"`   // case list query string, e.g. priority=high&area=Muscat&sort=-createdAt
+	Columns     []string `json:"columns"` // columns to show, all when empty
+	Shared      bool     `json:"shared"`  // share with the users of your home area
+	Watch       bool     `json:"watch"`   // notify me when new cases match
+}
+
+type SavedViewResponseDTO struct {
+	ID            uint     `json:"id"`
+	Name          string   `json:"name"`
+	Description   string   `json:"description,omitempty"`
+	Query         string   `json:"query"`
+	Columns       []string `json:"columns"`
+	OwnerID       uint     `json:"ownerId"`
+	OwnerName     string   `json:"ownerName"`
+	SharedArea    string   `json:"sharedArea,omitempty"`
+	Watch         bool     `json:"watch"`
+	LastCheckedAt string   `json:"lastCheckedAt,omitempty"`
+	UpdatedAt     string   `json:"updatedAt"`
+}
+
+type SavedViewResultDTO struct {
+	View    SavedViewResponseDTO     `json:"view"`
+	Columns []string                 `json:"columns"`
+	Rows    []map[string]interface{} `json:"rows"`
+	Total   int64                    `json:"total"`
+}
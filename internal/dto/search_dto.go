@@ -0,0 +1,11 @@
+package dto
+
+type SearchResultDTO struct {
+	EntityType string  `json:"entityType"`
+	EntityID   uint    `json:"entityId"`
+	CaseID     uint    `json:"caseId"`
+	CaseName   string  `json:"caseName"`
+	Rank       float64 `json:"rank"`
+	Snippet    string  `json:"snippet"`
+	CreatedAt  string  `json:"createdAt"`
+}
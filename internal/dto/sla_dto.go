@@ -0,0 +1,13 @@
+package dto
+
+import (
+	"github.com/m7medVision/crime-management-system/internal/model"
+)
+
+type SLAPolicyDTO struct {
+	CaseType               string             `json:"caseType"` // empty applies to all case types without their own policy
+	Priority               model.CasePriority `json:"priority" binding:"required,oneof=low medium high critical"`
+	FirstAssignmentMinutes int                `json:"firstAssignmentMinutes" binding:"min=0"`
+	FirstUpdateMinutes     int                `json:"firstUpdateMinutes" binding:"min=0"`
+	ClosureMinutes         int                `json:"closureMinutes" binding:"min=0"`
+}
@@ -0,0 +1,34 @@
+package dto
+
+type CreateTagDTO struct {
+	Name         string `json:"name" binding:"required,max=64"`
+	Color        string `json:"color"`
+	Description  string `json:"description"`
+	MinClearance string `json:"minClearance" binding:"omitempty,oneof=low medium high critical"`
+}
+
+type UpdateTagDTO struct {
+	Name         *string `json:"name,omitempty" binding:"omitempty,max=64"`
+	Color        *string `json:"color,omitempty"`
+	Description  *string `json:"description,omitempty"`
+	MinClearance *string `json:"minClearance,omitempty" binding:"omitempty,oneof=low medium high critical"`
+}
+
+type TaggedEntityDTO struct {
+	Type string `json:"type" binding:"required,oneof=case evidence suspect victim witness"`
+	ID   uint   `json:"id" binding:"required"`
+}
+
+type BulkTagDTO struct {
+	Action   string            `json:"action" binding:"required,oneof=tag untag"`
+	TagIDs   []uint            `json:"tagIds" binding:"required,min=1"`
+	Entities []TaggedEntityDTO `json:"entities" binding:"required,min=1,dive"`
+}
+
+type TagResponseDTO struct {
+	ID           uint   `json:"id"`
+	Name         string `json:"name"`
+	Color        string `json:"color,omitempty"`
+	Description  string `json:"description,omitempty"`
+	MinClearance string `json:"minClearance"`
+}
@@ -0,0 +1,43 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/m7medVision/crime-management-system/internal/model"
+)
+
+type CreateTaskDTO struct {
+	Title       string             `json:"title" binding:"required"`
+	Description string             `json:"description"`
+	AssigneeID  *uint              `json:"assigneeId"`
+	DueDate     *time.Time         `json:"dueDate"`
+	Priority    model.TaskPriority `json:"priority" binding:"omitempty,oneof=low medium high urgent"`
+}
+
+type UpdateTaskDTO struct {
+	Title        *string             `json:"title,omitempty"`
+	Description  *string             `json:"description,omitempty"`
+	AssigneeID   *uint               `json:"assigneeId,omitempty"`
+	Unassign     bool                `json:"unassign,omitempty"`
+	DueDate      *time.Time          `json:"dueDate,omitempty"`
+	ClearDueDate bool                `json:"clearDueDate,omitempty"`
+	Priority     *model.TaskPriority `json:"priority,omitempty" binding:"omitempty,oneof=low medium high urgent"`
+	Status       *model.TaskStatus   `json:"status,omitempty" binding:"omitempty,oneof=open in_progress done cancelled"`
+}
+
+type TaskResponseDTO struct {
+	ID          uint   `json:"id"`
+	CaseID      uint   `json:"caseId"`
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	AssigneeID  *uint  `json:"assigneeId,omitempty"`
+	Assignee    string `json:"assignee,omitempty"`
+	DueDate     string `json:"dueDate,omitempty"`
+	Priority    string `json:"priority"`
+	Status      string `json:"status"`
+	IsOverdue   bool   `json:"isOverdue"`
+	CompletedAt string `json:"completedAt,omitempty"`
+	CreatedBy   string `json:"createdBy"`
+	CreatedAt   string `json:"createdAt"`
+	UpdatedAt   string `json:"updatedAt"`
+}
@@ -0,0 +1,14 @@
+package dto
+
+type TimelineItemDTO struct {
+	Type       string `json:"type"`
+	EntityType string `json:"entityType"`
+	EntityID   uint   `json:"entityId"`
+	OccurredAt string `json:"occurredAt"`
+	ActorID    *uint  `json:"actorId,omitempty"`
+	Actor      string `json:"actor,omitempty"`
+	Summary    string `json:"summary"`
+	Detail     string `json:"detail,omitempty"`
+	OldValue   string `json:"oldValue,omitempty"`
+	NewValue   string `json:"newValue,omitempty"`
+}
@@ -8,13 +8,17 @@ type UserDTO struct {
 	Role           string `json:"role" binding:"required,oneof=admin investigator officer citizen"`
 	ClearanceLevel string `json:"clearanceLevel" binding:"required,oneof=low medium high critical"`
 	IsActive       bool   `json:"isActive"`
+	IsSupervisor   bool   `json:"isSupervisor"`
+	Area           string `json:"area"`
 }
 
 type UpdateUserDTO struct {
-	Email          string `json:"email,omitempty" binding:"omitempty,email"`
-	FullName       string `json:"fullName,omitempty"`
-	Password       string `json:"password,omitempty"`
-	Role           string `json:"role,omitempty" binding:"omitempty,oneof=admin investigator officer citizen"`
-	ClearanceLevel string `json:"clearanceLevel,omitempty" binding:"omitempty,oneof=low medium high critical"`
-	IsActive       *bool  `json:"isActive,omitempty"`
+	Email          string  `json:"email,omitempty" binding:"omitempty,email"`
+	FullName       string  `json:"fullName,omitempty"`
+	Password       string  `json:"password,omitempty"`
+	Role           string  `json:"role,omitempty" binding:"omitempty,oneof=admin investigator officer citizen"`
+	ClearanceLevel string  `json:"clearanceLevel,omitempty" binding:"omitempty,oneof=low medium high critical"`
+	IsActive       *bool   `json:"isActive,omitempty"`
+	IsSupervisor   *bool   `json:"isSupervisor,omitempty"`
+	Area           *string `json:"area,omitempty"`
 }
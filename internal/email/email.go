@@ -0,0 +1,74 @@
+package email
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// Message is a plain-text email to one recipient
+type Message struct {
+	To      string
+	Subject string
+	Text    string
+}
+
+// Sender delivers email
+type Sender interface {
+	Send(message Message) error
+}
+
+const resendEndpoint = "https://api.resend.com/emails"
+
+// ResendSender sends email through the Resend HTTP API
+type ResendSender struct {
+	apiKey string
+	from   string
+	client *http.Client
+}
+
+func NewResendSender(apiKey, fromEmail, fromName string) *ResendSender {
+	from := fromEmail
+	if fromName != "" {
+		from = fmt.Sprintf("%s <%s>", fromName, fromEmail)
+	}
+	return &ResendSender{
+		apiKey: apiKey,
+		from:   from,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (s *ResendSender) Send(message Message) error {
+	body, err := json.Marshal(map[string]interface{}{
+		"from":    s.from,
+		"to":      []string{message.To},
+		"subject": message.Subject,
+		"text":    message.Text,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, resendEndpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+s.apiKey)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("email provider returned %s: %s", resp.Status, bytes.TrimSpace(detail))
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package geo
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Gazetteer is an offline geocoder backed by a list of known place names
+type Gazetteer struct {
+	places map[string]Location
+}
+
+func NewGazetteer() *Gazetteer {
+	return &Gazetteer{places: map[string]Location{}}
+}
+
+// LoadGazetteer reads places from a CSV file with name,latitude,longitude rows.
+// A header row and lines starting with # are skipped.
+func LoadGazetteer(path string) (*Gazetteer, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = 3
+	reader.TrimLeadingSpace = true
+
+	gazetteer := NewGazetteer()
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read gazetteer: %w", err)
+		}
+
+		latitude, latErr := strconv.ParseFloat(record[1], 64)
+		longitude, lonErr := strconv.ParseFloat(record[2], 64)
+		if latErr != nil || lonErr != nil {
+			if line == 1 {
+				continue // header row
+			}
+			return nil, fmt.Errorf("invalid coordinates for %q in gazetteer", record[0])
+		}
+		if err := gazetteer.Add(record[0], Point{Latitude: latitude, Longitude: longitude}); err != nil {
+			return nil, err
+		}
+	}
+	return gazetteer, nil
+}
+
+// Add registers a place name
+func (g *Gazetteer) Add(name string, point Point) error {
+	if !point.Valid() {
+		return fmt.Errorf("coordinates out of range for %q", name)
+	}
+	g.places[normalizePlace(name)] = Location{Point: point, Address: strings.TrimSpace(name)}
+	return nil
+}
+
+// Geocode looks up a place by name. If there is no exact match, the longest
+// known place name contained in the query is used, so "12 Harbour Road, Old Town"
+// resolves to "Old Town".
+func (g *Gazetteer) Geocode(query string) (*Location, error) {
+	normalized := normalizePlace(query)
+	if normalized == "" {
+		return nil, ErrNotFound
+	}
+	if location, ok := g.places[normalized]; ok {
+		return &location, nil
+	}
+
+	var best string
+	padded := " " + normalized + " "
+	for name := range g.places {
+		if len(name) > len(best) && strings.Contains(padded, " "+name+" ") {
+			best = name
+		}
+	}
+	if best == "" {
+		return nil, ErrNotFound
+	}
+	location := g.places[best]
+	return &location, nil
+}
+
+// normalizePlace lowercases a place name and reduces punctuation and spacing to single spaces
+func normalizePlace(name string) string {
+	fields := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r > 127)
+	})
+	return strings.Join(fields, " ")
+}
@@ -0,0 +1,114 @@
+package geo
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+const earthRadiusKm = 6371.0
+
+var ErrNotFound = errors.New("location not found")
+
+// Point is a WGS84 coordinate
+type Point struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// Valid reports whether the point lies within the WGS84 coordinate range
+func (p Point) Valid() bool {
+	return p.Latitude >= -90 && p.Latitude <= 90 && p.Longitude >= -180 && p.Longitude <= 180
+}
+
+// BoundingBox is a rectangular area between two corners
+type BoundingBox struct {
+	MinLatitude  float64
+	MinLongitude float64
+	MaxLatitude  float64
+	MaxLongitude float64
+}
+
+// Location is the result of geocoding a place name or address
+type Location struct {
+	Point
+	Address string
+}
+
+// Geocoder turns a free-text place name or address into coordinates
+type Geocoder interface {
+	Geocode(query string) (*Location, error)
+}
+
+// ParsePoint parses a "lat,lon" pair
+func ParsePoint(value string) (Point, error) {
+	parts, err := parseFloats(value, 2)
+	if err != nil {
+		return Point{}, err
+	}
+	point := Point{Latitude: parts[0], Longitude: parts[1]}
+	if !point.Valid() {
+		return Point{}, fmt.Errorf("coordinates out of range: %s", value)
+	}
+	return point, nil
+}
+
+// ParseBoundingBox parses a "minLon,minLat,maxLon,maxLat" box, the order used by GeoJSON
+func ParseBoundingBox(value string) (BoundingBox, error) {
+	parts, err := parseFloats(value, 4)
+	if err != nil {
+		return BoundingBox{}, err
+	}
+	box := BoundingBox{MinLongitude: parts[0], MinLatitude: parts[1], MaxLongitude: parts[2], MaxLatitude: parts[3]}
+	min := Point{Latitude: box.MinLatitude, Longitude: box.MinLongitude}
+	max := Point{Latitude: box.MaxLatitude, Longitude: box.MaxLongitude}
+	if !min.Valid() || !max.Valid() || box.MinLatitude > box.MaxLatitude || box.MinLongitude > box.MaxLongitude {
+		return BoundingBox{}, fmt.Errorf("invalid bounding box: %s", value)
+	}
+	return box, nil
+}
+
+func parseFloats(value string, count int) ([]float64, error) {
+	fields := strings.Split(value, ",")
+	if len(fields) != count {
+		return nil, fmt.Errorf("expected %d comma-separated numbers, got %q", count, value)
+	}
+	numbers := make([]float64, 0, count)
+	for _, field := range fields {
+		number, err := strconv.ParseFloat(strings.TrimSpace(field), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q", field)
+		}
+		numbers = append(numbers, number)
+	}
+	return numbers, nil
+}
+
+// DistanceKm returns the great-circle distance between two points using the haversine formula
+func DistanceKm(a, b Point) float64 {
+	lat1 := a.Latitude * math.Pi / 180
+	lat2 := b.Latitude * math.Pi / 180
+	dLat := lat2 - lat1
+	dLon := (b.Longitude - a.Longitude) * math.Pi / 180
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(h))
+}
+
+// BoundingBoxAround returns a box that contains every point within radiusKm of the centre.
+// It is used to narrow down candidates before the exact distance check.
+func BoundingBoxAround(centre Point, radiusKm float64) BoundingBox {
+	latDelta := radiusKm / earthRadiusKm * 180 / math.Pi
+	lonDelta := 180.0
+	if cos := math.Cos(centre.Latitude * math.Pi / 180); cos > 1e-6 {
+		lonDelta = math.Min(180, latDelta/cos)
+	}
+	return BoundingBox{
+		MinLatitude:  math.Max(-90, centre.Latitude-latDelta),
+		MaxLatitude:  math.Min(90, centre.Latitude+latDelta),
+		MinLongitude: math.Max(-180, centre.Longitude-lonDelta),
+		MaxLongitude: math.Min(180, centre.Longitude+lonDelta),
+	}
+}
@@ -2,6 +2,8 @@ package middleware
 
 import (
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/m7medVision/crime-management-system/internal/auth"
@@ -87,6 +89,61 @@ func RequireClearance(minClearance model.ClearanceLevel) gin.HandlerFunc {
 	}
 }
 
+// RequireSupervisor allows supervisors and admins
+func RequireSupervisor() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userInterface, exists := c.Get("user")
+		if !exists {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Authentication required"})
+			return
+		}
+
+		user, ok := userInterface.(*model.User)
+		if !ok {
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "Internal server error"})
+			return
+		}
+
+		if !user.IsSupervisor && user.Role != model.RoleAdmin {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "Supervisor access required"})
+			return
+		}
+
+		c.Next()
+	}
+}
+
+// ResolveCaseReference lets case routes accept a reference number (e.g. DC-2026-000123)
+// in place of the numeric :id parameter by rewriting it to the case ID
+func ResolveCaseReference(db *gorm.DB) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !strings.HasPrefix(c.FullPath(), "/api/cases/:id") {
+			c.Next()
+			return
+		}
+
+		for i, param := range c.Params {
+			if param.Key != "id" {
+				continue
+			}
+			if _, err := strconv.ParseUint(param.Value, 10, 64); err == nil {
+				break
+			}
+
+			caseRepo := repository.NewCaseRepository(db)
+			caseID, err := caseRepo.ResolveID(param.Value)
+			if err != nil {
+				c.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": "Case not found"})
+				return
+			}
+			c.Params[i].Value = strconv.FormatUint(uint64(caseID), 10)
+			break
+		}
+
+		c.Next()
+	}
+}
+
 func AuditLogger() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		// Process request
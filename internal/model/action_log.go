@@ -10,6 +10,7 @@ const (
 	ActionCreate ActionType = "create"
 	ActionUpdate ActionType = "update"
 	ActionDelete ActionType = "delete"
+	ActionMerge  ActionType = "merge"
 )
 
 type AuditLog struct {
@@ -19,6 +20,7 @@ type AuditLog struct {
 	Action     ActionType `gorm:"not null"`
 	EntityType string     `gorm:"not null"` // "evidence", "case", "user", etc.
 	EntityID   uint       `gorm:"not null"`
+	CaseID     *uint      `gorm:"index"` // Case the entry belongs to, used for case history
 	OldValue   string     `gorm:"type:text"`
 	NewValue   string     `gorm:"type:text"`
 	IPAddress  string
@@ -0,0 +1,30 @@
+package model
+
+import "time"
+
+type AssignmentRole string
+
+const (
+	AssignmentLead     AssignmentRole = "lead"
+	AssignmentSupport  AssignmentRole = "support"
+	AssignmentReviewer AssignmentRole = "reviewer"
+)
+
+// IsValid reports whether the role is one of the known assignment roles
+func (r AssignmentRole) IsValid() bool {
+	switch r {
+	case AssignmentLead, AssignmentSupport, AssignmentReviewer:
+		return true
+	}
+	return false
+}
+
+// CaseAssignee is the join table behind Case.Assignees. A case has at most one lead.
+type CaseAssignee struct {
+	CaseID          uint           `gorm:"primaryKey"`
+	UserID          uint           `gorm:"primaryKey"`
+	Role            AssignmentRole `gorm:"not null;default:'support'"`
+	AssignedAt      time.Time      `gorm:"not null;default:CURRENT_TIMESTAMP"`
+	AssignedByID    *uint          // Nil for assignments made before roles were recorded
+	IneligibleSince *time.Time     // Set while the assignee is not cleared for the case
+}
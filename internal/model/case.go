@@ -1,6 +1,8 @@
 package model
 
 import (
+	"time"
+
 	"gorm.io/gorm"
 )
 
@@ -12,14 +14,48 @@ const (
 	StatusClosed  CaseStatus = "closed"
 )
 
+// IsValid reports whether the status is one of the known case statuses
+func (s CaseStatus) IsValid() bool {
+	switch s {
+	case StatusPending, StatusOngoing, StatusClosed:
+		return true
+	}
+	return false
+}
+
+type CasePriority string
+
+const (
+	PriorityLow      CasePriority = "low"
+	PriorityMedium   CasePriority = "medium"
+	PriorityHigh     CasePriority = "high"
+	PriorityCritical CasePriority = "critical"
+)
+
+// IsValid reports whether the priority is one of the known priorities
+func (p CasePriority) IsValid() bool {
+	switch p {
+	case PriorityLow, PriorityMedium, PriorityHigh, PriorityCritical:
+		return true
+	}
+	return false
+}
+
 type Case struct {
 	gorm.Model
+	ReferenceNumber    string         `gorm:"uniqueIndex;size:32"`
 	Name               string         `gorm:"not null"`
 	Description        string         `gorm:"type:text;not null"`
 	Area               string         `gorm:"not null"` // City/Area
+	Address            string         `gorm:"size:255"`
+	Latitude           *float64       `gorm:"index:idx_case_location"`
+	Longitude          *float64       `gorm:"index:idx_case_location"`
 	CaseType           string         `gorm:"not null"`
+	CustomFields       CustomFields   `gorm:"type:jsonb;not null;default:'{}'"` // Validated against the CustomFieldSchema of the case type
 	Status             CaseStatus     `gorm:"not null;default:'pending'"`
+	Priority           CasePriority   `gorm:"not null;default:'medium';index"`
 	AuthorizationLevel ClearanceLevel `gorm:"not null;default:'low'"`
+	Version            int            `gorm:"not null;default:1"` // Incremented on every update, used for optimistic locking
 	CreatedByID        uint           `gorm:"not null"`
 	CreatedBy          User           `gorm:"foreignKey:CreatedByID"`
 	Reports            []Report       `gorm:"many2many:case_reports;"`
@@ -28,4 +64,22 @@ type Case struct {
 	Suspects           []Suspect      `gorm:"foreignKey:CaseID"`
 	Victims            []Victim       `gorm:"foreignKey:CaseID"`
 	Witnesses          []Witness      `gorm:"foreignKey:CaseID"`
+	MergedIntoID       *uint          // Set when this case was merged into another case
+	MergedAt           *time.Time
+	// Outcome recorded by the closure workflow, cleared when the case is reopened
+	Disposition    CaseDisposition `gorm:"size:32;index"`
+	ClosingSummary string          `gorm:"type:text"`
+	// SLA tracking
+	FirstAssignedAt *time.Time
+	FirstUpdatedAt  *time.Time
+	ClosedAt        *time.Time
+	SLAStatus       SLAStatus  `gorm:"not null;default:'on_track';index"`
+	SLADueAt        *time.Time // Closure deadline under the applicable SLA policy
+	EscalatedAt     *time.Time
+}
+
+// CaseReferenceCounter holds the last sequence number issued for a reference scope (area code and year)
+type CaseReferenceCounter struct {
+	Scope string `gorm:"primaryKey"`
+	Value int64  `gorm:"not null"`
 }
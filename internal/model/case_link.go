@@ -0,0 +1,46 @@
+package model
+
+import (
+	"gorm.io/gorm"
+)
+
+type CaseLinkType string
+
+const (
+	LinkRelated     CaseLinkType = "related"
+	LinkDuplicateOf CaseLinkType = "duplicate_of"
+	LinkDuplicateBy CaseLinkType = "duplicated_by"
+	LinkParentOf    CaseLinkType = "parent_of"
+	LinkChildOf     CaseLinkType = "child_of"
+	LinkSameSuspect CaseLinkType = "same_suspect"
+)
+
+// Inverse returns the link type as seen from the other case
+func (t CaseLinkType) Inverse() CaseLinkType {
+	switch t {
+	case LinkDuplicateOf:
+		return LinkDuplicateBy
+	case LinkDuplicateBy:
+		return LinkDuplicateOf
+	case LinkParentOf:
+		return LinkChildOf
+	case LinkChildOf:
+		return LinkParentOf
+	default:
+		return t
+	}
+}
+
+// CaseLink is a typed, directed relationship between two cases.
+// Links are stored in canonical form (duplicate_of, parent_of, related, same_suspect).
+type CaseLink struct {
+	gorm.Model
+	SourceCaseID uint         `gorm:"not null;uniqueIndex:idx_case_link"`
+	SourceCase   Case         `gorm:"foreignKey:SourceCaseID"`
+	TargetCaseID uint         `gorm:"not null;uniqueIndex:idx_case_link"`
+	TargetCase   Case         `gorm:"foreignKey:TargetCaseID"`
+	LinkType     CaseLinkType `gorm:"not null;uniqueIndex:idx_case_link"`
+	Notes        string       `gorm:"type:text"`
+	CreatedByID  uint         `gorm:"not null"`
+	CreatedBy    User         `gorm:"foreignKey:CreatedByID"`
+}
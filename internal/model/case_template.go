@@ -0,0 +1,47 @@
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+
+	"gorm.io/gorm"
+)
+
+// TemplateTask is a checklist task created on every case made from a template.
+// It is stored as JSON, so the field names are part of the stored format.
+type TemplateTask struct {
+	Title       string       `json:"title"`
+	Description string       `json:"description,omitempty"`
+	Priority    TaskPriority `json:"priority,omitempty"`
+	DueInDays   int          `json:"dueInDays,omitempty"` // due date relative to case creation, 0 means no due date
+}
+
+// TemplateTasks is the ordered checklist of a template, stored as JSONB
+type TemplateTasks []TemplateTask
+
+func (t TemplateTasks) Value() (driver.Value, error) {
+	if t == nil {
+		return "[]", nil
+	}
+	data, err := json.Marshal(t)
+	return string(data), err
+}
+
+func (t *TemplateTasks) Scan(value interface{}) error {
+	return scanJSON(value, t)
+}
+
+// CaseTemplate is an admin-managed starting point for cases of a recurring crime type
+type CaseTemplate struct {
+	gorm.Model
+	Name               string         `gorm:"uniqueIndex;not null;size:64"`
+	Description        string         // what the template is for, shown when picking one
+	CaseType           string         `gorm:"not null"`
+	CaseName           string         // default case name
+	CaseDescription    string         `gorm:"type:text"` // description skeleton
+	Priority           CasePriority   `gorm:"not null;default:'medium'"`
+	AuthorizationLevel ClearanceLevel `gorm:"not null;default:'low'"`
+	CustomFields       CustomFields   `gorm:"type:jsonb;not null;default:'{}'"` // custom field defaults
+	Tasks              TemplateTasks  `gorm:"type:jsonb;not null;default:'[]'"`
+	Tags               []Tag          `gorm:"many2many:case_template_tags;"`
+}
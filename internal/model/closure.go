@@ -0,0 +1,86 @@
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// CaseDisposition is the outcome a case was closed with
+type CaseDisposition string
+
+const (
+	DispositionSolvedArrest         CaseDisposition = "solved_arrest"
+	DispositionSolvedNoArrest       CaseDisposition = "solved_no_arrest" // cleared by exceptional means
+	DispositionUnfounded            CaseDisposition = "unfounded"
+	DispositionInsufficientEvidence CaseDisposition = "insufficient_evidence"
+	DispositionReferred             CaseDisposition = "referred"  // handed to another agency
+	DispositionWithdrawn            CaseDisposition = "withdrawn" // complaint withdrawn
+	// DispositionMerged is recorded when a case is merged into another. It cannot be chosen when closing a case.
+	DispositionMerged CaseDisposition = "merged"
+)
+
+// CaseDispositions lists the dispositions a case can be closed with
+var CaseDispositions = []CaseDisposition{
+	DispositionSolvedArrest,
+	DispositionSolvedNoArrest,
+	DispositionUnfounded,
+	DispositionInsufficientEvidence,
+	DispositionReferred,
+	DispositionWithdrawn,
+}
+
+// IsValid reports whether the disposition is one of the known dispositions
+func (d CaseDisposition) IsValid() bool {
+	for _, known := range CaseDispositions {
+		if d == known {
+			return true
+		}
+	}
+	return false
+}
+
+type ClosureStatus string
+
+const (
+	ClosurePending  ClosureStatus = "pending"
+	ClosureApproved ClosureStatus = "approved"
+	ClosureRejected ClosureStatus = "rejected"
+)
+
+// ClosureChecklist is what the closing investigator confirmed before closing a case.
+// It is stored as JSON, so the field names are part of the stored format.
+type ClosureChecklist struct {
+	EvidenceReviewed   bool `json:"evidenceReviewed"`
+	TasksDone          bool `json:"tasksDone"`
+	SuspectStatusesSet bool `json:"suspectStatusesSet"` // arrest status of every suspect is up to date
+}
+
+func (c ClosureChecklist) Value() (driver.Value, error) {
+	data, err := json.Marshal(c)
+	return string(data), err
+}
+
+func (c *ClosureChecklist) Scan(value interface{}) error {
+	return scanJSON(value, c)
+}
+
+// CaseClosure is a request to close a case with a disposition. Closures by supervisors,
+// or when no approval is required, are approved as soon as they are made.
+type CaseClosure struct {
+	gorm.Model
+	CaseID        uint             `gorm:"not null;index"`
+	Case          Case             `gorm:"foreignKey:CaseID"`
+	Disposition   CaseDisposition  `gorm:"not null;size:32"`
+	Summary       string           `gorm:"type:text;not null"`
+	Checklist     ClosureChecklist `gorm:"type:jsonb;not null;default:'{}'"`
+	Status        ClosureStatus    `gorm:"not null;default:'pending';index"`
+	RequestedByID uint             `gorm:"not null"`
+	RequestedBy   User             `gorm:"foreignKey:RequestedByID"`
+	DecidedByID   *uint
+	DecidedBy     *User  `gorm:"foreignKey:DecidedByID"`
+	Response      string `gorm:"type:text"`
+	DecidedAt     *time.Time
+}
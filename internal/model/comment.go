@@ -1,6 +1,8 @@
 package model
 
 import (
+	"time"
+
 	"gorm.io/gorm"
 )
 
@@ -8,8 +10,20 @@ type Comment struct {
 	gorm.Model
 	CaseID    uint   `gorm:"not null"`
 	Case      Case   `gorm:"foreignKey:CaseID"`
+	ParentID  *uint  `gorm:"index"` // Top-level comment this is a reply to
 	Content   string `gorm:"type:text;not null"`
 	UserID    uint   `gorm:"not null"`
 	User      User   `gorm:"foreignKey:UserID"`
 	IPAddress string
+	EditedAt  *time.Time
+	IsDeleted bool `gorm:"default:false"` // For soft delete, keeps replies attached to the thread
+}
+
+// CommentRevision stores the previous content of a comment each time it is edited
+type CommentRevision struct {
+	gorm.Model
+	CommentID  uint   `gorm:"not null;index"`
+	Content    string `gorm:"type:text;not null"`
+	EditedByID uint   `gorm:"not null"`
+	EditedBy   User   `gorm:"foreignKey:EditedByID"`
 }
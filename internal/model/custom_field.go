@@ -0,0 +1,103 @@
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+type CustomFieldType string
+
+const (
+	CustomFieldString  CustomFieldType = "string"
+	CustomFieldNumber  CustomFieldType = "number"
+	CustomFieldInteger CustomFieldType = "integer"
+	CustomFieldBoolean CustomFieldType = "boolean"
+	CustomFieldDate    CustomFieldType = "date" // YYYY-MM-DD
+	CustomFieldEnum    CustomFieldType = "enum"
+)
+
+// IsValid reports whether the type is one of the known custom field types
+func (t CustomFieldType) IsValid() bool {
+	switch t {
+	case CustomFieldString, CustomFieldNumber, CustomFieldInteger, CustomFieldBoolean, CustomFieldDate, CustomFieldEnum:
+		return true
+	}
+	return false
+}
+
+// CustomFieldDefinition describes one structured field of a case type.
+// It is stored as JSON, so the field names are part of the stored format.
+type CustomFieldDefinition struct {
+	Key       string          `json:"key"`
+	Label     string          `json:"label"`
+	Type      CustomFieldType `json:"type"`
+	Required  bool            `json:"required,omitempty"`
+	Options   []string        `json:"options,omitempty"`   // allowed values of an enum field
+	Min       *float64        `json:"min,omitempty"`       // lower bound of a number or integer field
+	Max       *float64        `json:"max,omitempty"`       // upper bound of a number or integer field
+	MaxLength int             `json:"maxLength,omitempty"` // for string fields, 0 means no limit
+	Pattern   string          `json:"pattern,omitempty"`   // regular expression a string field must match
+}
+
+// CustomFieldDefinitions is the ordered list of fields of a schema, stored as JSONB
+type CustomFieldDefinitions []CustomFieldDefinition
+
+func (d CustomFieldDefinitions) Value() (driver.Value, error) {
+	if d == nil {
+		return "[]", nil
+	}
+	data, err := json.Marshal(d)
+	return string(data), err
+}
+
+func (d *CustomFieldDefinitions) Scan(value interface{}) error {
+	return scanJSON(value, d)
+}
+
+// CustomFieldSchema defines the custom fields of cases of one type
+type CustomFieldSchema struct {
+	gorm.Model
+	CaseType string                 `gorm:"uniqueIndex;not null"`
+	Fields   CustomFieldDefinitions `gorm:"type:jsonb;not null;default:'[]'"`
+}
+
+// Field returns the definition with the given key
+func (s *CustomFieldSchema) Field(key string) (CustomFieldDefinition, bool) {
+	for _, field := range s.Fields {
+		if field.Key == key {
+			return field, true
+		}
+	}
+	return CustomFieldDefinition{}, false
+}
+
+// CustomFields holds the custom field values of a case, keyed by field key, stored as JSONB
+type CustomFields map[string]interface{}
+
+func (f CustomFields) Value() (driver.Value, error) {
+	if f == nil {
+		return "{}", nil
+	}
+	data, err := json.Marshal(f)
+	return string(data), err
+}
+
+func (f *CustomFields) Scan(value interface{}) error {
+	return scanJSON(value, f)
+}
+
+func scanJSON(value interface{}, dest interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []byte:
+		return json.Unmarshal(v, dest)
+	case string:
+		return json.Unmarshal([]byte(v), dest)
+	default:
+		return fmt.Errorf("cannot scan %T into JSON", value)
+	}
+}
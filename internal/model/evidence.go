@@ -1,6 +1,8 @@
 package model
 
 import (
+	"time"
+
 	"gorm.io/gorm"
 )
 
@@ -13,13 +15,15 @@ const (
 
 type Evidence struct {
 	gorm.Model
-	CaseID    uint         `gorm:"not null"`
-	Case      Case         `gorm:"foreignKey:CaseID"`
-	Type      EvidenceType `gorm:"not null"`
-	Content   string       `gorm:"type:text"`         // For text evidence
-	ImagePath string       `gorm:"type:varchar(255)"` // For image evidence
-	Remarks   string       `gorm:"type:text"`
-	AddedByID uint         `gorm:"not null"`
-	AddedBy   User         `gorm:"foreignKey:AddedByID"`
-	IsDeleted bool         `gorm:"default:false"` // For soft delete
+	CaseID     uint         `gorm:"not null"`
+	Case       Case         `gorm:"foreignKey:CaseID"`
+	Type       EvidenceType `gorm:"not null"`
+	Content    string       `gorm:"type:text"`         // For text evidence
+	ImagePath  string       `gorm:"type:varchar(255)"` // For image evidence
+	Remarks    string       `gorm:"type:text"`
+	AddedByID  uint         `gorm:"not null"`
+	AddedBy    User         `gorm:"foreignKey:AddedByID"`
+	IsDeleted  bool         `gorm:"default:false"`      // For soft delete
+	Version    int          `gorm:"not null;default:1"` // Incremented on every update, used for optimistic locking
+	ArchivedAt *time.Time   // Set while the case is closed, archived evidence is read-only
 }
@@ -0,0 +1,34 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type HandoverStatus string
+
+const (
+	HandoverPending   HandoverStatus = "pending"
+	HandoverAccepted  HandoverStatus = "accepted"
+	HandoverDeclined  HandoverStatus = "declined"
+	HandoverCancelled HandoverStatus = "cancelled"
+)
+
+// CaseHandover transfers one user's assignment on a case to another investigator.
+// The outgoing user keeps the assignment until the incoming user accepts.
+type CaseHandover struct {
+	gorm.Model
+	CaseID        uint           `gorm:"not null;index"`
+	Case          Case           `gorm:"foreignKey:CaseID"`
+	FromUserID    uint           `gorm:"not null;index"`
+	FromUser      User           `gorm:"foreignKey:FromUserID"`
+	ToUserID      uint           `gorm:"not null;index"`
+	ToUser        User           `gorm:"foreignKey:ToUserID"`
+	Role          AssignmentRole `gorm:"not null"` // Role of the outgoing user, taken over on acceptance
+	Note          string         `gorm:"type:text;not null"`
+	Status        HandoverStatus `gorm:"not null;default:'pending';index"`
+	RequestedByID uint           `gorm:"not null"` // The outgoing user, or an admin acting for them
+	Response      string         `gorm:"type:text"`
+	DecidedAt     *time.Time
+}
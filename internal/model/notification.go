@@ -0,0 +1,90 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type NotificationType string
+
+const (
+	NotificationMention       NotificationType = "mention"
+	NotificationSLAEscalation NotificationType = "sla_escalation"
+	// Case events delivered to the assignees and watchers of a case
+	NotificationStatusChanged NotificationType = "status_changed"
+	NotificationEvidenceAdded NotificationType = "evidence_added"
+	NotificationCommentAdded  NotificationType = "comment_added"
+	NotificationAssigneeAdded NotificationType = "assignee_added"
+	// Sent to a case lead when assignees lose or regain clearance for the case
+	NotificationAssigneeIneligible NotificationType = "assignee_ineligible"
+	// Case handovers, sent to the incoming investigator and then to the other parties
+	NotificationHandoverRequested NotificationType = "handover_requested"
+	NotificationHandoverDecided   NotificationType = "handover_decided"
+	// New cases matching a watched saved view
+	NotificationViewMatch NotificationType = "view_match"
+	// Closures waiting for a supervisor's approval, and the decision sent back to the requester
+	NotificationClosureRequested NotificationType = "closure_requested"
+	NotificationClosureDecided   NotificationType = "closure_decided"
+	// Reopen requests waiting for a supervisor's approval, and the decision sent back to the requester
+	NotificationReopenRequested NotificationType = "reopen_requested"
+	NotificationReopenDecided   NotificationType = "reopen_decided"
+)
+
+// NotificationTypes lists the notification types users can set preferences for
+var NotificationTypes = []NotificationType{
+	NotificationMention,
+	NotificationSLAEscalation,
+	NotificationStatusChanged,
+	NotificationEvidenceAdded,
+	NotificationCommentAdded,
+	NotificationAssigneeAdded,
+	NotificationAssigneeIneligible,
+	NotificationHandoverRequested,
+	NotificationHandoverDecided,
+	NotificationViewMatch,
+	NotificationClosureRequested,
+	NotificationClosureDecided,
+	NotificationReopenRequested,
+	NotificationReopenDecided,
+}
+
+// IsValid reports whether the type is one of the known notification types
+func (t NotificationType) IsValid() bool {
+	for _, known := range NotificationTypes {
+		if t == known {
+			return true
+		}
+	}
+	return false
+}
+
+// Notification is an entry in a user's in-app inbox
+type Notification struct {
+	gorm.Model
+	UserID  uint             `gorm:"not null;index"`
+	Type    NotificationType `gorm:"not null"`
+	Title   string           `gorm:"not null"`
+	Message string           `gorm:"type:text"`
+	CaseID  *uint
+	ActorID *uint
+	ReadAt  *time.Time
+}
+
+// CaseWatcher follows the activity of a case without being assigned to it
+type CaseWatcher struct {
+	CaseID    uint `gorm:"primaryKey"`
+	UserID    uint `gorm:"primaryKey;index"`
+	User      User `gorm:"foreignKey:UserID"`
+	CreatedAt time.Time
+}
+
+// NotificationPreference sets how a user receives one type of notification.
+// Types without a preference are delivered to the in-app inbox only.
+type NotificationPreference struct {
+	gorm.Model
+	UserID uint             `gorm:"not null;uniqueIndex:idx_notification_preference"`
+	Type   NotificationType `gorm:"not null;uniqueIndex:idx_notification_preference"`
+	InApp  bool             `gorm:"not null"`
+	Email  bool             `gorm:"not null"`
+}
@@ -4,6 +4,15 @@ import (
 	"gorm.io/gorm"
 )
 
+// PersonType is the role a person plays in a case
+type PersonType string
+
+const (
+	PersonSuspect PersonType = "suspect"
+	PersonVictim  PersonType = "victim"
+	PersonWitness PersonType = "witness"
+)
+
 // Base struct for suspect, victim, and witness
 type Person struct {
 	gorm.Model
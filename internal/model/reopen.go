@@ -0,0 +1,34 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type ReopenStatus string
+
+const (
+	ReopenPending  ReopenStatus = "pending"
+	ReopenApproved ReopenStatus = "approved"
+	ReopenRejected ReopenStatus = "rejected"
+)
+
+// CaseReopen is a request to move a closed case back to ongoing. Cases closed longer
+// than the configured period wait for a supervisor's approval.
+type CaseReopen struct {
+	gorm.Model
+	CaseID              uint            `gorm:"not null;index"`
+	Case                Case            `gorm:"foreignKey:CaseID"`
+	Reason              string          `gorm:"type:text;not null"`
+	PreviousDisposition CaseDisposition `gorm:"size:32"` // the outcome the case was closed with
+	ClosedAt            *time.Time      // when the case was closed
+	Status              ReopenStatus    `gorm:"not null;default:'pending';index"`
+	RequestedByID       uint            `gorm:"not null"`
+	RequestedBy         User            `gorm:"foreignKey:RequestedByID"`
+	DecidedByID         *uint
+	DecidedBy           *User  `gorm:"foreignKey:DecidedByID"`
+	Response            string `gorm:"type:text"`
+	DecidedAt           *time.Time
+	RestoredEvidence    int64 // evidence items taken out of the archive
+}
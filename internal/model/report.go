@@ -9,6 +9,9 @@ type Report struct {
 	Title        string `gorm:"not null"`
 	Description  string `gorm:"type:text;not null"`
 	Location     string `gorm:"not null"`
+	Address      string `gorm:"size:255"`
+	Latitude     *float64
+	Longitude    *float64
 	CivilID      string `gorm:"not null"`
 	Email        string `gorm:"not null"`
 	Name         string `gorm:"not null"`
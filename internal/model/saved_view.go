@@ -0,0 +1,22 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// SavedView is a named case list query. It is personal unless shared with the users
+// of a home area, and can be watched to be notified of new matching cases.
+type SavedView struct {
+	gorm.Model
+	OwnerID       uint   `gorm:"not null;uniqueIndex:idx_saved_view_name"`
+	Owner         User   `gorm:"foreignKey:OwnerID"`
+	Name          string `gorm:"not null;size:100;uniqueIndex:idx_saved_view_name"`
+	Description   string
+	Query         string `gorm:"type:text;not null;default:''"` // case list query string, e.g. status=ongoing&priority=high&sort=-createdAt
+	Columns       string // comma-separated case columns to show, all when empty
+	SharedArea    string `gorm:"index"` // shared with the users of this home area, personal when empty
+	Watch         bool   // notify the owner of new cases matching the query
+	LastCheckedAt *time.Time
+}
@@ -0,0 +1,35 @@
+package model
+
+import (
+	"gorm.io/gorm"
+)
+
+type SLAStatus string
+
+const (
+	SLAStatusOnTrack  SLAStatus = "on_track"
+	SLAStatusAtRisk   SLAStatus = "at_risk"
+	SLAStatusBreached SLAStatus = "breached"
+	SLAStatusMet      SLAStatus = "met"
+)
+
+// IsValid reports whether the status is one of the known SLA statuses
+func (s SLAStatus) IsValid() bool {
+	switch s {
+	case SLAStatusOnTrack, SLAStatusAtRisk, SLAStatusBreached, SLAStatusMet:
+		return true
+	}
+	return false
+}
+
+// SLAPolicy sets the response targets for cases of a type and priority.
+// A policy with an empty CaseType applies to every case type without its own policy.
+// A target of zero minutes means no target.
+type SLAPolicy struct {
+	gorm.Model
+	CaseType               string       `gorm:"uniqueIndex:idx_sla_policy"`
+	Priority               CasePriority `gorm:"not null;uniqueIndex:idx_sla_policy"`
+	FirstAssignmentMinutes int          `gorm:"not null;default:0"`
+	FirstUpdateMinutes     int          `gorm:"not null;default:0"`
+	ClosureMinutes         int          `gorm:"not null;default:0"`
+}
@@ -0,0 +1,35 @@
+package model
+
+import (
+	"gorm.io/gorm"
+)
+
+type TaggableType string
+
+const (
+	TaggableCase     TaggableType = "case"
+	TaggableEvidence TaggableType = "evidence"
+	TaggableSuspect  TaggableType = "suspect"
+	TaggableVictim   TaggableType = "victim"
+	TaggableWitness  TaggableType = "witness"
+)
+
+// Tag is an admin-managed label. Users below MinClearance can neither see nor apply it.
+type Tag struct {
+	gorm.Model
+	Name         string `gorm:"uniqueIndex;not null;size:64"`
+	Color        string `gorm:"size:7"` // hex colour, e.g. #ff8800
+	Description  string
+	MinClearance ClearanceLevel `gorm:"not null;default:'low'"`
+}
+
+// Tagging attaches a tag to a case, evidence item or person
+type Tagging struct {
+	gorm.Model
+	TagID       uint         `gorm:"not null;uniqueIndex:idx_tagging"`
+	Tag         Tag          `gorm:"foreignKey:TagID"`
+	EntityType  TaggableType `gorm:"not null;uniqueIndex:idx_tagging;index:idx_tagging_entity"`
+	EntityID    uint         `gorm:"not null;uniqueIndex:idx_tagging;index:idx_tagging_entity"`
+	CreatedByID uint         `gorm:"not null"`
+	CreatedBy   User         `gorm:"foreignKey:CreatedByID"`
+}
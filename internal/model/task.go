@@ -0,0 +1,52 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type TaskStatus string
+
+const (
+	TaskStatusOpen       TaskStatus = "open"
+	TaskStatusInProgress TaskStatus = "in_progress"
+	TaskStatusDone       TaskStatus = "done"
+	TaskStatusCancelled  TaskStatus = "cancelled"
+)
+
+type TaskPriority string
+
+const (
+	TaskPriorityLow    TaskPriority = "low"
+	TaskPriorityMedium TaskPriority = "medium"
+	TaskPriorityHigh   TaskPriority = "high"
+	TaskPriorityUrgent TaskPriority = "urgent"
+)
+
+// Task is an action item on a case
+type Task struct {
+	gorm.Model
+	CaseID      uint   `gorm:"not null;index"`
+	Case        Case   `gorm:"foreignKey:CaseID"`
+	Title       string `gorm:"not null"`
+	Description string `gorm:"type:text"`
+	AssigneeID  *uint  `gorm:"index"`
+	Assignee    *User  `gorm:"foreignKey:AssigneeID"`
+	DueDate     *time.Time
+	Priority    TaskPriority `gorm:"not null;default:'medium'"`
+	Status      TaskStatus   `gorm:"not null;default:'open'"`
+	CompletedAt *time.Time
+	CreatedByID uint `gorm:"not null"`
+	CreatedBy   User `gorm:"foreignKey:CreatedByID"`
+}
+
+// IsFinished reports whether the task no longer needs any work
+func (t *Task) IsFinished() bool {
+	return t.Status == TaskStatusDone || t.Status == TaskStatusCancelled
+}
+
+// IsOverdue reports whether the task is past its due date and still unfinished
+func (t *Task) IsOverdue(now time.Time) bool {
+	return t.DueDate != nil && t.DueDate.Before(now) && !t.IsFinished()
+}
@@ -33,6 +33,8 @@ type User struct {
 	Role           Role           `gorm:"not null;default:'citizen'"`
 	ClearanceLevel ClearanceLevel `gorm:"default:'low'"`
 	IsActive       bool           `gorm:"default:true"`
+	IsSupervisor   bool           `gorm:"default:false"` // Receives escalations for cases in breach of SLA
+	Area           string         // Home area, used to match public reports and workload
 	LastLogin      *time.Time
 }
 
@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"gorm.io/gorm"
+)
+
+// AnalyticsDimensions maps the dimensions cases can be grouped by to their columns
+var AnalyticsDimensions = map[string]string{
+	"area":        "area",
+	"caseType":    "case_type",
+	"status":      "status",
+	"priority":    "priority",
+	"disposition": "disposition",
+}
+
+// AnalyticsFilter selects the cases that take part in an aggregate.
+// Cases are counted by creation time, within [From, To).
+type AnalyticsFilter struct {
+	From          time.Time
+	To            time.Time
+	Area          string
+	CaseType      string
+	Status        model.CaseStatus
+	Disposition   model.CaseDisposition
+	AllowedLevels []model.ClearanceLevel
+}
+
+type CountByKey struct {
+	Key   string
+	Count int64
+}
+
+type CountByBucket struct {
+	Bucket time.Time
+	Count  int64
+}
+
+type Hotspot struct {
+	Area      string
+	Count     int64
+	Latitude  *float64 // centroid of the geolocated cases in the area
+	Longitude *float64
+}
+
+// EnsureAnalyticsIndexes creates the indexes used by the aggregate queries
+func EnsureAnalyticsIndexes(db *gorm.DB) error {
+	return db.Exec("CREATE INDEX IF NOT EXISTS idx_cases_analytics ON cases (created_at, authorization_level) WHERE deleted_at IS NULL AND merged_into_id IS NULL").Error
+}
+
+type AnalyticsRepository struct {
+	db *gorm.DB
+}
+
+func NewAnalyticsRepository(db *gorm.DB) *AnalyticsRepository {
+	return &AnalyticsRepository{db: db}
+}
+
+func (r *AnalyticsRepository) scope(filter AnalyticsFilter) *gorm.DB {
+	query := r.db.Model(&model.Case{}).
+		Where("merged_into_id IS NULL").
+		Where("authorization_level IN ?", filter.AllowedLevels).
+		Where("created_at >= ? AND created_at < ?", filter.From, filter.To)
+
+	if filter.Area != "" {
+		query = query.Where("area = ?", filter.Area)
+	}
+	if filter.CaseType != "" {
+		query = query.Where("case_type = ?", filter.CaseType)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.Disposition != "" {
+		query = query.Where("disposition = ?", filter.Disposition)
+	}
+	return query
+}
+
+// CountBy counts cases grouped by the given column, largest groups first.
+// The column must come from AnalyticsDimensions.
+func (r *AnalyticsRepository) CountBy(filter AnalyticsFilter, column string) ([]CountByKey, error) {
+	var counts []CountByKey
+	err := r.scope(filter).
+		Select(column + " AS key, COUNT(*) AS count").
+		Group(column).
+		Order("count DESC, key").
+		Scan(&counts).Error
+	return counts, err
+}
+
+// CountByTimeBucket counts cases per day, week or month
+func (r *AnalyticsRepository) CountByTimeBucket(filter AnalyticsFilter, bucket string) ([]CountByBucket, error) {
+	var counts []CountByBucket
+	err := r.scope(filter).
+		Select("date_trunc(?, created_at) AS bucket, COUNT(*) AS count", bucket).
+		Group("bucket").
+		Order("bucket").
+		Scan(&counts).Error
+	return counts, err
+}
+
+// Hotspots returns the areas with the most cases
+func (r *AnalyticsRepository) Hotspots(filter AnalyticsFilter, limit int) ([]Hotspot, error) {
+	var hotspots []Hotspot
+	err := r.scope(filter).
+		Select("area, COUNT(*) AS count, AVG(latitude) AS latitude, AVG(longitude) AS longitude").
+		Group("area").
+		Order("count DESC, area").
+		Limit(limit).
+		Scan(&hotspots).Error
+	return hotspots, err
+}
@@ -0,0 +1,174 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// EnsureAssignmentIndexes creates the partial unique index that allows one lead per case
+func EnsureAssignmentIndexes(db *gorm.DB) error {
+	return db.Exec("CREATE UNIQUE INDEX IF NOT EXISTS idx_case_assignees_lead ON case_assignees (case_id) WHERE role = 'lead'").Error
+}
+
+// Assignment is a case assignee together with the details of the assignment
+type Assignment struct {
+	model.User
+	Role            model.AssignmentRole
+	AssignedAt      time.Time
+	AssignedByID    *uint
+	IneligibleSince *time.Time
+}
+
+// UserAssignment is one case a user is assigned to, with the case's authorization level
+type UserAssignment struct {
+	CaseID             uint
+	ReferenceNumber    string
+	AuthorizationLevel model.ClearanceLevel
+	Role               model.AssignmentRole
+	IneligibleSince    *time.Time
+}
+
+// WorkloadFilter narrows down the investigators listed in a workload report
+type WorkloadFilter struct {
+	Area string
+}
+
+// Workload is the open case load of one investigator
+type Workload struct {
+	UserID         uint
+	Username       string
+	FullName       string
+	Area           string
+	ClearanceLevel model.ClearanceLevel
+	OpenCases      int64
+	LeadCases      int64
+	LastAssignedAt *time.Time
+}
+
+// ListAssignments returns the assignees of a case with their roles, lead first
+func (r *CaseRepository) ListAssignments(caseID uint) ([]Assignment, error) {
+	var assignments []Assignment
+	err := r.db.Model(&model.User{}).
+		Select("users.*, ca.role, ca.assigned_at, ca.assigned_by_id, ca.ineligible_since").
+		Joins("JOIN case_assignees ca ON ca.user_id = users.id").
+		Where("ca.case_id = ?", caseID).
+		Order("CASE ca.role WHEN 'lead' THEN 0 WHEN 'support' THEN 1 ELSE 2 END, ca.assigned_at").
+		Scan(&assignments).Error
+
+	for i := range assignments {
+		assignments[i].Password = ""
+	}
+	return assignments, err
+}
+
+// GetAssignment returns the assignment of a user to a case, or gorm.ErrRecordNotFound
+func (r *CaseRepository) GetAssignment(caseID, userID uint) (*model.CaseAssignee, error) {
+	var assignment model.CaseAssignee
+	if err := r.db.Where("case_id = ? AND user_id = ?", caseID, userID).First(&assignment).Error; err != nil {
+		return nil, err
+	}
+	return &assignment, nil
+}
+
+// GetLead returns the lead assignment of a case, or gorm.ErrRecordNotFound if it has none
+func (r *CaseRepository) GetLead(caseID uint) (*model.CaseAssignee, error) {
+	var assignment model.CaseAssignee
+	if err := r.db.Where("case_id = ? AND role = ?", caseID, model.AssignmentLead).First(&assignment).Error; err != nil {
+		return nil, err
+	}
+	return &assignment, nil
+}
+
+// AddAssignee assigns a user to a case in the given role. Assigning a user twice is not an error.
+func (r *CaseRepository) AddAssignee(caseID, userID uint, role model.AssignmentRole, assignedByID *uint) error {
+	return r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.CaseAssignee{
+		CaseID:       caseID,
+		UserID:       userID,
+		Role:         role,
+		AssignedAt:   time.Now(),
+		AssignedByID: assignedByID,
+	}).Error
+}
+
+func (r *CaseRepository) UpdateAssigneeRole(caseID, userID uint, role model.AssignmentRole) error {
+	return r.db.Model(&model.CaseAssignee{}).Where("case_id = ? AND user_id = ?", caseID, userID).
+		Update("role", role).Error
+}
+
+// ListUserAssignments returns the cases a user is assigned to
+func (r *CaseRepository) ListUserAssignments(userID uint) ([]UserAssignment, error) {
+	var assignments []UserAssignment
+	err := r.db.Table("case_assignees ca").
+		Select("c.id AS case_id, c.reference_number, c.authorization_level, ca.role, ca.ineligible_since").
+		Joins("JOIN cases c ON c.id = ca.case_id AND c.deleted_at IS NULL").
+		Where("ca.user_id = ?", userID).
+		Order("c.id").
+		Scan(&assignments).Error
+	return assignments, err
+}
+
+// AssignmentChange is one assignment changed by a reconciliation: the assignee is removed,
+// or flagged as ineligible since IneligibleSince, which clears the flag when nil
+type AssignmentChange struct {
+	CaseID          uint
+	UserID          uint
+	Remove          bool
+	IneligibleSince *time.Time
+	AuditLog        *model.AuditLog
+}
+
+// ApplyAssignmentChanges applies the changes and writes their audit logs in one transaction.
+// A non-nil user is saved in the same transaction, so that a clearance change and the
+// reconciliation it causes succeed or fail together.
+func (r *CaseRepository) ApplyAssignmentChanges(user *model.User, changes []AssignmentChange) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if user != nil {
+			if err := tx.Save(user).Error; err != nil {
+				return err
+			}
+		}
+		return applyAssignmentChanges(tx, changes)
+	})
+}
+
+func applyAssignmentChanges(tx *gorm.DB, changes []AssignmentChange) error {
+	for _, change := range changes {
+		query := tx.Where("case_id = ? AND user_id = ?", change.CaseID, change.UserID)
+		var err error
+		if change.Remove {
+			err = query.Delete(&model.CaseAssignee{}).Error
+		} else {
+			err = query.Model(&model.CaseAssignee{}).Update("ineligible_since", change.IneligibleSince).Error
+		}
+		if err != nil {
+			return err
+		}
+		if err := tx.Create(change.AuditLog).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListWorkload counts the open cases of every active investigator, least loaded first
+func (r *CaseRepository) ListWorkload(filter WorkloadFilter) ([]Workload, error) {
+	query := r.db.Table("users u").
+		Select(`u.id AS user_id, u.username, u.full_name, u.area, u.clearance_level,
+			COUNT(c.id) AS open_cases,
+			COUNT(c.id) FILTER (WHERE ca.role = ?) AS lead_cases,
+			MAX(ca.assigned_at) AS last_assigned_at`, model.AssignmentLead).
+		Joins("LEFT JOIN case_assignees ca ON ca.user_id = u.id").
+		Joins("LEFT JOIN cases c ON c.id = ca.case_id AND c.deleted_at IS NULL AND c.merged_into_id IS NULL AND c.status <> ?", model.StatusClosed).
+		Where("u.deleted_at IS NULL AND u.is_active = true AND u.role = ?", model.RoleInvestigator)
+
+	if filter.Area != "" {
+		query = query.Where("lower(u.area) = lower(?)", filter.Area)
+	}
+
+	var workload []Workload
+	err := query.Group("u.id").Order("open_cases, u.id").Scan(&workload).Error
+	return workload, err
+}
@@ -0,0 +1,183 @@
+package repository
+
+import (
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CaseExport is everything a case bundle carries about a case
+type CaseExport struct {
+	Case      model.Case
+	Suspects  []model.Suspect
+	Victims   []model.Victim
+	Witnesses []model.Witness
+	Evidence  []model.Evidence
+	Comments  []model.Comment
+	AuditLogs []model.AuditLog
+}
+
+// CaseImport is a case read from a bundle. The IDs of people, evidence and comments, the
+// parent IDs of comments and the entity IDs of audit logs still refer to the exporting
+// system and are remapped as the records are created.
+type CaseImport struct {
+	Case      *model.Case
+	Suspects  []model.Suspect
+	Victims   []model.Victim
+	Witnesses []model.Witness
+	Evidence  []model.Evidence
+	Comments  []model.Comment
+	AuditLogs []*model.AuditLog
+}
+
+type BundleRepository struct {
+	db *gorm.DB
+}
+
+func NewBundleRepository(db *gorm.DB) *BundleRepository {
+	return &BundleRepository{db: db}
+}
+
+// Load reads a case with its people, current evidence, comments and history
+func (r *BundleRepository) Load(caseID uint) (*CaseExport, error) {
+	export := &CaseExport{}
+	if err := r.db.Preload("CreatedBy").First(&export.Case, caseID).Error; err != nil {
+		return nil, err
+	}
+	byCase := func(query *gorm.DB) *gorm.DB {
+		return query.Where("case_id = ?", caseID).Order("created_at, id")
+	}
+	if err := byCase(r.db.Preload("AddedBy")).Find(&export.Suspects).Error; err != nil {
+		return nil, err
+	}
+	if err := byCase(r.db.Preload("AddedBy")).Find(&export.Victims).Error; err != nil {
+		return nil, err
+	}
+	if err := byCase(r.db.Preload("AddedBy")).Find(&export.Witnesses).Error; err != nil {
+		return nil, err
+	}
+	if err := byCase(r.db.Preload("AddedBy").Where("is_deleted = ?", false)).Find(&export.Evidence).Error; err != nil {
+		return nil, err
+	}
+	if err := byCase(r.db.Preload("User")).Find(&export.Comments).Error; err != nil {
+		return nil, err
+	}
+	if err := byCase(r.db.Preload("User")).Find(&export.AuditLogs).Error; err != nil {
+		return nil, err
+	}
+	return export, nil
+}
+
+// Import creates a case from a bundle in one transaction. store runs once the case has
+// its ID and before the evidence is created, to put the evidence images in place.
+func (r *BundleRepository) Import(data *CaseImport, store func(caseID uint) error) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Create(data.Case).Error; err != nil {
+			return err
+		}
+		caseID := data.Case.ID
+		if err := store(caseID); err != nil {
+			return err
+		}
+
+		// IDs in the exporting system by entity type, mapped to the new IDs
+		ids := map[string]map[uint]uint{"suspect": {}, "victim": {}, "witness": {}, "evidence": {}, "comment": {}}
+
+		for i := range data.Suspects {
+			oldID := data.Suspects[i].ID
+			data.Suspects[i].ID = 0
+			data.Suspects[i].CaseID = caseID
+			if err := tx.Omit(clause.Associations).Create(&data.Suspects[i]).Error; err != nil {
+				return err
+			}
+			ids["suspect"][oldID] = data.Suspects[i].ID
+		}
+		for i := range data.Victims {
+			oldID := data.Victims[i].ID
+			data.Victims[i].ID = 0
+			data.Victims[i].CaseID = caseID
+			if err := tx.Omit(clause.Associations).Create(&data.Victims[i]).Error; err != nil {
+				return err
+			}
+			ids["victim"][oldID] = data.Victims[i].ID
+		}
+		for i := range data.Witnesses {
+			oldID := data.Witnesses[i].ID
+			data.Witnesses[i].ID = 0
+			data.Witnesses[i].CaseID = caseID
+			if err := tx.Omit(clause.Associations).Create(&data.Witnesses[i]).Error; err != nil {
+				return err
+			}
+			ids["witness"][oldID] = data.Witnesses[i].ID
+		}
+		for i := range data.Evidence {
+			oldID := data.Evidence[i].ID
+			data.Evidence[i].ID = 0
+			data.Evidence[i].CaseID = caseID
+			if err := tx.Omit(clause.Associations).Create(&data.Evidence[i]).Error; err != nil {
+				return err
+			}
+			ids["evidence"][oldID] = data.Evidence[i].ID
+		}
+
+		// Parents are created before their replies, however deep the thread, so that
+		// replies can point at them. Replies whose parent is not in the bundle become
+		// top-level comments.
+		inBundle := map[uint]bool{}
+		for _, comment := range data.Comments {
+			inBundle[comment.ID] = true
+		}
+		remaining := data.Comments
+		for len(remaining) > 0 {
+			var waiting []model.Comment
+			for i := range remaining {
+				comment := &remaining[i]
+				if comment.ParentID != nil && inBundle[*comment.ParentID] {
+					if _, ok := ids["comment"][*comment.ParentID]; !ok {
+						waiting = append(waiting, *comment)
+						continue
+					}
+				}
+				if err := createImportedComment(tx, comment, caseID, ids["comment"]); err != nil {
+					return err
+				}
+			}
+			if len(waiting) == len(remaining) {
+				// The rest wait on a cycle of replies; break it by making one of them top-level
+				waiting[0].ParentID = nil
+			}
+			remaining = waiting
+		}
+
+		for _, auditLog := range data.AuditLogs {
+			auditLog.CaseID = &caseID
+			if auditLog.EntityType == "case" {
+				auditLog.EntityID = caseID
+			} else if newIDs, ok := ids[auditLog.EntityType]; ok {
+				// Entities that did not travel with the bundle have no counterpart here
+				auditLog.EntityID = newIDs[auditLog.EntityID]
+			}
+		}
+		return createAuditLogs(tx, data.AuditLogs)
+	})
+}
+
+// createImportedComment creates a comment read from a bundle, pointing it at the new ID of
+// its parent, and records its new ID
+func createImportedComment(tx *gorm.DB, comment *model.Comment, caseID uint, commentIDs map[uint]uint) error {
+	oldID := comment.ID
+	comment.ID = 0
+	comment.CaseID = caseID
+	if comment.ParentID != nil {
+		if parentID, ok := commentIDs[*comment.ParentID]; ok {
+			comment.ParentID = &parentID
+		} else {
+			comment.ParentID = nil
+		}
+	}
+	if err := tx.Omit(clause.Associations).Create(comment).Error; err != nil {
+		return err
+	}
+	commentIDs[oldID] = comment.ID
+	return nil
+}
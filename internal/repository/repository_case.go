@@ -3,9 +3,13 @@ package repository
 import (
 	"errors"
 	"regexp"
+	"strconv"
+	"time"
 
+	"github.com/m7medVision/crime-management-system/internal/geo"
 	"github.com/m7medVision/crime-management-system/internal/model"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type CaseRepository struct {
@@ -20,6 +24,55 @@ func (r *CaseRepository) Create(cas *model.Case) error {
 	return r.db.Create(cas).Error
 }
 
+// CaseSetup is what is created along with a new case, such as the checklist of a template
+type CaseSetup struct {
+	Tasks       []model.Task
+	TagIDs      []uint
+	ReportID    *uint // citizen report the case is opened for
+	CreatedByID uint
+	AuditLogs   []*model.AuditLog
+}
+
+// CreateWithSetup creates a case with its tasks, tags and report link in one transaction
+func (r *CaseRepository) CreateWithSetup(cas *model.Case, setup CaseSetup) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(cas).Error; err != nil {
+			return err
+		}
+
+		for i := range setup.Tasks {
+			setup.Tasks[i].CaseID = cas.ID
+		}
+		if len(setup.Tasks) > 0 {
+			if err := tx.Omit(clause.Associations).Create(&setup.Tasks).Error; err != nil {
+				return err
+			}
+		}
+
+		if len(setup.TagIDs) > 0 {
+			taggings := make([]model.Tagging, 0, len(setup.TagIDs))
+			for _, tagID := range setup.TagIDs {
+				taggings = append(taggings, model.Tagging{TagID: tagID, EntityType: model.TaggableCase, EntityID: cas.ID, CreatedByID: setup.CreatedByID})
+			}
+			if err := tx.Create(&taggings).Error; err != nil {
+				return err
+			}
+		}
+
+		if setup.ReportID != nil {
+			if err := tx.Exec("INSERT INTO case_reports (case_id, report_id) VALUES (?, ?)", cas.ID, *setup.ReportID).Error; err != nil {
+				return err
+			}
+		}
+
+		for _, auditLog := range setup.AuditLogs {
+			caseID := cas.ID
+			auditLog.CaseID = &caseID
+		}
+		return createAuditLogs(tx, setup.AuditLogs)
+	})
+}
+
 func (r *CaseRepository) GetByID(id uint) (*model.Case, error) {
 	var cas model.Case
 	result := r.db.Where("id = ?", id).First(&cas)
@@ -29,8 +82,56 @@ func (r *CaseRepository) GetByID(id uint) (*model.Case, error) {
 	return &cas, nil
 }
 
-func (r *CaseRepository) Update(cas *model.Case) error {
-	return r.db.Save(cas).Error
+func (r *CaseRepository) GetByReferenceNumber(reference string) (*model.Case, error) {
+	var cas model.Case
+	result := r.db.Where("UPPER(reference_number) = UPPER(?)", reference).First(&cas)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &cas, nil
+}
+
+// ResolveID accepts either a numeric case ID or a case reference number and returns the case ID
+func (r *CaseRepository) ResolveID(idOrReference string) (uint, error) {
+	if id, err := strconv.ParseUint(idOrReference, 10, 64); err == nil {
+		return uint(id), nil
+	}
+
+	cas, err := r.GetByReferenceNumber(idOrReference)
+	if err != nil {
+		return 0, err
+	}
+	return cas.ID, nil
+}
+
+// NextReferenceSequence atomically increments and returns the counter for the given scope
+func (r *CaseRepository) NextReferenceSequence(scope string) (int64, error) {
+	var value int64
+	err := r.db.Raw(`INSERT INTO case_reference_counters (scope, value) VALUES (?, 1)
+		ON CONFLICT (scope) DO UPDATE SET value = case_reference_counters.value + 1
+		RETURNING value`, scope).Scan(&value).Error
+	return value, err
+}
+
+// ListWithoutReferenceNumber returns cases created before reference numbers were introduced
+func (r *CaseRepository) ListWithoutReferenceNumber() ([]model.Case, error) {
+	var cases []model.Case
+	err := r.db.Where("reference_number IS NULL OR reference_number = ''").Order("created_at").Find(&cases).Error
+	return cases, err
+}
+
+func (r *CaseRepository) SetReferenceNumber(caseID uint, reference string) error {
+	return r.db.Model(&model.Case{}).Where("id = ?", caseID).Update("reference_number", reference).Error
+}
+
+// UpdateFields updates only the given columns of a case, provided it is still at the
+// version it was read at. Returns ErrVersionConflict otherwise.
+func (r *CaseRepository) UpdateFields(cas *model.Case, fields map[string]interface{}) error {
+	if err := updateVersioned(r.db, &model.Case{}, cas.ID, cas.Version, fields); err != nil {
+		return err
+	}
+	cas.Version++
+	return nil
 }
 
 func (r *CaseRepository) Delete(id uint) error {
@@ -44,25 +145,154 @@ func (r *CaseRepository) Delete(id uint) error {
 	return nil
 }
 
-func (r *CaseRepository) List(offset, limit int, search string) ([]model.Case, int64, error) {
-	var cases []model.Case
-	var count int64
+// CaseFilter narrows down the cases returned by List
+type CaseFilter struct {
+	Search        string
+	Priority      model.CasePriority
+	SLAStatus     model.SLAStatus
+	Status        model.CaseStatus
+	Disposition   model.CaseDisposition
+	Area          string
+	CaseType      string
+	CustomFields  model.CustomFields // cases whose custom fields contain all of these values
+	BoundingBox   *geo.BoundingBox
+	Near          *geo.Point
+	RadiusKm      float64
+	AllowedLevels []model.ClearanceLevel // when set, only cases at these authorization levels
+	Tags          TagFilter
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	OrderBy       string // sort order of List, an ORDER BY expression built from an allowed sort key
+}
 
-	query := r.db.Model(&model.Case{})
+// haversineSQL is the great-circle distance in km between a case and a point given as (lat, lat, lon)
+const haversineSQL = `2 * 6371 * asin(sqrt(
+	power(sin(radians(latitude - ?) / 2), 2) +
+	cos(radians(?)) * cos(radians(latitude)) * power(sin(radians(longitude - ?) / 2), 2)))`
 
-	if search != "" {
-		query = query.Where("name LIKE ? OR description LIKE ?", "%"+search+"%", "%"+search+"%")
+func applyCaseFilter(query *gorm.DB, filter CaseFilter) *gorm.DB {
+	if filter.Search != "" {
+		search := filter.Search
+		query = query.Where("name LIKE ? OR description LIKE ? OR reference_number ILIKE ?", "%"+search+"%", "%"+search+"%", "%"+search+"%")
+	}
+	if filter.Priority != "" {
+		query = query.Where("priority = ?", filter.Priority)
 	}
+	if filter.SLAStatus != "" {
+		query = query.Where("sla_status = ?", filter.SLAStatus)
+	}
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.Disposition != "" {
+		query = query.Where("disposition = ?", filter.Disposition)
+	}
+	if filter.Area != "" {
+		query = query.Where("area = ?", filter.Area)
+	}
+	if filter.CaseType != "" {
+		query = query.Where("case_type = ?", filter.CaseType)
+	}
+	if len(filter.CustomFields) > 0 {
+		query = query.Where("custom_fields @> ?::jsonb", filter.CustomFields)
+	}
+	if filter.AllowedLevels != nil {
+		query = query.Where("authorization_level IN ?", filter.AllowedLevels)
+	}
+	query = filter.Tags.apply(query, model.TaggableCase, "cases.id")
+	if filter.CreatedAfter != nil {
+		query = query.Where("created_at > ?", *filter.CreatedAfter)
+	}
+	if filter.CreatedBefore != nil {
+		query = query.Where("created_at <= ?", *filter.CreatedBefore)
+	}
+	if box := filter.BoundingBox; box != nil {
+		query = query.Where("latitude BETWEEN ? AND ? AND longitude BETWEEN ? AND ?",
+			box.MinLatitude, box.MaxLatitude, box.MinLongitude, box.MaxLongitude)
+	}
+	if centre := filter.Near; centre != nil {
+		// The bounding box lets Postgres use the location index before the exact distance check
+		box := geo.BoundingBoxAround(*centre, filter.RadiusKm)
+		query = query.Where("latitude BETWEEN ? AND ? AND longitude BETWEEN ? AND ?",
+			box.MinLatitude, box.MaxLatitude, box.MinLongitude, box.MaxLongitude).
+			Where(haversineSQL+" <= ?", centre.Latitude, centre.Latitude, centre.Longitude, filter.RadiusKm)
+	}
+	return query
+}
+
+func (r *CaseRepository) List(offset, limit int, filter CaseFilter) ([]model.Case, int64, error) {
+	var cases []model.Case
+	var count int64
+
+	query := applyCaseFilter(r.db.Model(&model.Case{}), filter)
 
 	err := query.Count(&count).Error
 	if err != nil {
 		return nil, 0, err
 	}
 
+	if filter.OrderBy != "" {
+		query = query.Order(filter.OrderBy)
+	}
 	err = query.Preload("CreatedBy").Offset(offset).Limit(limit).Find(&cases).Error
 	return cases, count, err
 }
 
+// ListGeolocated returns cases with coordinates, excluding cases merged into another case
+func (r *CaseRepository) ListGeolocated(filter CaseFilter, limit int) ([]model.Case, error) {
+	var cases []model.Case
+	err := applyCaseFilter(r.db.Model(&model.Case{}), filter).
+		Where("latitude IS NOT NULL AND longitude IS NOT NULL AND merged_into_id IS NULL").
+		Order("created_at DESC").Limit(limit).Find(&cases).Error
+	return cases, err
+}
+
+// ListIDs returns the IDs of cases matching the filter, excluding cases merged into another case
+func (r *CaseRepository) ListIDs(filter CaseFilter, limit int) ([]uint, error) {
+	var ids []uint
+	err := applyCaseFilter(r.db.Model(&model.Case{}), filter).
+		Where("merged_into_id IS NULL").
+		Order("id").Limit(limit).Pluck("id", &ids).Error
+	return ids, err
+}
+
+// Transaction runs fn with case and tag repositories bound to one database transaction
+func (r *CaseRepository) Transaction(fn func(cases *CaseRepository, tags *TagRepository) error) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		return fn(&CaseRepository{db: tx}, &TagRepository{db: tx})
+	})
+}
+
+// MarkFirstAssigned records when a case first got an assignee
+func (r *CaseRepository) MarkFirstAssigned(caseID uint, at time.Time) error {
+	return r.db.Model(&model.Case{}).Where("id = ? AND first_assigned_at IS NULL", caseID).
+		Update("first_assigned_at", at).Error
+}
+
+// ListForSLAEvaluation returns open cases and closed cases whose final SLA outcome
+// has not been recorded yet
+func (r *CaseRepository) ListForSLAEvaluation() ([]model.Case, error) {
+	var cases []model.Case
+	err := r.db.Where("merged_into_id IS NULL").
+		Where("status <> ? OR sla_status NOT IN ?", model.StatusClosed, []model.SLAStatus{model.SLAStatusMet, model.SLAStatusBreached}).
+		Find(&cases).Error
+	return cases, err
+}
+
+// UpdateSLA stores the result of an SLA evaluation
+func (r *CaseRepository) UpdateSLA(caseID uint, status model.SLAStatus, dueAt *time.Time) error {
+	return r.db.Model(&model.Case{}).Where("id = ?", caseID).
+		Updates(map[string]interface{}{"sla_status": status, "sla_due_at": dueAt}).Error
+}
+
+// MarkEscalated records that a case was escalated. It returns false if the case
+// had already been escalated, so escalation happens only once.
+func (r *CaseRepository) MarkEscalated(caseID uint, at time.Time) (bool, error) {
+	result := r.db.Model(&model.Case{}).Where("id = ? AND escalated_at IS NULL", caseID).
+		Update("escalated_at", at)
+	return result.RowsAffected > 0, result.Error
+}
+
 func (r *CaseRepository) GetAssignees(caseID uint) ([]model.User, error) {
 	var users []model.User
 	err := r.db.Model(&model.Case{Model: gorm.Model{ID: caseID}}).Association("Assignees").Find(&users)
@@ -75,8 +305,10 @@ func (r *CaseRepository) GetAssignees(caseID uint) ([]model.User, error) {
 	return users, err
 }
 
-func (r *CaseRepository) AddAssignee(caseID, userID uint) error {
-	return r.db.Model(&model.Case{Model: gorm.Model{ID: caseID}}).Association("Assignees").Append(&model.User{Model: gorm.Model{ID: userID}})
+func (r *CaseRepository) IsAssigned(caseID, userID uint) (bool, error) {
+	var count int64
+	err := r.db.Table("case_assignees").Where("case_id = ? AND user_id = ?", caseID, userID).Count(&count).Error
+	return count > 0, err
 }
 
 func (r *CaseRepository) RemoveAssignee(caseID, userID uint) error {
@@ -87,27 +319,27 @@ func (r *CaseRepository) CreateReport(report *model.Report) error {
 	return r.db.Create(report).Error
 }
 
-func (r *CaseRepository) GetEvidence(caseID uint) ([]model.Evidence, error) {
+func (r *CaseRepository) GetEvidence(caseID uint, tags TagFilter) ([]model.Evidence, error) {
 	var evidence []model.Evidence
-	err := r.db.Where("case_id = ?", caseID).Find(&evidence).Error
+	err := tags.apply(r.db.Where("case_id = ?", caseID), model.TaggableEvidence, "id").Find(&evidence).Error
 	return evidence, err
 }
 
-func (r *CaseRepository) GetSuspects(caseID uint) ([]model.Suspect, error) {
+func (r *CaseRepository) GetSuspects(caseID uint, tags TagFilter) ([]model.Suspect, error) {
 	var suspects []model.Suspect
-	err := r.db.Where("case_id = ?", caseID).Find(&suspects).Error
+	err := tags.apply(r.db.Where("case_id = ?", caseID), model.TaggableSuspect, "id").Find(&suspects).Error
 	return suspects, err
 }
 
-func (r *CaseRepository) GetVictims(caseID uint) ([]model.Victim, error) {
+func (r *CaseRepository) GetVictims(caseID uint, tags TagFilter) ([]model.Victim, error) {
 	var victims []model.Victim
-	err := r.db.Where("case_id = ?", caseID).Find(&victims).Error
+	err := tags.apply(r.db.Where("case_id = ?", caseID), model.TaggableVictim, "id").Find(&victims).Error
 	return victims, err
 }
 
-func (r *CaseRepository) GetWitnesses(caseID uint) ([]model.Witness, error) {
+func (r *CaseRepository) GetWitnesses(caseID uint, tags TagFilter) ([]model.Witness, error) {
 	var witnesses []model.Witness
-	err := r.db.Where("case_id = ?", caseID).Find(&witnesses).Error
+	err := tags.apply(r.db.Where("case_id = ?", caseID), model.TaggableWitness, "id").Find(&witnesses).Error
 	return witnesses, err
 }
 
@@ -153,3 +385,122 @@ func (r *CaseRepository) GetStatusByReportID(reportID string) (model.CaseStatus,
 
 	return caseStatus, nil
 }
+
+func (r *CaseRepository) CreateAuditLog(auditLog *model.AuditLog) error {
+	return r.db.Create(auditLog).Error
+}
+
+// CaseMerge is what changes along with merging a case into another
+type CaseMerge struct {
+	Link            *model.CaseLink    // duplicate link between the cases, if they were not linked yet
+	Closure         *model.CaseClosure // closes the source case, if it was still open
+	AssigneeChanges []AssignmentChange // moved assignees who are not cleared for the target case
+	DecidedByID     uint               // recorded on the pending requests of the source case
+	Response        string             // why the pending requests of the source case were settled
+	AuditLogs       []*model.AuditLog
+}
+
+// Merge moves evidence, people, comments, tasks, tags, assignees, watchers and linked reports from the
+// source case into the target case and marks the source as merged, all in one transaction.
+// Pending handovers of the source are cancelled and its pending closure and reopen requests rejected.
+func (r *CaseRepository) Merge(sourceID, targetID uint, merge CaseMerge) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		for _, child := range []interface{}{&model.Evidence{}, &model.Suspect{}, &model.Victim{}, &model.Witness{}, &model.Comment{}, &model.Task{}} {
+			if err := tx.Model(child).Where("case_id = ?", sourceID).Update("case_id", targetID).Error; err != nil {
+				return err
+			}
+		}
+
+		// Assignees keep their role, except that the source lead supports the target's lead
+		err := tx.Exec(`INSERT INTO case_assignees (case_id, user_id, role, assigned_at, assigned_by_id, ineligible_since)
+			SELECT ?, user_id,
+				CASE WHEN role = ? AND EXISTS (SELECT 1 FROM case_assignees WHERE case_id = ? AND role = ?) THEN ? ELSE role END,
+				assigned_at, assigned_by_id, ineligible_since
+			FROM case_assignees WHERE case_id = ? ON CONFLICT DO NOTHING`,
+			targetID, model.AssignmentLead, targetID, model.AssignmentLead, model.AssignmentSupport, sourceID).Error
+		if err != nil {
+			return err
+		}
+		if err := applyAssignmentChanges(tx, merge.AssigneeChanges); err != nil {
+			return err
+		}
+
+		err = tx.Exec("INSERT INTO case_reports (case_id, report_id) SELECT ?, report_id FROM case_reports WHERE case_id = ? ON CONFLICT DO NOTHING",
+			targetID, sourceID).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Exec("INSERT INTO case_watchers (case_id, user_id, created_at) SELECT ?, user_id, created_at FROM case_watchers WHERE case_id = ? ON CONFLICT DO NOTHING",
+			targetID, sourceID).Error
+		if err != nil {
+			return err
+		}
+
+		for _, joinTable := range []string{"case_assignees", "case_reports", "case_watchers"} {
+			if err := tx.Exec("DELETE FROM "+joinTable+" WHERE case_id = ?", sourceID).Error; err != nil {
+				return err
+			}
+		}
+
+		// Tags of the source case carry over; tags both cases share are kept once
+		err = tx.Exec(`INSERT INTO taggings (tag_id, entity_type, entity_id, created_by_id, created_at, updated_at)
+			SELECT tag_id, entity_type, ?, created_by_id, created_at, ? FROM taggings
+			WHERE entity_type = ? AND entity_id = ? AND deleted_at IS NULL ON CONFLICT DO NOTHING`,
+			targetID, time.Now(), model.TaggableCase, sourceID).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Unscoped().Where("entity_type = ? AND entity_id = ?", model.TaggableCase, sourceID).Delete(&model.Tagging{}).Error
+		if err != nil {
+			return err
+		}
+
+		// Cases previously merged into the source now redirect to the target
+		if err := tx.Model(&model.Case{}).Where("merged_into_id = ?", sourceID).Update("merged_into_id", targetID).Error; err != nil {
+			return err
+		}
+
+		now := time.Now()
+		err = tx.Model(&model.CaseHandover{}).Where("case_id = ? AND status = ?", sourceID, model.HandoverPending).
+			Updates(map[string]interface{}{"status": model.HandoverCancelled, "response": merge.Response, "decided_at": now}).Error
+		if err != nil {
+			return err
+		}
+		decided := map[string]interface{}{"decided_by_id": merge.DecidedByID, "response": merge.Response, "decided_at": now}
+		decided["status"] = model.ClosureRejected
+		if err := tx.Model(&model.CaseClosure{}).Where("case_id = ? AND status = ?", sourceID, model.ClosurePending).Updates(decided).Error; err != nil {
+			return err
+		}
+		decided["status"] = model.ReopenRejected
+		if err := tx.Model(&model.CaseReopen{}).Where("case_id = ? AND status = ?", sourceID, model.ReopenPending).Updates(decided).Error; err != nil {
+			return err
+		}
+
+		fields := map[string]interface{}{
+			"merged_into_id": targetID,
+			"merged_at":      now,
+			"status":         model.StatusClosed,
+			"version":        gorm.Expr("version + 1"),
+		}
+		if merge.Closure != nil {
+			fields["closed_at"] = now
+			fields["disposition"] = merge.Closure.Disposition
+			fields["closing_summary"] = merge.Closure.Summary
+			if err := tx.Create(merge.Closure).Error; err != nil {
+				return err
+			}
+		}
+		if err := tx.Model(&model.Case{}).Where("id = ?", sourceID).Updates(fields).Error; err != nil {
+			return err
+		}
+
+		if merge.Link != nil {
+			if err := tx.Create(merge.Link).Error; err != nil {
+				return err
+			}
+		}
+
+		return createAuditLogs(tx, merge.AuditLogs)
+	})
+}
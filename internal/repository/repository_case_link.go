@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"errors"
+
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"gorm.io/gorm"
+)
+
+type CaseLinkRepository struct {
+	db *gorm.DB
+}
+
+func NewCaseLinkRepository(db *gorm.DB) *CaseLinkRepository {
+	return &CaseLinkRepository{db: db}
+}
+
+func (r *CaseLinkRepository) Create(link *model.CaseLink) error {
+	return r.db.Create(link).Error
+}
+
+func (r *CaseLinkRepository) GetByID(id uint) (*model.CaseLink, error) {
+	var link model.CaseLink
+	result := r.db.First(&link, id)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	return &link, nil
+}
+
+// Exists reports whether the two cases are already linked with the given type, in either direction
+func (r *CaseLinkRepository) Exists(sourceCaseID, targetCaseID uint, linkType model.CaseLinkType) (bool, error) {
+	var count int64
+	err := r.db.Model(&model.CaseLink{}).
+		Where("(source_case_id = ? AND target_case_id = ?) OR (source_case_id = ? AND target_case_id = ?)",
+			sourceCaseID, targetCaseID, targetCaseID, sourceCaseID).
+		Where("link_type = ?", linkType).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// Delete permanently removes a link so that the same link can be created again later
+func (r *CaseLinkRepository) Delete(id uint) error {
+	result := r.db.Unscoped().Delete(&model.CaseLink{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("link not found")
+	}
+	return nil
+}
+
+// ListByCaseID returns all links where the case is either the source or the target
+func (r *CaseLinkRepository) ListByCaseID(caseID uint) ([]model.CaseLink, error) {
+	var links []model.CaseLink
+	err := r.db.Where("source_case_id = ? OR target_case_id = ?", caseID, caseID).
+		Preload("SourceCase").Preload("TargetCase").Preload("CreatedBy").
+		Order("created_at").
+		Find(&links).Error
+
+	// Remove passwords from all CreatedBy users
+	for i := range links {
+		links[i].CreatedBy.Password = ""
+	}
+
+	return links, err
+}
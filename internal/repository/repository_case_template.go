@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"strconv"
+
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"gorm.io/gorm"
+)
+
+type CaseTemplateRepository struct {
+	db *gorm.DB
+}
+
+func NewCaseTemplateRepository(db *gorm.DB) *CaseTemplateRepository {
+	return &CaseTemplateRepository{db: db}
+}
+
+func (r *CaseTemplateRepository) Create(template *model.CaseTemplate) error {
+	return r.db.Create(template).Error
+}
+
+func (r *CaseTemplateRepository) GetByID(id uint) (*model.CaseTemplate, error) {
+	var template model.CaseTemplate
+	if err := r.db.Preload("Tags").First(&template, id).Error; err != nil {
+		return nil, err
+	}
+	return &template, nil
+}
+
+// Resolve accepts either a numeric template ID or a template name
+func (r *CaseTemplateRepository) Resolve(idOrName string) (*model.CaseTemplate, error) {
+	if id, err := strconv.ParseUint(idOrName, 10, 64); err == nil {
+		return r.GetByID(uint(id))
+	}
+	var template model.CaseTemplate
+	if err := r.db.Preload("Tags").Where("LOWER(name) = LOWER(?)", idOrName).First(&template).Error; err != nil {
+		return nil, err
+	}
+	return &template, nil
+}
+
+func (r *CaseTemplateRepository) List() ([]model.CaseTemplate, error) {
+	var templates []model.CaseTemplate
+	err := r.db.Preload("Tags").Order("name").Find(&templates).Error
+	return templates, err
+}
+
+// Update saves the template and replaces its tags
+func (r *CaseTemplateRepository) Update(template *model.CaseTemplate) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Tags").Save(template).Error; err != nil {
+			return err
+		}
+		return tx.Model(template).Association("Tags").Replace(template.Tags)
+	})
+}
+
+// Delete removes a template permanently so that its name can be used again.
+// Cases created from it are not affected.
+func (r *CaseTemplateRepository) Delete(id uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM case_template_tags WHERE case_template_id = ?", id).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Delete(&model.CaseTemplate{}, id).Error
+	})
+}
@@ -0,0 +1,187 @@
+package repository
+
+import (
+	"errors"
+
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"gorm.io/gorm"
+)
+
+// ErrClosureNotPending is returned when a closure was decided in the meantime
+var ErrClosureNotPending = errors.New("closure is no longer pending")
+
+// EnsureClosureIndexes creates the partial unique index that allows one pending closure per case
+func EnsureClosureIndexes(db *gorm.DB) error {
+	return db.Exec("CREATE UNIQUE INDEX IF NOT EXISTS idx_case_closures_pending ON case_closures (case_id) WHERE status = 'pending' AND deleted_at IS NULL").Error
+}
+
+// ClosureReadiness counts what the closure checklist of a case is checked against
+type ClosureReadiness struct {
+	Evidence         int64
+	OpenTasks        int64
+	Suspects         int64
+	ArrestedSuspects int64
+}
+
+type ClosureRepository struct {
+	db *gorm.DB
+}
+
+func NewClosureRepository(db *gorm.DB) *ClosureRepository {
+	return &ClosureRepository{db: db}
+}
+
+func (r *ClosureRepository) GetByID(id uint) (*model.CaseClosure, error) {
+	var closure model.CaseClosure
+	if err := r.preload(r.db).First(&closure, id).Error; err != nil {
+		return nil, err
+	}
+	sanitizeClosureUsers(&closure)
+	return &closure, nil
+}
+
+// GetPending returns the pending closure of a case, or gorm.ErrRecordNotFound
+func (r *ClosureRepository) GetPending(caseID uint) (*model.CaseClosure, error) {
+	var closure model.CaseClosure
+	if err := r.db.Where("case_id = ? AND status = ?", caseID, model.ClosurePending).First(&closure).Error; err != nil {
+		return nil, err
+	}
+	return &closure, nil
+}
+
+// ListByCase returns the closure history of a case, newest first
+func (r *ClosureRepository) ListByCase(caseID uint) ([]model.CaseClosure, error) {
+	return r.list(r.db.Where("case_id = ?", caseID).Order("created_at DESC"))
+}
+
+// ListPending returns the closures waiting for approval on cases at the allowed levels, oldest first
+func (r *ClosureRepository) ListPending(allowedLevels []model.ClearanceLevel) ([]model.CaseClosure, error) {
+	query := r.db.Where("status = ?", model.ClosurePending).
+		Where("case_id IN (SELECT id FROM cases WHERE authorization_level IN ? AND deleted_at IS NULL)", allowedLevels)
+	return r.list(query.Order("created_at ASC"))
+}
+
+func (r *ClosureRepository) list(query *gorm.DB) ([]model.CaseClosure, error) {
+	var closures []model.CaseClosure
+	err := r.preload(query).Find(&closures).Error
+	for i := range closures {
+		sanitizeClosureUsers(&closures[i])
+	}
+	return closures, err
+}
+
+func (r *ClosureRepository) preload(db *gorm.DB) *gorm.DB {
+	return db.Preload("Case").Preload("RequestedBy").Preload("DecidedBy")
+}
+
+func sanitizeClosureUsers(closure *model.CaseClosure) {
+	closure.RequestedBy.Password = ""
+	if closure.DecidedBy != nil {
+		closure.DecidedBy.Password = ""
+	}
+}
+
+// Readiness counts the evidence, open tasks and suspects of a case
+func (r *ClosureRepository) Readiness(caseID uint) (*ClosureReadiness, error) {
+	var readiness ClosureReadiness
+	err := r.db.Model(&model.Evidence{}).Where("case_id = ? AND is_deleted = ?", caseID, false).Count(&readiness.Evidence).Error
+	if err != nil {
+		return nil, err
+	}
+	err = r.db.Model(&model.Task{}).
+		Where("case_id = ? AND status IN ?", caseID, []model.TaskStatus{model.TaskStatusOpen, model.TaskStatusInProgress}).
+		Count(&readiness.OpenTasks).Error
+	if err != nil {
+		return nil, err
+	}
+	err = r.db.Model(&model.Suspect{}).Where("case_id = ?", caseID).Count(&readiness.Suspects).Error
+	if err != nil {
+		return nil, err
+	}
+	err = r.db.Model(&model.Suspect{}).Where("case_id = ? AND is_arrested = ?", caseID, true).Count(&readiness.ArrestedSuspects).Error
+	if err != nil {
+		return nil, err
+	}
+	return &readiness, nil
+}
+
+// Request records a closure that waits for approval
+func (r *ClosureRepository) Request(closure *model.CaseClosure, auditLog *model.AuditLog) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Case", "RequestedBy", "DecidedBy").Create(closure).Error; err != nil {
+			return err
+		}
+		auditLog.EntityID = closure.ID
+		return tx.Create(auditLog).Error
+	})
+}
+
+// Close records an approved closure, closes the case and archives its evidence in one
+// transaction. A closure without an ID is created, a pending one is approved. The case
+// must still be at the version it was read at. Returns the number of archived evidence items.
+func (r *ClosureRepository) Close(closure *model.CaseClosure, caseData *model.Case, fields map[string]interface{}, auditLogs []*model.AuditLog) (int64, error) {
+	var archived int64
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if closure.ID == 0 {
+			if err := tx.Omit("Case", "RequestedBy", "DecidedBy").Create(closure).Error; err != nil {
+				return err
+			}
+		} else if err := decideClosure(tx, closure); err != nil {
+			return err
+		}
+
+		if err := updateVersioned(tx, &model.Case{}, caseData.ID, caseData.Version, fields); err != nil {
+			return err
+		}
+
+		result := tx.Model(&model.Evidence{}).
+			Where("case_id = ? AND is_deleted = ? AND archived_at IS NULL", caseData.ID, false).
+			Updates(map[string]interface{}{"archived_at": closure.DecidedAt, "version": gorm.Expr("version + 1")})
+		if result.Error != nil {
+			return result.Error
+		}
+		archived = result.RowsAffected
+
+		for _, auditLog := range auditLogs {
+			if auditLog.EntityID == 0 {
+				auditLog.EntityID = closure.ID
+			}
+		}
+		return createAuditLogs(tx, auditLogs)
+	})
+	if err != nil {
+		return 0, err
+	}
+	caseData.Version++
+	return archived, nil
+}
+
+// Reject records that a pending closure was turned down. The case stays open.
+func (r *ClosureRepository) Reject(closure *model.CaseClosure, auditLog *model.AuditLog) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := decideClosure(tx, closure); err != nil {
+			return err
+		}
+		return tx.Create(auditLog).Error
+	})
+}
+
+// decideClosure stores the decision on a pending closure. It fails with
+// ErrClosureNotPending if the closure was decided in the meantime.
+func decideClosure(tx *gorm.DB, closure *model.CaseClosure) error {
+	result := tx.Model(&model.CaseClosure{}).
+		Where("id = ? AND status = ?", closure.ID, model.ClosurePending).
+		Updates(map[string]interface{}{
+			"status":        closure.Status,
+			"decided_by_id": closure.DecidedByID,
+			"response":      closure.Response,
+			"decided_at":    closure.DecidedAt,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrClosureNotPending
+	}
+	return nil
+}
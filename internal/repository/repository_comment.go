@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"gorm.io/gorm"
+)
+
+type CommentRepository struct {
+	db *gorm.DB
+}
+
+func NewCommentRepository(db *gorm.DB) *CommentRepository {
+	return &CommentRepository{db: db}
+}
+
+func (r *CommentRepository) Create(comment *model.Comment) error {
+	return r.db.Create(comment).Error
+}
+
+func (r *CommentRepository) GetByID(id uint) (*model.Comment, error) {
+	var comment model.Comment
+	result := r.db.Preload("User").First(&comment, id)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	comment.User.Password = ""
+	return &comment, nil
+}
+
+func (r *CommentRepository) Update(comment *model.Comment) error {
+	return r.db.Omit("Case", "User").Save(comment).Error
+}
+
+func (r *CommentRepository) SoftDelete(id uint) error {
+	return r.db.Model(&model.Comment{}).Where("id = ?", id).Update("is_deleted", true).Error
+}
+
+// ListThreadsByCaseID returns a page of top-level comments for a case, newest first
+func (r *CommentRepository) ListThreadsByCaseID(caseID uint, offset, limit int) ([]model.Comment, int64, error) {
+	var comments []model.Comment
+	var count int64
+
+	query := r.db.Model(&model.Comment{}).Where("case_id = ? AND parent_id IS NULL", caseID)
+
+	err := query.Count(&count).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	err = query.Preload("User").Order("created_at DESC").Offset(offset).Limit(limit).Find(&comments).Error
+	sanitizeCommentUsers(comments)
+	return comments, count, err
+}
+
+// ListReplies returns all replies to the given top-level comments, oldest first
+func (r *CommentRepository) ListReplies(parentIDs []uint) ([]model.Comment, error) {
+	var replies []model.Comment
+	if len(parentIDs) == 0 {
+		return replies, nil
+	}
+	err := r.db.Where("parent_id IN ?", parentIDs).Preload("User").Order("created_at").Find(&replies).Error
+	sanitizeCommentUsers(replies)
+	return replies, err
+}
+
+func (r *CommentRepository) CreateRevision(revision *model.CommentRevision) error {
+	return r.db.Create(revision).Error
+}
+
+func (r *CommentRepository) ListRevisions(commentID uint) ([]model.CommentRevision, error) {
+	var revisions []model.CommentRevision
+	err := r.db.Where("comment_id = ?", commentID).Preload("EditedBy").Order("created_at").Find(&revisions).Error
+	for i := range revisions {
+		revisions[i].EditedBy.Password = ""
+	}
+	return revisions, err
+}
+
+// sanitizeCommentUsers removes passwords from comment authors
+func sanitizeCommentUsers(comments []model.Comment) {
+	for i := range comments {
+		comments[i].User.Password = ""
+	}
+}
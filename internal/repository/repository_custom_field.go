@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type CustomFieldSchemaRepository struct {
+	db *gorm.DB
+}
+
+func NewCustomFieldSchemaRepository(db *gorm.DB) *CustomFieldSchemaRepository {
+	return &CustomFieldSchemaRepository{db: db}
+}
+
+// EnsureCustomFieldIndexes creates the GIN index used to filter cases by custom field values
+func EnsureCustomFieldIndexes(db *gorm.DB) error {
+	return db.Exec("CREATE INDEX IF NOT EXISTS idx_cases_custom_fields ON cases USING GIN (custom_fields jsonb_path_ops)").Error
+}
+
+// Upsert creates the schema for its case type, or replaces the fields of the existing one
+func (r *CustomFieldSchemaRepository) Upsert(schema *model.CustomFieldSchema) error {
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "case_type"}},
+		DoUpdates: clause.AssignmentColumns([]string{"fields", "updated_at"}),
+	}).Create(schema).Error
+}
+
+func (r *CustomFieldSchemaRepository) GetByCaseType(caseType string) (*model.CustomFieldSchema, error) {
+	var schema model.CustomFieldSchema
+	if err := r.db.Where("case_type = ?", caseType).First(&schema).Error; err != nil {
+		return nil, err
+	}
+	return &schema, nil
+}
+
+func (r *CustomFieldSchemaRepository) List() ([]model.CustomFieldSchema, error) {
+	var schemas []model.CustomFieldSchema
+	err := r.db.Order("case_type").Find(&schemas).Error
+	return schemas, err
+}
+
+// Delete removes a schema permanently so that its case type can be defined again
+func (r *CustomFieldSchemaRepository) Delete(id uint) error {
+	return r.db.Unscoped().Delete(&model.CustomFieldSchema{}, id).Error
+}
@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"gorm.io/gorm"
+)
+
+// AssignedCase is a case assigned to a user, with the time of the assignment when known
+type AssignedCase struct {
+	model.Case
+	AssignedAt *time.Time
+}
+
+type DashboardRepository struct {
+	db *gorm.DB
+}
+
+func NewDashboardRepository(db *gorm.DB) *DashboardRepository {
+	return &DashboardRepository{db: db}
+}
+
+// assignedCases selects the cases assigned to a user within the given authorization levels
+func (r *DashboardRepository) assignedCases(userID uint, allowedLevels []model.ClearanceLevel) *gorm.DB {
+	return r.db.Model(&model.Case{}).
+		Joins("JOIN case_assignees ca ON ca.case_id = cases.id").
+		Where("ca.user_id = ? AND cases.merged_into_id IS NULL", userID).
+		Where("cases.authorization_level IN ?", allowedLevels)
+}
+
+// CountAssignedCasesByStatus counts a user's assigned cases per status
+func (r *DashboardRepository) CountAssignedCasesByStatus(userID uint, allowedLevels []model.ClearanceLevel) ([]CountByKey, error) {
+	var counts []CountByKey
+	err := r.assignedCases(userID, allowedLevels).
+		Select("cases.status AS key, COUNT(*) AS count").
+		Group("cases.status").
+		Scan(&counts).Error
+	return counts, err
+}
+
+// ListNewlyAssignedCases returns cases assigned to a user since the given time, newest first.
+// Assignment times come from the case history.
+func (r *DashboardRepository) ListNewlyAssignedCases(userID uint, allowedLevels []model.ClearanceLevel, since time.Time, limit int) ([]AssignedCase, error) {
+	var cases []AssignedCase
+	err := r.assignedCases(userID, allowedLevels).
+		Select("cases.*, assigned.assigned_at").
+		Joins(`JOIN (SELECT case_id, MAX(created_at) AS assigned_at FROM audit_logs
+			WHERE entity_type = 'case_assignee' AND action = ? AND entity_id = ? AND deleted_at IS NULL
+			GROUP BY case_id) assigned ON assigned.case_id = cases.id`, model.ActionCreate, userID).
+		Where("assigned.assigned_at >= ?", since).
+		Order("assigned.assigned_at DESC").
+		Limit(limit).
+		Scan(&cases).Error
+	return cases, err
+}
+
+// ListBreachedAssignedCases returns a user's assigned open cases that are in breach of their SLA
+func (r *DashboardRepository) ListBreachedAssignedCases(userID uint, allowedLevels []model.ClearanceLevel, limit int) ([]model.Case, error) {
+	var cases []model.Case
+	err := r.assignedCases(userID, allowedLevels).
+		Where("cases.sla_status = ? AND cases.status <> ?", model.SLAStatusBreached, model.StatusClosed).
+		Order("cases.sla_due_at").
+		Limit(limit).
+		Find(&cases).Error
+	return cases, err
+}
+
+// ListRecentEvidence returns the latest evidence added to a user's assigned cases
+func (r *DashboardRepository) ListRecentEvidence(userID uint, allowedLevels []model.ClearanceLevel, limit int) ([]model.Evidence, error) {
+	var evidence []model.Evidence
+	err := r.db.Model(&model.Evidence{}).
+		Joins("JOIN cases ON cases.id = evidences.case_id AND cases.deleted_at IS NULL").
+		Joins("JOIN case_assignees ca ON ca.case_id = cases.id").
+		Where("ca.user_id = ? AND evidences.is_deleted = false", userID).
+		Where("cases.authorization_level IN ?", allowedLevels).
+		Preload("AddedBy").
+		Order("evidences.created_at DESC").
+		Limit(limit).
+		Find(&evidence).Error
+
+	for i := range evidence {
+		evidence[i].AddedBy.Password = ""
+	}
+	return evidence, err
+}
+
+// ListUntriagedReports returns pending public reports that mention an area and are not linked to a case
+func (r *DashboardRepository) ListUntriagedReports(area string, limit int) ([]model.Report, int64, error) {
+	var reports []model.Report
+	var count int64
+
+	query := r.db.Model(&model.Report{}).
+		Where("report_status = ?", model.ReportStatusPending).
+		Where("NOT EXISTS (SELECT 1 FROM case_reports cr WHERE cr.report_id = reports.id)").
+		Where("location ILIKE ? OR address ILIKE ?", "%"+area+"%", "%"+area+"%")
+
+	if err := query.Count(&count).Error; err != nil {
+		return nil, 0, err
+	}
+	err := query.Order("created_at DESC").Limit(limit).Find(&reports).Error
+	return reports, count, err
+}
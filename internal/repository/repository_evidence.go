@@ -34,12 +34,25 @@ func (r *EvidenceRepository) GetByID(id uint) (*model.Evidence, error) {
 	return &evidence, nil
 }
 
-func (r *EvidenceRepository) Update(evidence *model.Evidence) error {
-	return r.db.Save(evidence).Error
+// UpdateFields updates only the given columns of an evidence item, provided it is
+// still at the version it was read at. Returns ErrVersionConflict otherwise.
+func (r *EvidenceRepository) UpdateFields(evidence *model.Evidence, fields map[string]interface{}) error {
+	if err := updateVersioned(r.db, &model.Evidence{}, evidence.ID, evidence.Version, fields); err != nil {
+		return err
+	}
+	evidence.Version++
+	return nil
 }
 
-func (r *EvidenceRepository) SoftDelete(id uint) error {
-	return r.db.Model(&model.Evidence{}).Where("id = ?", id).Update("is_deleted", true).Error
+// SoftDelete marks an evidence item as deleted. Like any update it bumps the version, and it
+// fails with ErrVersionConflict if the item changed since it was read.
+func (r *EvidenceRepository) SoftDelete(evidence *model.Evidence) error {
+	if err := updateVersioned(r.db, &model.Evidence{}, evidence.ID, evidence.Version, map[string]interface{}{"is_deleted": true}); err != nil {
+		return err
+	}
+	evidence.IsDeleted = true
+	evidence.Version++
+	return nil
 }
 
 func (r *EvidenceRepository) HardDelete(id uint) error {
@@ -0,0 +1,175 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrHandoverNotPending is returned when a handover was decided in the meantime
+	ErrHandoverNotPending = errors.New("handover is no longer pending")
+	// ErrHandoverUnassigned is returned when the outgoing user is no longer assigned to the case
+	ErrHandoverUnassigned = errors.New("outgoing user is no longer assigned to the case")
+)
+
+// EnsureHandoverIndexes creates the partial unique index that allows one pending
+// handover per case and outgoing user
+func EnsureHandoverIndexes(db *gorm.DB) error {
+	return db.Exec("CREATE UNIQUE INDEX IF NOT EXISTS idx_case_handovers_pending ON case_handovers (case_id, from_user_id) WHERE status = 'pending' AND deleted_at IS NULL").Error
+}
+
+// HandoverFilter narrows down handover listings
+type HandoverFilter struct {
+	Status   model.HandoverStatus
+	Incoming *uint // handovers to this user
+	Outgoing *uint // handovers from this user
+	CaseID   *uint
+}
+
+type HandoverRepository struct {
+	db *gorm.DB
+}
+
+func NewHandoverRepository(db *gorm.DB) *HandoverRepository {
+	return &HandoverRepository{db: db}
+}
+
+func (r *HandoverRepository) Create(handover *model.CaseHandover) error {
+	return r.db.Omit("Case", "FromUser", "ToUser").Create(handover).Error
+}
+
+func (r *HandoverRepository) GetByID(id uint) (*model.CaseHandover, error) {
+	var handover model.CaseHandover
+	if err := r.preload(r.db).First(&handover, id).Error; err != nil {
+		return nil, err
+	}
+	sanitizeHandoverUsers(&handover)
+	return &handover, nil
+}
+
+// GetPending returns the pending handover of a user's assignment on a case, or gorm.ErrRecordNotFound
+func (r *HandoverRepository) GetPending(caseID, fromUserID uint) (*model.CaseHandover, error) {
+	var handover model.CaseHandover
+	err := r.db.Where("case_id = ? AND from_user_id = ? AND status = ?", caseID, fromUserID, model.HandoverPending).
+		First(&handover).Error
+	if err != nil {
+		return nil, err
+	}
+	return &handover, nil
+}
+
+// List returns handovers matching the filter, newest first
+func (r *HandoverRepository) List(filter HandoverFilter) ([]model.CaseHandover, error) {
+	query := r.preload(r.db)
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.Incoming != nil && filter.Outgoing != nil {
+		query = query.Where("to_user_id = ? OR from_user_id = ?", *filter.Incoming, *filter.Outgoing)
+	} else if filter.Incoming != nil {
+		query = query.Where("to_user_id = ?", *filter.Incoming)
+	} else if filter.Outgoing != nil {
+		query = query.Where("from_user_id = ?", *filter.Outgoing)
+	}
+	if filter.CaseID != nil {
+		query = query.Where("case_id = ?", *filter.CaseID)
+	}
+
+	var handovers []model.CaseHandover
+	err := query.Order("created_at DESC").Find(&handovers).Error
+	for i := range handovers {
+		sanitizeHandoverUsers(&handovers[i])
+	}
+	return handovers, err
+}
+
+func (r *HandoverRepository) preload(db *gorm.DB) *gorm.DB {
+	return db.Preload("Case").Preload("FromUser").Preload("ToUser")
+}
+
+func sanitizeHandoverUsers(handover *model.CaseHandover) {
+	handover.FromUser.Password = ""
+	handover.ToUser.Password = ""
+}
+
+// decide moves a pending handover to its final status. It fails with
+// ErrHandoverNotPending if the handover was decided in the meantime.
+func decide(tx *gorm.DB, handover *model.CaseHandover, status model.HandoverStatus, response string, at time.Time) error {
+	result := tx.Model(&model.CaseHandover{}).
+		Where("id = ? AND status = ?", handover.ID, model.HandoverPending).
+		Updates(map[string]interface{}{"status": status, "response": response, "decided_at": at})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrHandoverNotPending
+	}
+	handover.Status, handover.Response, handover.DecidedAt = status, response, &at
+	return nil
+}
+
+// Close declines or cancels a pending handover without changing the case assignments
+func (r *HandoverRepository) Close(handover *model.CaseHandover, status model.HandoverStatus, response string, auditLog *model.AuditLog) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := decide(tx, handover, status, response, time.Now()); err != nil {
+			return err
+		}
+		return tx.Create(auditLog).Error
+	})
+}
+
+// Accept completes a handover in one transaction: the outgoing user is unassigned and
+// the incoming user takes over their role, keeping an existing assignment if they had one
+func (r *HandoverRepository) Accept(handover *model.CaseHandover, response string, auditLogs []*model.AuditLog) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		if err := decide(tx, handover, model.HandoverAccepted, response, now); err != nil {
+			return err
+		}
+
+		// The outgoing role is read again, it may have changed since the request
+		var outgoing model.CaseAssignee
+		err := tx.Where("case_id = ? AND user_id = ?", handover.CaseID, handover.FromUserID).First(&outgoing).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return ErrHandoverUnassigned
+		}
+		if err != nil {
+			return err
+		}
+		if err := tx.Delete(&outgoing).Error; err != nil {
+			return err
+		}
+		handover.Role = outgoing.Role
+		if err := tx.Model(&model.CaseHandover{}).Where("id = ?", handover.ID).Update("role", handover.Role).Error; err != nil {
+			return err
+		}
+
+		// An incoming user who already leads the case stays lead
+		result := tx.Model(&model.CaseAssignee{}).
+			Where("case_id = ? AND user_id = ?", handover.CaseID, handover.ToUserID).
+			Updates(map[string]interface{}{
+				"role":             gorm.Expr("CASE WHEN role = ? THEN role ELSE ? END", model.AssignmentLead, handover.Role),
+				"ineligible_since": nil,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			err := tx.Create(&model.CaseAssignee{
+				CaseID:       handover.CaseID,
+				UserID:       handover.ToUserID,
+				Role:         handover.Role,
+				AssignedAt:   now,
+				AssignedByID: &handover.FromUserID,
+			}).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return createAuditLogs(tx, auditLogs)
+	})
+}
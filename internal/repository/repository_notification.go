@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type NotificationRepository struct {
+	db *gorm.DB
+}
+
+func NewNotificationRepository(db *gorm.DB) *NotificationRepository {
+	return &NotificationRepository{db: db}
+}
+
+func (r *NotificationRepository) Create(notification *model.Notification) error {
+	return r.db.Create(notification).Error
+}
+
+// ListByUserID returns a page of a user's notifications, newest first
+func (r *NotificationRepository) ListByUserID(userID uint, unreadOnly bool, offset, limit int) ([]model.Notification, int64, error) {
+	var notifications []model.Notification
+	var count int64
+
+	query := r.db.Model(&model.Notification{}).Where("user_id = ?", userID)
+	if unreadOnly {
+		query = query.Where("read_at IS NULL")
+	}
+
+	err := query.Count(&count).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	err = query.Order("created_at DESC").Offset(offset).Limit(limit).Find(&notifications).Error
+	return notifications, count, err
+}
+
+func (r *NotificationRepository) CountUnread(userID uint) (int64, error) {
+	var count int64
+	err := r.db.Model(&model.Notification{}).Where("user_id = ? AND read_at IS NULL", userID).Count(&count).Error
+	return count, err
+}
+
+// MarkRead marks one of the user's notifications as read. Returns gorm.ErrRecordNotFound
+// if the user has no such notification.
+func (r *NotificationRepository) MarkRead(userID, id uint, at time.Time) error {
+	var notification model.Notification
+	if err := r.db.Where("id = ? AND user_id = ?", id, userID).First(&notification).Error; err != nil {
+		return err
+	}
+	if notification.ReadAt != nil {
+		return nil
+	}
+	return r.db.Model(&notification).Update("read_at", at).Error
+}
+
+// MarkAllRead marks all of the user's unread notifications as read and returns how many changed
+func (r *NotificationRepository) MarkAllRead(userID uint, at time.Time) (int64, error) {
+	result := r.db.Model(&model.Notification{}).Where("user_id = ? AND read_at IS NULL", userID).Update("read_at", at)
+	return result.RowsAffected, result.Error
+}
+
+func (r *NotificationRepository) ListPreferences(userID uint) ([]model.NotificationPreference, error) {
+	var preferences []model.NotificationPreference
+	err := r.db.Where("user_id = ?", userID).Order("type").Find(&preferences).Error
+	return preferences, err
+}
+
+// GetPreferences returns the preferences of the given users for one notification type, keyed by user ID
+func (r *NotificationRepository) GetPreferences(userIDs []uint, notificationType model.NotificationType) (map[uint]model.NotificationPreference, error) {
+	var preferences []model.NotificationPreference
+	err := r.db.Where("user_id IN ? AND type = ?", userIDs, notificationType).Find(&preferences).Error
+	if err != nil {
+		return nil, err
+	}
+
+	byUser := make(map[uint]model.NotificationPreference, len(preferences))
+	for _, preference := range preferences {
+		byUser[preference.UserID] = preference
+	}
+	return byUser, nil
+}
+
+// SavePreferences creates or replaces the given preferences of a user
+func (r *NotificationRepository) SavePreferences(preferences []model.NotificationPreference) error {
+	if len(preferences) == 0 {
+		return nil
+	}
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}, {Name: "type"}},
+		DoUpdates: clause.AssignmentColumns([]string{"in_app", "email", "updated_at"}),
+	}).Create(&preferences).Error
+}
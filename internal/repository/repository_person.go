@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// PersonRepository stores suspects, victims and witnesses. Records are passed as
+// *model.Suspect, *model.Victim or *model.Witness.
+type PersonRepository struct {
+	db *gorm.DB
+}
+
+func NewPersonRepository(db *gorm.DB) *PersonRepository {
+	return &PersonRepository{db: db}
+}
+
+// Get loads a person of the case into record
+func (r *PersonRepository) Get(record interface{}, caseID, id uint) error {
+	return r.db.Where("case_id = ?", caseID).First(record, id).Error
+}
+
+// Create adds a person to a case and records it in the case history
+func (r *PersonRepository) Create(record interface{}, person *model.Person, auditLog *model.AuditLog) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit(clause.Associations).Create(record).Error; err != nil {
+			return err
+		}
+		auditLog.EntityID = person.ID
+		return tx.Create(auditLog).Error
+	})
+}
+
+// UpdateFields updates only the given columns of a person, with one audit log per field
+func (r *PersonRepository) UpdateFields(record interface{}, person *model.Person, fields map[string]interface{}, auditLogs []*model.AuditLog) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(record).Where("id = ?", person.ID).Updates(fields).Error; err != nil {
+			return err
+		}
+		return createAuditLogs(tx, auditLogs)
+	})
+}
+
+// Delete removes a person from a case. The row is soft deleted so the case history keeps its reference.
+func (r *PersonRepository) Delete(record interface{}, auditLog *model.AuditLog) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(record).Error; err != nil {
+			return err
+		}
+		return tx.Create(auditLog).Error
+	})
+}
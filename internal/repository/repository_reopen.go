@@ -0,0 +1,161 @@
+package repository
+
+import (
+	"errors"
+
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"gorm.io/gorm"
+)
+
+// ErrReopenNotPending is returned when a reopen request was decided in the meantime
+var ErrReopenNotPending = errors.New("reopen request is no longer pending")
+
+// EnsureReopenIndexes creates the partial unique index that allows one pending reopen request per case
+func EnsureReopenIndexes(db *gorm.DB) error {
+	return db.Exec("CREATE UNIQUE INDEX IF NOT EXISTS idx_case_reopens_pending ON case_reopens (case_id) WHERE status = 'pending' AND deleted_at IS NULL").Error
+}
+
+type ReopenRepository struct {
+	db *gorm.DB
+}
+
+func NewReopenRepository(db *gorm.DB) *ReopenRepository {
+	return &ReopenRepository{db: db}
+}
+
+func (r *ReopenRepository) GetByID(id uint) (*model.CaseReopen, error) {
+	var reopen model.CaseReopen
+	if err := r.preload(r.db).First(&reopen, id).Error; err != nil {
+		return nil, err
+	}
+	sanitizeReopenUsers(&reopen)
+	return &reopen, nil
+}
+
+// GetPending returns the pending reopen request of a case, or gorm.ErrRecordNotFound
+func (r *ReopenRepository) GetPending(caseID uint) (*model.CaseReopen, error) {
+	var reopen model.CaseReopen
+	if err := r.db.Where("case_id = ? AND status = ?", caseID, model.ReopenPending).First(&reopen).Error; err != nil {
+		return nil, err
+	}
+	return &reopen, nil
+}
+
+// ListByCase returns the reopen history of a case, newest first
+func (r *ReopenRepository) ListByCase(caseID uint) ([]model.CaseReopen, error) {
+	return r.list(r.db.Where("case_id = ?", caseID).Order("created_at DESC"))
+}
+
+// ListPending returns the reopen requests waiting for approval on cases at the allowed levels, oldest first
+func (r *ReopenRepository) ListPending(allowedLevels []model.ClearanceLevel) ([]model.CaseReopen, error) {
+	query := r.db.Where("status = ?", model.ReopenPending).
+		Where("case_id IN (SELECT id FROM cases WHERE authorization_level IN ? AND deleted_at IS NULL)", allowedLevels)
+	return r.list(query.Order("created_at ASC"))
+}
+
+func (r *ReopenRepository) list(query *gorm.DB) ([]model.CaseReopen, error) {
+	var reopens []model.CaseReopen
+	err := r.preload(query).Find(&reopens).Error
+	for i := range reopens {
+		sanitizeReopenUsers(&reopens[i])
+	}
+	return reopens, err
+}
+
+func (r *ReopenRepository) preload(db *gorm.DB) *gorm.DB {
+	return db.Preload("Case").Preload("RequestedBy").Preload("DecidedBy")
+}
+
+func sanitizeReopenUsers(reopen *model.CaseReopen) {
+	reopen.RequestedBy.Password = ""
+	if reopen.DecidedBy != nil {
+		reopen.DecidedBy.Password = ""
+	}
+}
+
+// Request records a reopen request that waits for approval
+func (r *ReopenRepository) Request(reopen *model.CaseReopen, auditLog *model.AuditLog) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Case", "RequestedBy", "DecidedBy").Create(reopen).Error; err != nil {
+			return err
+		}
+		auditLog.EntityID = reopen.ID
+		return tx.Create(auditLog).Error
+	})
+}
+
+// Reopen records an approved reopen request, reopens the case and restores its archived
+// evidence in one transaction. A request without an ID is created, a pending one is
+// approved. The case must still be at the version it was read at. Its SLA escalation is
+// cleared, so that a reopened case that breaches its SLA is escalated again.
+func (r *ReopenRepository) Reopen(reopen *model.CaseReopen, caseData *model.Case, fields map[string]interface{}, auditLogs []*model.AuditLog) error {
+	fields["escalated_at"] = nil
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := updateVersioned(tx, &model.Case{}, caseData.ID, caseData.Version, fields); err != nil {
+			return err
+		}
+
+		result := tx.Model(&model.Evidence{}).
+			Where("case_id = ? AND archived_at IS NOT NULL", caseData.ID).
+			Updates(map[string]interface{}{"archived_at": nil, "version": gorm.Expr("version + 1")})
+		if result.Error != nil {
+			return result.Error
+		}
+		reopen.RestoredEvidence = result.RowsAffected
+
+		if reopen.ID == 0 {
+			if err := tx.Omit("Case", "RequestedBy", "DecidedBy").Create(reopen).Error; err != nil {
+				return err
+			}
+		} else {
+			if err := decideReopen(tx, reopen); err != nil {
+				return err
+			}
+			if err := tx.Model(&model.CaseReopen{}).Where("id = ?", reopen.ID).Update("restored_evidence", reopen.RestoredEvidence).Error; err != nil {
+				return err
+			}
+		}
+
+		for _, auditLog := range auditLogs {
+			if auditLog.EntityID == 0 {
+				auditLog.EntityID = reopen.ID
+			}
+		}
+		return createAuditLogs(tx, auditLogs)
+	})
+	if err == nil {
+		caseData.Version++
+		caseData.EscalatedAt = nil
+	}
+	return err
+}
+
+// Reject records that a pending reopen request was turned down. The case stays closed.
+func (r *ReopenRepository) Reject(reopen *model.CaseReopen, auditLog *model.AuditLog) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := decideReopen(tx, reopen); err != nil {
+			return err
+		}
+		return tx.Create(auditLog).Error
+	})
+}
+
+// decideReopen stores the decision on a pending reopen request. It fails with
+// ErrReopenNotPending if the request was decided in the meantime.
+func decideReopen(tx *gorm.DB, reopen *model.CaseReopen) error {
+	result := tx.Model(&model.CaseReopen{}).
+		Where("id = ? AND status = ?", reopen.ID, model.ReopenPending).
+		Updates(map[string]interface{}{
+			"status":        reopen.Status,
+			"decided_by_id": reopen.DecidedByID,
+			"response":      reopen.Response,
+			"decided_at":    reopen.DecidedAt,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrReopenNotPending
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"gorm.io/gorm"
+)
+
+// duplicateCandidateLimit bounds how many reports or cases a report is compared with
+const duplicateCandidateLimit = 200
+
+type ReportRepository struct {
+	db *gorm.DB
+}
+
+func NewReportRepository(db *gorm.DB) *ReportRepository {
+	return &ReportRepository{db: db}
+}
+
+// GetByID returns a report with the cases it is linked to
+func (r *ReportRepository) GetByID(id uint) (*model.Report, error) {
+	var report model.Report
+	if err := r.db.Preload("Cases").First(&report, id).Error; err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
+
+// ListCandidateReports returns the reports submitted between from and to, newest first, with
+// their linked cases, to compare the given number of reports with
+func (r *ReportRepository) ListCandidateReports(from, to time.Time, compared int) ([]model.Report, error) {
+	var reports []model.Report
+	err := r.db.Preload("Cases").
+		Where("created_at BETWEEN ? AND ?", from, to).
+		Order("created_at DESC").
+		Limit((duplicateCandidateLimit + 1) * compared). // +1 for each compared report itself
+		Find(&reports).Error
+	return reports, err
+}
+
+// ListCandidateCases returns the open cases at the allowed levels created between from and to,
+// newest first, to compare the given number of reports with
+func (r *ReportRepository) ListCandidateCases(from, to time.Time, allowedLevels []model.ClearanceLevel, compared int) ([]model.Case, error) {
+	var cases []model.Case
+	err := r.db.
+		Where("status <> ? AND merged_into_id IS NULL", model.StatusClosed).
+		Where("authorization_level IN ?", allowedLevels).
+		Where("created_at BETWEEN ? AND ?", from, to).
+		Order("created_at DESC").
+		Limit(duplicateCandidateLimit * compared).
+		Find(&cases).Error
+	return cases, err
+}
+
+// ListLinkedCaseIDs returns the IDs of the cases each of the reports is linked to
+func (r *ReportRepository) ListLinkedCaseIDs(reportIDs []uint) (map[uint][]uint, error) {
+	var links []struct {
+		ReportID uint
+		CaseID   uint
+	}
+	err := r.db.Table("case_reports").Select("report_id, case_id").Where("report_id IN ?", reportIDs).Scan(&links).Error
+	if err != nil {
+		return nil, err
+	}
+
+	linked := map[uint][]uint{}
+	for _, link := range links {
+		linked[link.ReportID] = append(linked[link.ReportID], link.CaseID)
+	}
+	return linked, nil
+}
+
+func (r *ReportRepository) IsLinked(reportID, caseID uint) (bool, error) {
+	var count int64
+	err := r.db.Table("case_reports").Where("case_id = ? AND report_id = ?", caseID, reportID).Count(&count).Error
+	return count > 0, err
+}
+
+// LinkToCase attaches the report to the case and records it in the case history
+func (r *ReportRepository) LinkToCase(reportID, caseID uint, auditLog *model.AuditLog) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("INSERT INTO case_reports (case_id, report_id) VALUES (?, ?) ON CONFLICT DO NOTHING", caseID, reportID).Error; err != nil {
+			return err
+		}
+		return createAuditLogs(tx, []*model.AuditLog{auditLog})
+	})
+}
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"gorm.io/gorm"
+)
+
+type SavedViewRepository struct {
+	db *gorm.DB
+}
+
+func NewSavedViewRepository(db *gorm.DB) *SavedViewRepository {
+	return &SavedViewRepository{db: db}
+}
+
+func (r *SavedViewRepository) Create(view *model.SavedView) error {
+	return r.db.Omit("Owner").Create(view).Error
+}
+
+func (r *SavedViewRepository) GetByID(id uint) (*model.SavedView, error) {
+	var view model.SavedView
+	if err := r.db.Preload("Owner").First(&view, id).Error; err != nil {
+		return nil, err
+	}
+	return &view, nil
+}
+
+func (r *SavedViewRepository) Update(view *model.SavedView) error {
+	return r.db.Omit("Owner").Save(view).Error
+}
+
+// Delete removes a view permanently so that its name can be used again
+func (r *SavedViewRepository) Delete(id uint) error {
+	return r.db.Unscoped().Delete(&model.SavedView{}, id).Error
+}
+
+// NameTaken reports whether the owner has another view with this name
+func (r *SavedViewRepository) NameTaken(ownerID uint, name string, excludeID uint) (bool, error) {
+	var count int64
+	err := r.db.Model(&model.SavedView{}).
+		Where("owner_id = ? AND name = ? AND id <> ?", ownerID, name, excludeID).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// ListVisible returns the user's own views and the views shared with their area
+func (r *SavedViewRepository) ListVisible(userID uint, area string) ([]model.SavedView, error) {
+	query := r.db.Preload("Owner").Where("owner_id = ?", userID)
+	if area != "" {
+		query = query.Or("shared_area = ?", area)
+	}
+	var views []model.SavedView
+	err := query.Order("name").Find(&views).Error
+	return views, err
+}
+
+// ListWatched returns the views whose owners want to hear about new matching cases
+func (r *SavedViewRepository) ListWatched() ([]model.SavedView, error) {
+	var views []model.SavedView
+	err := r.db.Preload("Owner").Where("watch = ?", true).Find(&views).Error
+	return views, err
+}
+
+// MarkChecked records that the view was checked for new cases up to the given time
+func (r *SavedViewRepository) MarkChecked(id uint, at time.Time) error {
+	return r.db.Model(&model.SavedView{}).Where("id = ?", id).Update("last_checked_at", at).Error
+}
@@ -0,0 +1,156 @@
+package repository
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"gorm.io/gorm"
+)
+
+// searchSource describes one table that takes part in full-text search.
+// The tsvector expression is shared between the GIN index and the query so
+// that Postgres can use the index.
+type searchSource struct {
+	EntityType string
+	Table      string
+	Columns    []string
+	CaseColumn string
+	Condition  string
+}
+
+var searchSources = []searchSource{
+	{EntityType: "case", Table: "cases", Columns: []string{"name", "description"}, CaseColumn: "id"},
+	{EntityType: "evidence", Table: "evidences", Columns: []string{"content", "remarks"}, CaseColumn: "case_id", Condition: "t.is_deleted = false"},
+	{EntityType: "witness", Table: "witnesses", Columns: []string{"statement"}, CaseColumn: "case_id"},
+	{EntityType: "suspect", Table: "suspects", Columns: []string{"description"}, CaseColumn: "case_id"},
+	{EntityType: "comment", Table: "comments", Columns: []string{"content"}, CaseColumn: "case_id", Condition: "t.is_deleted = false"},
+}
+
+// SearchEntityTypes returns the entity types that can be searched
+func SearchEntityTypes() []string {
+	types := make([]string, 0, len(searchSources))
+	for _, source := range searchSources {
+		types = append(types, source.EntityType)
+	}
+	return types
+}
+
+func searchDocument(alias string, columns []string) string {
+	parts := make([]string, 0, len(columns))
+	for _, column := range columns {
+		parts = append(parts, fmt.Sprintf("coalesce(%s%s, '')", alias, column))
+	}
+	return strings.Join(parts, " || ' ' || ")
+}
+
+// htmlSearchDocument is the search document with HTML special characters escaped. Snippets
+// are highlighted with <b> tags and rendered as HTML, so the text around them must be escaped.
+func htmlSearchDocument(alias string, columns []string) string {
+	document := searchDocument(alias, columns)
+	for _, escape := range [][2]string{{"&", "&amp;"}, {"<", "&lt;"}, {">", "&gt;"}, {`"`, "&quot;"}, {"''", "&#39;"}} {
+		document = fmt.Sprintf("replace(%s, '%s', '%s')", document, escape[0], escape[1])
+	}
+	return document
+}
+
+func searchVector(alias string, columns []string) string {
+	return fmt.Sprintf("to_tsvector('english', %s)", searchDocument(alias, columns))
+}
+
+// EnsureSearchIndexes creates the GIN indexes used by full-text search.
+// They are expression indexes, so Postgres keeps them up to date on every write.
+func EnsureSearchIndexes(db *gorm.DB) error {
+	for _, source := range searchSources {
+		stmt := fmt.Sprintf("CREATE INDEX IF NOT EXISTS idx_%s_fts ON %s USING GIN (%s)",
+			source.Table, source.Table, searchVector("", source.Columns))
+		if err := db.Exec(stmt).Error; err != nil {
+			return fmt.Errorf("failed to create search index on %s: %w", source.Table, err)
+		}
+	}
+	return nil
+}
+
+type SearchResult struct {
+	EntityType string
+	EntityID   uint
+	CaseID     uint
+	CaseName   string
+	Rank       float64
+	Snippet    string
+	CreatedAt  time.Time
+}
+
+type SearchRepository struct {
+	db *gorm.DB
+}
+
+func NewSearchRepository(db *gorm.DB) *SearchRepository {
+	return &SearchRepository{db: db}
+}
+
+// Search runs a ranked full-text query across all search sources, limited to
+// cases whose authorization level is in allowedLevels.
+func (r *SearchRepository) Search(query string, entityTypes []string, allowedLevels []model.ClearanceLevel, offset, limit int) ([]SearchResult, int64, error) {
+	var selects []string
+	var args []interface{}
+
+	for _, source := range searchSources {
+		if len(entityTypes) > 0 && !containsString(entityTypes, source.EntityType) {
+			continue
+		}
+
+		where := []string{
+			"t.deleted_at IS NULL",
+			"c.deleted_at IS NULL",
+			"c.authorization_level IN ?",
+			searchVector("t.", source.Columns) + " @@ websearch_to_tsquery('english', ?)",
+		}
+		if source.Condition != "" {
+			where = append(where, source.Condition)
+		}
+
+		selects = append(selects, fmt.Sprintf(
+			`SELECT '%s' AS entity_type, t.id AS entity_id, c.id AS case_id, c.name AS case_name,
+				ts_rank(%s, websearch_to_tsquery('english', ?)) AS rank,
+				ts_headline('english', %s, websearch_to_tsquery('english', ?), 'StartSel=<b>, StopSel=</b>, MaxFragments=2, MaxWords=30, MinWords=10') AS snippet,
+				t.created_at AS created_at
+			FROM %s t JOIN cases c ON c.id = t.%s
+			WHERE %s`,
+			source.EntityType,
+			searchVector("t.", source.Columns),
+			htmlSearchDocument("t.", source.Columns),
+			source.Table, source.CaseColumn,
+			strings.Join(where, " AND "),
+		))
+		args = append(args, query, query, allowedLevels, query)
+	}
+
+	if len(selects) == 0 {
+		return []SearchResult{}, 0, nil
+	}
+
+	union := strings.Join(selects, " UNION ALL ")
+
+	var count int64
+	err := r.db.Raw("SELECT COUNT(*) FROM ("+union+") results", args...).Scan(&count).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var results []SearchResult
+	pageArgs := append(args, limit, offset)
+	err = r.db.Raw("SELECT * FROM ("+union+") results ORDER BY rank DESC, created_at DESC LIMIT ? OFFSET ?", pageArgs...).
+		Scan(&results).Error
+	return results, count, err
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type SLAPolicyRepository struct {
+	db *gorm.DB
+}
+
+func NewSLAPolicyRepository(db *gorm.DB) *SLAPolicyRepository {
+	return &SLAPolicyRepository{db: db}
+}
+
+// Upsert creates the policy for its case type and priority, or replaces the targets of the existing one
+func (r *SLAPolicyRepository) Upsert(policy *model.SLAPolicy) error {
+	return r.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "case_type"}, {Name: "priority"}},
+		DoUpdates: clause.AssignmentColumns([]string{"first_assignment_minutes", "first_update_minutes", "closure_minutes", "updated_at"}),
+	}).Create(policy).Error
+}
+
+func (r *SLAPolicyRepository) GetByID(id uint) (*model.SLAPolicy, error) {
+	var policy model.SLAPolicy
+	if err := r.db.First(&policy, id).Error; err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+func (r *SLAPolicyRepository) List() ([]model.SLAPolicy, error) {
+	var policies []model.SLAPolicy
+	err := r.db.Order("case_type, priority").Find(&policies).Error
+	return policies, err
+}
+
+// Delete removes a policy permanently so that its case type and priority can be defined again
+func (r *SLAPolicyRepository) Delete(id uint) error {
+	return r.db.Unscoped().Delete(&model.SLAPolicy{}, id).Error
+}
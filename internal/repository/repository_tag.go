@@ -0,0 +1,182 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// taggableTables maps each taggable entity type to its table
+var taggableTables = map[model.TaggableType]string{
+	model.TaggableCase:     "cases",
+	model.TaggableEvidence: "evidences",
+	model.TaggableSuspect:  "suspects",
+	model.TaggableVictim:   "victims",
+	model.TaggableWitness:  "witnesses",
+}
+
+// IsTaggable reports whether tags can be attached to the entity type
+func IsTaggable(entityType model.TaggableType) bool {
+	_, ok := taggableTables[entityType]
+	return ok
+}
+
+// TagFilter limits a list to entities carrying the given tags: all of them, or any of them with MatchAny
+type TagFilter struct {
+	TagIDs   []uint
+	MatchAny bool
+}
+
+// apply adds the tag condition for entities of entityType whose ID is in idColumn
+func (f TagFilter) apply(query *gorm.DB, entityType model.TaggableType, idColumn string) *gorm.DB {
+	if len(f.TagIDs) == 0 {
+		return query
+	}
+	required := len(f.TagIDs)
+	if f.MatchAny {
+		required = 1
+	}
+	return query.Where(idColumn+` IN (SELECT entity_id FROM taggings
+		WHERE entity_type = ? AND tag_id IN ? AND deleted_at IS NULL
+		GROUP BY entity_id HAVING COUNT(DISTINCT tag_id) >= ?)`, entityType, f.TagIDs, required)
+}
+
+// TaggedEntity identifies one entity in a bulk tag operation
+type TaggedEntity struct {
+	Type model.TaggableType
+	ID   uint
+}
+
+type TagRepository struct {
+	db *gorm.DB
+}
+
+func NewTagRepository(db *gorm.DB) *TagRepository {
+	return &TagRepository{db: db}
+}
+
+func (r *TagRepository) Create(tag *model.Tag) error {
+	return r.db.Create(tag).Error
+}
+
+func (r *TagRepository) GetByID(id uint) (*model.Tag, error) {
+	var tag model.Tag
+	if err := r.db.First(&tag, id).Error; err != nil {
+		return nil, err
+	}
+	return &tag, nil
+}
+
+func (r *TagRepository) GetByName(name string) (*model.Tag, error) {
+	var tag model.Tag
+	if err := r.db.Where("LOWER(name) = LOWER(?)", name).First(&tag).Error; err != nil {
+		return nil, err
+	}
+	return &tag, nil
+}
+
+func (r *TagRepository) Update(tag *model.Tag) error {
+	return r.db.Save(tag).Error
+}
+
+// Delete permanently removes a tag and detaches it everywhere
+func (r *TagRepository) Delete(id uint) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Where("tag_id = ?", id).Delete(&model.Tagging{}).Error; err != nil {
+			return err
+		}
+		result := tx.Unscoped().Delete(&model.Tag{}, id)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errors.New("tag not found")
+		}
+		return nil
+	})
+}
+
+// List returns the tags visible at the given clearance levels
+func (r *TagRepository) List(allowedLevels []model.ClearanceLevel) ([]model.Tag, error) {
+	var tags []model.Tag
+	err := r.db.Where("min_clearance IN ?", allowedLevels).Order("name").Find(&tags).Error
+	return tags, err
+}
+
+// ListByEntity returns the tags on an entity that are visible at the given clearance levels
+func (r *TagRepository) ListByEntity(entityType model.TaggableType, entityID uint, allowedLevels []model.ClearanceLevel) ([]model.Tag, error) {
+	var tags []model.Tag
+	err := r.db.Joins("JOIN taggings ON taggings.tag_id = tags.id AND taggings.deleted_at IS NULL").
+		Where("taggings.entity_type = ? AND taggings.entity_id = ?", entityType, entityID).
+		Where("tags.min_clearance IN ?", allowedLevels).
+		Order("tags.name").
+		Find(&tags).Error
+	return tags, err
+}
+
+// GetEntityCaseID returns the case an entity belongs to
+func (r *TagRepository) GetEntityCaseID(entityType model.TaggableType, entityID uint) (uint, error) {
+	table, ok := taggableTables[entityType]
+	if !ok {
+		return 0, fmt.Errorf("unknown entity type %q", entityType)
+	}
+	caseColumn := "case_id"
+	if entityType == model.TaggableCase {
+		caseColumn = "id"
+	}
+
+	var caseIDs []uint
+	err := r.db.Table(table).Where("id = ? AND deleted_at IS NULL", entityID).Pluck(caseColumn, &caseIDs).Error
+	if err != nil {
+		return 0, err
+	}
+	if len(caseIDs) == 0 {
+		return 0, gorm.ErrRecordNotFound
+	}
+	return caseIDs[0], nil
+}
+
+// Attach tags every entity with every tag in one transaction. Existing taggings are kept.
+func (r *TagRepository) Attach(tagIDs []uint, entities []TaggedEntity, createdByID uint, auditLogs []*model.AuditLog) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		var taggings []model.Tagging
+		for _, entity := range entities {
+			for _, tagID := range tagIDs {
+				taggings = append(taggings, model.Tagging{TagID: tagID, EntityType: entity.Type, EntityID: entity.ID, CreatedByID: createdByID})
+			}
+		}
+		if len(taggings) > 0 {
+			if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&taggings).Error; err != nil {
+				return err
+			}
+		}
+		return createAuditLogs(tx, auditLogs)
+	})
+}
+
+// Detach removes the tags from every entity in one transaction
+func (r *TagRepository) Detach(tagIDs []uint, entities []TaggedEntity, auditLogs []*model.AuditLog) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		for _, entity := range entities {
+			err := tx.Unscoped().
+				Where("entity_type = ? AND entity_id = ? AND tag_id IN ?", entity.Type, entity.ID, tagIDs).
+				Delete(&model.Tagging{}).Error
+			if err != nil {
+				return err
+			}
+		}
+		return createAuditLogs(tx, auditLogs)
+	})
+}
+
+func createAuditLogs(tx *gorm.DB, auditLogs []*model.AuditLog) error {
+	for _, auditLog := range auditLogs {
+		if err := tx.Create(auditLog).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
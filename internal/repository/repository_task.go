@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"gorm.io/gorm"
+)
+
+// TaskFilter narrows down task listings
+type TaskFilter struct {
+	Status      model.TaskStatus
+	OverdueOnly bool
+}
+
+type TaskRepository struct {
+	db *gorm.DB
+}
+
+func NewTaskRepository(db *gorm.DB) *TaskRepository {
+	return &TaskRepository{db: db}
+}
+
+func (r *TaskRepository) Create(task *model.Task) error {
+	return r.db.Create(task).Error
+}
+
+func (r *TaskRepository) GetByID(id uint) (*model.Task, error) {
+	var task model.Task
+	result := r.db.Preload("Assignee").Preload("CreatedBy").First(&task, id)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	sanitizeTaskUsers(&task)
+	return &task, nil
+}
+
+func (r *TaskRepository) Update(task *model.Task) error {
+	return r.db.Omit("Case", "Assignee", "CreatedBy").Save(task).Error
+}
+
+func (r *TaskRepository) Delete(id uint) error {
+	result := r.db.Delete(&model.Task{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("task not found")
+	}
+	return nil
+}
+
+func (r *TaskRepository) ListByCaseID(caseID uint, filter TaskFilter) ([]model.Task, error) {
+	return r.list(r.db.Where("case_id = ?", caseID), filter)
+}
+
+// ListByAssigneeID returns the tasks assigned to a user across all cases
+func (r *TaskRepository) ListByAssigneeID(userID uint, filter TaskFilter) ([]model.Task, error) {
+	return r.list(r.db.Where("assignee_id = ?", userID).Preload("Case"), filter)
+}
+
+func (r *TaskRepository) list(query *gorm.DB, filter TaskFilter) ([]model.Task, error) {
+	if filter.Status != "" {
+		query = query.Where("status = ?", filter.Status)
+	}
+	if filter.OverdueOnly {
+		query = query.Where("due_date < ? AND status NOT IN ?", time.Now(),
+			[]model.TaskStatus{model.TaskStatusDone, model.TaskStatusCancelled})
+	}
+
+	var tasks []model.Task
+	err := query.Preload("Assignee").Preload("CreatedBy").
+		Order("due_date IS NULL, due_date, created_at").
+		Find(&tasks).Error
+
+	for i := range tasks {
+		sanitizeTaskUsers(&tasks[i])
+	}
+	return tasks, err
+}
+
+// sanitizeTaskUsers removes passwords from the users attached to a task
+func sanitizeTaskUsers(task *model.Task) {
+	if task.Assignee != nil {
+		task.Assignee.Password = ""
+	}
+	task.CreatedBy.Password = ""
+	task.Case.CreatedBy.Password = ""
+}
@@ -0,0 +1,169 @@
+package repository
+
+import (
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// TimelineItem is a single event in a case's activity history
+type TimelineItem struct {
+	Type       string
+	EntityType string
+	EntityID   uint
+	OccurredAt time.Time
+	ActorID    *uint
+	ActorName  string
+	Summary    string
+	Detail     string
+	OldValue   string
+	NewValue   string
+}
+
+// TimelineFilter narrows down a case timeline
+type TimelineFilter struct {
+	Types     []string
+	ActorID   *uint
+	Since     *time.Time
+	Until     *time.Time
+	Ascending bool
+}
+
+// timelineSources produce events from the case tables themselves; status changes,
+// assignments, deletions and other changes come from the audit log. Evidence that
+// was later deleted keeps its "added" event but loses its content; people who were
+// removed keep theirs.
+// Every source takes the case ID as its only argument.
+var timelineSources = []string{
+	`SELECT 'case_created' AS type, 'case' AS entity_type, c.id AS entity_id, c.created_at AS occurred_at,
+		c.created_by_id AS actor_id, 'Case ' || coalesce(c.reference_number, '') || ' opened' AS summary,
+		c.description AS detail, '' AS old_value, '' AS new_value
+	FROM cases c WHERE c.id = ?`,
+
+	`SELECT 'evidence_added', 'evidence', e.id, e.created_at, e.added_by_id,
+		initcap(e.type) || ' evidence added',
+		CASE WHEN e.is_deleted THEN '' WHEN e.type = 'text' THEN e.content ELSE e.remarks END, '', ''
+	FROM evidences e WHERE e.case_id = ? AND e.deleted_at IS NULL`,
+
+	`SELECT 'person_added', 'suspect', p.id, p.created_at, p.added_by_id,
+		'Suspect ' || p.first_name || ' ' || p.last_name || ' added', p.description, '', ''
+	FROM suspects p WHERE p.case_id = ?`,
+
+	`SELECT 'person_added', 'victim', p.id, p.created_at, p.added_by_id,
+		'Victim ' || p.first_name || ' ' || p.last_name || ' added', p.injury_description, '', ''
+	FROM victims p WHERE p.case_id = ?`,
+
+	`SELECT 'person_added', 'witness', p.id, p.created_at, p.added_by_id,
+		'Witness ' || p.first_name || ' ' || p.last_name || ' added', p.statement, '', ''
+	FROM witnesses p WHERE p.case_id = ?`,
+
+	`SELECT 'comment_added', 'comment', cm.id, cm.created_at, cm.user_id,
+		CASE WHEN cm.parent_id IS NULL THEN 'Comment posted' ELSE 'Reply posted' END, cm.content, '', ''
+	FROM comments cm WHERE cm.case_id = ? AND cm.deleted_at IS NULL AND cm.is_deleted = false`,
+
+	`SELECT 'report_linked', 'report', r.id, r.created_at, NULL,
+		'Citizen report "' || r.title || '" linked', r.description, '', ''
+	FROM reports r JOIN case_reports cr ON cr.report_id = r.id WHERE cr.case_id = ? AND r.deleted_at IS NULL`,
+
+	`SELECT
+		CASE
+			WHEN a.entity_type = 'case_status' THEN 'status_changed'
+			WHEN a.entity_type = 'case_authorization' THEN 'authorization_changed'
+			WHEN a.entity_type = 'case_assignee' AND a.action = 'create' THEN 'assignee_added'
+			WHEN a.entity_type = 'case_assignee' AND a.action = 'delete' THEN 'assignee_removed'
+			WHEN a.entity_type = 'case_assignee' AND a.action = 'update' THEN 'assignee_role_changed'
+			WHEN a.entity_type = 'assignee_eligibility' THEN 'assignee_eligibility_changed'
+			WHEN a.entity_type = 'case_handover' AND a.action = 'create' THEN 'handover_requested'
+			WHEN a.entity_type = 'case_handover' THEN 'handover_' || a.new_value
+			WHEN a.entity_type = 'case_template' THEN 'created_from_template'
+			WHEN a.entity_type = 'case_closure' AND a.action = 'create' THEN 'closure_requested'
+			WHEN a.entity_type = 'case_closure' THEN 'closure_' || a.new_value
+			WHEN a.entity_type = 'case_disposition' THEN 'case_closed'
+			WHEN a.entity_type = 'case_reopen' AND a.action = 'create' THEN 'reopen_requested'
+			WHEN a.entity_type = 'case_reopen' THEN 'reopen_' || a.new_value
+			WHEN a.entity_type IN ('case_reopened', 'evidence_archived', 'evidence_restored') THEN a.entity_type
+			WHEN a.entity_type = 'case_export' THEN 'case_exported'
+			WHEN a.entity_type IN ('suspect', 'victim', 'witness') AND a.action = 'update' THEN 'person_updated'
+			WHEN a.entity_type IN ('suspect', 'victim', 'witness') AND a.action = 'delete' THEN 'person_removed'
+			WHEN a.entity_type = 'case_import' THEN 'case_imported'
+			WHEN a.action = 'merge' THEN 'case_merged'
+			ELSE a.entity_type || '_' || CASE a.action WHEN 'create' THEN 'created' WHEN 'update' THEN 'updated' WHEN 'delete' THEN 'deleted' ELSE a.action END
+		END,
+		a.entity_type, a.entity_id, a.created_at, a.user_id,
+		CASE
+			WHEN a.entity_type = 'case_status' THEN 'Status changed from ' || a.old_value || ' to ' || a.new_value
+			WHEN a.entity_type = 'case_authorization' THEN 'Authorization level changed from ' || a.old_value || ' to ' || a.new_value
+			WHEN a.entity_type = 'case_assignee' AND a.action = 'update' THEN 'Assignee role changed from ' || a.old_value || ' to ' || a.new_value
+			WHEN a.entity_type = 'case_handover' AND a.action = 'update' THEN 'Handover ' || a.new_value
+			WHEN a.entity_type = 'case_closure' AND a.action = 'update' THEN 'Closure ' || a.new_value
+			WHEN a.entity_type = 'case_disposition' THEN 'Closed as ' || a.new_value
+			WHEN a.entity_type = 'case_reopen' AND a.action = 'update' THEN 'Reopen ' || a.new_value
+			WHEN a.entity_type = 'case_reopened' THEN 'Reopened: ' || a.new_value
+			WHEN a.entity_type IN ('suspect', 'victim', 'witness') AND a.action = 'update' THEN initcap(a.entity_type) || ' updated, ' || a.new_value
+			WHEN a.entity_type IN ('suspect', 'victim', 'witness') AND a.action = 'delete' THEN initcap(a.entity_type) || ' ' || a.old_value || ' removed'
+			WHEN a.entity_type = 'comment' AND a.action = 'delete' THEN 'Comment deleted'
+			WHEN a.new_value <> '' THEN a.new_value
+			ELSE a.old_value
+		END,
+		'', a.old_value, a.new_value
+	FROM audit_logs a
+	WHERE a.case_id = ? AND a.deleted_at IS NULL AND NOT (a.entity_type IN ('evidence', 'case_report', 'suspect', 'victim', 'witness') AND a.action = 'create')`,
+}
+
+type TimelineRepository struct {
+	db *gorm.DB
+}
+
+func NewTimelineRepository(db *gorm.DB) *TimelineRepository {
+	return &TimelineRepository{db: db}
+}
+
+// ListByCaseID merges all activity on a case into one chronological, paginated feed
+func (r *TimelineRepository) ListByCaseID(caseID uint, filter TimelineFilter, offset, limit int) ([]TimelineItem, int64, error) {
+	args := make([]interface{}, 0, len(timelineSources))
+	for range timelineSources {
+		args = append(args, caseID)
+	}
+
+	var where []string
+	if len(filter.Types) > 0 {
+		where = append(where, "events.type IN ?")
+		args = append(args, filter.Types)
+	}
+	if filter.ActorID != nil {
+		where = append(where, "events.actor_id = ?")
+		args = append(args, *filter.ActorID)
+	}
+	if filter.Since != nil {
+		where = append(where, "events.occurred_at >= ?")
+		args = append(args, *filter.Since)
+	}
+	if filter.Until != nil {
+		where = append(where, "events.occurred_at <= ?")
+		args = append(args, *filter.Until)
+	}
+
+	from := "FROM (" + strings.Join(timelineSources, " UNION ALL ") + ") events"
+	if len(where) > 0 {
+		from += " WHERE " + strings.Join(where, " AND ")
+	}
+
+	var count int64
+	if err := r.db.Raw("SELECT COUNT(*) "+from, args...).Scan(&count).Error; err != nil {
+		return nil, 0, err
+	}
+
+	order := "DESC"
+	if filter.Ascending {
+		order = "ASC"
+	}
+
+	var items []TimelineItem
+	pageArgs := append(args, limit, offset)
+	err := r.db.Raw("SELECT events.*, coalesce(u.full_name, '') AS actor_name "+
+		strings.Replace(from, ") events", ") events LEFT JOIN users u ON u.id = events.actor_id", 1)+
+		" ORDER BY events.occurred_at "+order+", events.entity_id "+order+" LIMIT ? OFFSET ?", pageArgs...).
+		Scan(&items).Error
+	return items, count, err
+}
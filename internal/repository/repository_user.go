@@ -73,3 +73,20 @@ func (r *UserRepository) List(offset, limit int) ([]model.User, int64, error) {
 	err = r.db.Offset(offset).Limit(limit).Find(&users).Error
 	return users, count, err
 }
+
+// ListEscalationRecipients returns active supervisors, or active admins if there are no supervisors
+func (r *UserRepository) ListEscalationRecipients() ([]model.User, error) {
+	var users []model.User
+	err := r.db.Where("is_supervisor = ? AND is_active = ?", true, true).Find(&users).Error
+	if err != nil || len(users) > 0 {
+		return users, err
+	}
+	return r.ListActiveAdmins()
+}
+
+// ListActiveAdmins returns the active admins
+func (r *UserRepository) ListActiveAdmins() ([]model.User, error) {
+	var users []model.User
+	err := r.db.Where("role = ? AND is_active = ?", model.RoleAdmin, true).Find(&users).Error
+	return users, err
+}
@@ -0,0 +1,29 @@
+package repository
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+)
+
+// ErrVersionConflict is returned when a versioned record was changed after it was read
+var ErrVersionConflict = errors.New("record was modified by another request")
+
+// updateVersioned updates only the given columns of a record, provided it is still at
+// the given version, and moves it to the next version
+func updateVersioned(db *gorm.DB, value interface{}, id uint, version int, fields map[string]interface{}) error {
+	updates := make(map[string]interface{}, len(fields)+1)
+	for column, v := range fields {
+		updates[column] = v
+	}
+	updates["version"] = gorm.Expr("version + 1")
+
+	result := db.Model(value).Where("id = ? AND version = ?", id, version).Updates(updates)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrVersionConflict
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type WatcherRepository struct {
+	db *gorm.DB
+}
+
+func NewWatcherRepository(db *gorm.DB) *WatcherRepository {
+	return &WatcherRepository{db: db}
+}
+
+// Watch makes the user a watcher of the case. Watching a case twice is not an error.
+func (r *WatcherRepository) Watch(caseID, userID uint) error {
+	return r.db.Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&model.CaseWatcher{CaseID: caseID, UserID: userID}).Error
+}
+
+func (r *WatcherRepository) Unwatch(caseID, userID uint) error {
+	return r.db.Where("case_id = ? AND user_id = ?", caseID, userID).Delete(&model.CaseWatcher{}).Error
+}
+
+func (r *WatcherRepository) IsWatching(caseID, userID uint) (bool, error) {
+	var count int64
+	err := r.db.Model(&model.CaseWatcher{}).Where("case_id = ? AND user_id = ?", caseID, userID).Count(&count).Error
+	return count > 0, err
+}
+
+// ListWatchers returns the users watching a case
+func (r *WatcherRepository) ListWatchers(caseID uint) ([]model.User, error) {
+	var users []model.User
+	err := r.db.Joins("JOIN case_watchers cw ON cw.user_id = users.id").
+		Where("cw.case_id = ?", caseID).
+		Order("users.full_name").
+		Find(&users).Error
+
+	for i := range users {
+		users[i].Password = ""
+	}
+	return users, err
+}
+
+// ListWatchedCases returns the cases a user watches, most recently watched first
+func (r *WatcherRepository) ListWatchedCases(userID uint) ([]model.Case, error) {
+	var cases []model.Case
+	err := r.db.Joins("JOIN case_watchers cw ON cw.case_id = cases.id").
+		Where("cw.user_id = ?", userID).
+		Order("cw.created_at DESC").
+		Find(&cases).Error
+	return cases, err
+}
@@ -0,0 +1,251 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"github.com/m7medVision/crime-management-system/internal/repository"
+	"github.com/m7medVision/crime-management-system/internal/util"
+)
+
+// AnalyticsQuery describes the cases an analytics request covers
+type AnalyticsQuery struct {
+	From        *time.Time
+	To          *time.Time
+	Area        string
+	CaseType    string
+	Status      model.CaseStatus
+	Disposition model.CaseDisposition
+}
+
+// TimeBucketCount is the number of cases created in one time bucket
+type TimeBucketCount struct {
+	Start time.Time
+	Count int64
+}
+
+// TrendItem compares a group's case count with the previous period of the same length
+type TrendItem struct {
+	Key           string
+	Current       int64
+	Previous      int64
+	Change        int64
+	ChangePercent *float64 // nil when there were no cases in the previous period
+}
+
+// Hotspot is an area ranked by case count
+type Hotspot struct {
+	repository.Hotspot
+	Share float64 // percentage of all cases in the period
+}
+
+const (
+	defaultAnalyticsPeriod = 30 * 24 * time.Hour
+	maxTimeBuckets         = 1000
+)
+
+type AnalyticsService struct {
+	analyticsRepo *repository.AnalyticsRepository
+}
+
+func NewAnalyticsService(analyticsRepo *repository.AnalyticsRepository) *AnalyticsService {
+	return &AnalyticsService{analyticsRepo: analyticsRepo}
+}
+
+// filterFor resolves the query period (the last 30 days by default) and limits
+// the cases to the user's clearance
+func (s *AnalyticsService) filterFor(user *model.User, query AnalyticsQuery) (repository.AnalyticsFilter, error) {
+	to := time.Now()
+	if query.To != nil {
+		to = *query.To
+	}
+	from := to.Add(-defaultAnalyticsPeriod)
+	if query.From != nil {
+		from = *query.From
+	}
+	if !from.Before(to) {
+		return repository.AnalyticsFilter{}, fmt.Errorf("%w: from must be before to", ErrInvalidInput)
+	}
+	if query.Disposition != "" && !query.Disposition.IsValid() {
+		return repository.AnalyticsFilter{}, fmt.Errorf("%w: invalid disposition %q", ErrInvalidInput, query.Disposition)
+	}
+
+	return repository.AnalyticsFilter{
+		From:          from,
+		To:            to,
+		Area:          query.Area,
+		CaseType:      query.CaseType,
+		Status:        query.Status,
+		Disposition:   query.Disposition,
+		AllowedLevels: append([]model.ClearanceLevel{}, util.ClearanceLevelsUpTo(user.ClearanceLevel)...),
+	}, nil
+}
+
+// byDimension narrows the filter to the cases that have a value for the dimension.
+// Only closed cases have a disposition.
+func byDimension(filter repository.AnalyticsFilter, dimension string) repository.AnalyticsFilter {
+	if dimension == "disposition" {
+		filter.Status = model.StatusClosed
+	}
+	return filter
+}
+
+func dimensionColumn(dimension string) (string, error) {
+	column, ok := repository.AnalyticsDimensions[dimension]
+	if !ok {
+		return "", fmt.Errorf("%w: cannot group by %q, expected area, caseType, status, priority or disposition", ErrInvalidInput, dimension)
+	}
+	return column, nil
+}
+
+// CountBy counts cases grouped by a dimension
+func (s *AnalyticsService) CountBy(user *model.User, query AnalyticsQuery, dimension string) ([]repository.CountByKey, error) {
+	column, err := dimensionColumn(dimension)
+	if err != nil {
+		return nil, err
+	}
+	filter, err := s.filterFor(user, query)
+	if err != nil {
+		return nil, err
+	}
+	return s.analyticsRepo.CountBy(byDimension(filter, dimension), column)
+}
+
+// CountByTimeBucket counts cases per day, week or month. Buckets without cases are included with a zero count.
+func (s *AnalyticsService) CountByTimeBucket(user *model.User, query AnalyticsQuery, bucket string) ([]TimeBucketCount, error) {
+	if bucket != "day" && bucket != "week" && bucket != "month" {
+		return nil, fmt.Errorf("%w: bucket must be day, week or month", ErrInvalidInput)
+	}
+	filter, err := s.filterFor(user, query)
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := s.analyticsRepo.CountByTimeBucket(filter, bucket)
+	if err != nil {
+		return nil, err
+	}
+	countByStart := map[int64]int64{}
+	for _, count := range counts {
+		countByStart[count.Bucket.UTC().Unix()] = count.Count
+	}
+
+	var series []TimeBucketCount
+	for start := truncateToBucket(filter.From.UTC(), bucket); start.Before(filter.To); start = nextBucket(start, bucket) {
+		if len(series) == maxTimeBuckets {
+			return nil, fmt.Errorf("%w: period too long for %s buckets", ErrInvalidInput, bucket)
+		}
+		series = append(series, TimeBucketCount{Start: start, Count: countByStart[start.Unix()]})
+	}
+	return series, nil
+}
+
+// truncateToBucket matches Postgres date_trunc in UTC; weeks start on Monday
+func truncateToBucket(t time.Time, bucket string) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	switch bucket {
+	case "week":
+		return day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+	case "month":
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	}
+	return day
+}
+
+func nextBucket(t time.Time, bucket string) time.Time {
+	switch bucket {
+	case "week":
+		return t.AddDate(0, 0, 7)
+	case "month":
+		return t.AddDate(0, 1, 0)
+	}
+	return t.AddDate(0, 0, 1)
+}
+
+// Trends compares case counts per group with the previous period of the same length
+func (s *AnalyticsService) Trends(user *model.User, query AnalyticsQuery, dimension string) ([]TrendItem, error) {
+	column, err := dimensionColumn(dimension)
+	if err != nil {
+		return nil, err
+	}
+	current, err := s.filterFor(user, query)
+	if err != nil {
+		return nil, err
+	}
+	current = byDimension(current, dimension)
+	previous := current
+	previous.To = current.From
+	previous.From = current.From.Add(-current.To.Sub(current.From))
+
+	currentCounts, err := s.analyticsRepo.CountBy(current, column)
+	if err != nil {
+		return nil, err
+	}
+	previousCounts, err := s.analyticsRepo.CountBy(previous, column)
+	if err != nil {
+		return nil, err
+	}
+
+	previousByKey := map[string]int64{}
+	for _, count := range previousCounts {
+		previousByKey[count.Key] = count.Count
+	}
+
+	items := make([]TrendItem, 0, len(currentCounts))
+	for _, count := range currentCounts {
+		items = append(items, newTrendItem(count.Key, count.Count, previousByKey[count.Key]))
+		delete(previousByKey, count.Key)
+	}
+	// Groups that had cases before but none now
+	for _, count := range previousCounts {
+		if _, ok := previousByKey[count.Key]; ok {
+			items = append(items, newTrendItem(count.Key, 0, count.Count))
+		}
+	}
+	return items, nil
+}
+
+func newTrendItem(key string, current, previous int64) TrendItem {
+	item := TrendItem{Key: key, Current: current, Previous: previous, Change: current - previous}
+	if previous > 0 {
+		percent := float64(item.Change) * 100 / float64(previous)
+		item.ChangePercent = &percent
+	}
+	return item
+}
+
+// Hotspots returns the top areas by case count
+func (s *AnalyticsService) Hotspots(user *model.User, query AnalyticsQuery, limit int) ([]Hotspot, error) {
+	if limit <= 0 || limit > 100 {
+		return nil, fmt.Errorf("%w: limit must be between 1 and 100", ErrInvalidInput)
+	}
+	filter, err := s.filterFor(user, query)
+	if err != nil {
+		return nil, err
+	}
+
+	// The total is needed to work out each area's share
+	byArea, err := s.analyticsRepo.CountBy(filter, repository.AnalyticsDimensions["area"])
+	if err != nil {
+		return nil, err
+	}
+	var total int64
+	for _, count := range byArea {
+		total += count.Count
+	}
+
+	rows, err := s.analyticsRepo.Hotspots(filter, limit)
+	if err != nil {
+		return nil, err
+	}
+	hotspots := make([]Hotspot, 0, len(rows))
+	for _, row := range rows {
+		hotspot := Hotspot{Hotspot: row}
+		if total > 0 {
+			hotspot.Share = float64(row.Count) * 100 / float64(total)
+		}
+		hotspots = append(hotspots, hotspot)
+	}
+	return hotspots, nil
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTruncateToBucket(t *testing.T) {
+	date := func(year int, month time.Month, day, hour int) time.Time {
+		return time.Date(year, month, day, hour, 30, 15, 0, time.UTC)
+	}
+	midnight := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name   string
+		t      time.Time
+		bucket string
+		want   time.Time
+	}{
+		{"day", date(2026, 3, 18, 14), "day", midnight(2026, 3, 18)},
+		{"unknown bucket is a day", date(2026, 3, 18, 14), "hour", midnight(2026, 3, 18)},
+		{"week from a Wednesday", date(2026, 3, 18, 14), "week", midnight(2026, 3, 16)},
+		{"week from a Monday", date(2026, 3, 16, 0), "week", midnight(2026, 3, 16)},
+		{"week from a Sunday", date(2026, 3, 22, 23), "week", midnight(2026, 3, 16)},
+		{"week across a month", date(2026, 4, 2, 9), "week", midnight(2026, 3, 30)},
+		{"week across a year", date(2026, 1, 1, 9), "week", midnight(2025, 12, 29)},
+		{"month", date(2026, 3, 18, 14), "month", midnight(2026, 3, 1)},
+		{"month on its first day", date(2026, 3, 1, 0), "month", midnight(2026, 3, 1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := truncateToBucket(tt.t, tt.bucket); !got.Equal(tt.want) {
+				t.Errorf("truncateToBucket(%v, %q) = %v, want %v", tt.t, tt.bucket, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNextBucket(t *testing.T) {
+	day := func(year int, month time.Month, d int) time.Time {
+		return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		start  time.Time
+		bucket string
+		want   time.Time
+	}{
+		{day(2026, 1, 31), "day", day(2026, 2, 1)},
+		{day(2025, 12, 29), "week", day(2026, 1, 5)},
+		{day(2026, 1, 1), "month", day(2026, 2, 1)},
+		{day(2025, 12, 1), "month", day(2026, 1, 1)},
+	}
+	for _, tt := range tests {
+		if got := nextBucket(tt.start, tt.bucket); !got.Equal(tt.want) {
+			t.Errorf("nextBucket(%v, %q) = %v, want %v", tt.start, tt.bucket, got, tt.want)
+		}
+	}
+}
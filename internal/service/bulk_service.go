@@ -0,0 +1,461 @@
+package service
+
+import (
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"github.com/m7medVision/crime-management-system/internal/repository"
+	"github.com/m7medVision/crime-management-system/internal/util"
+)
+
+// maxBulkCases caps the number of cases one bulk operation may touch
+const maxBulkCases = 500
+
+type BulkOperation string
+
+const (
+	BulkAssign             BulkOperation = "assign"
+	BulkUnassign           BulkOperation = "unassign"
+	BulkStatus             BulkOperation = "status"
+	BulkTag                BulkOperation = "tag"
+	BulkUntag              BulkOperation = "untag"
+	BulkAuthorizationLevel BulkOperation = "authorization_level"
+)
+
+type BulkItemStatus string
+
+const (
+	BulkItemApplied    BulkItemStatus = "applied"
+	BulkItemUnchanged  BulkItemStatus = "unchanged"   // the case already was in the requested state
+	BulkItemFailed     BulkItemStatus = "failed"      // the case was rejected or could not be updated
+	BulkItemRolledBack BulkItemStatus = "rolled_back" // atomic request in which another case failed
+)
+
+// BulkCaseRequest applies one operation to a list of cases, or to every case matching Filter.
+// With Atomic set the operation is applied to all cases or to none.
+type BulkCaseRequest struct {
+	Operation          BulkOperation
+	CaseIDs            []uint
+	Filter             *repository.CaseFilter
+	Tags               TagQuery             // tag filter applied together with Filter
+	UserID             uint                 // assign, unassign
+	Role               model.AssignmentRole // assign, defaults to support
+	Status             model.CaseStatus
+	TagIDs             []uint // tag, untag
+	AuthorizationLevel model.ClearanceLevel
+	Atomic             bool
+	IPAddress          string
+}
+
+type BulkItemResult struct {
+	CaseID uint
+	Status BulkItemStatus
+	Error  string
+}
+
+type BulkCaseResult struct {
+	Applied   int
+	Unchanged int
+	Failed    int
+	Items     []BulkItemResult
+}
+
+// bulkChange is the work planned for one case
+type bulkChange func(cases *repository.CaseRepository, tags *repository.TagRepository) error
+
+// bulkOperationRoles lists who may run each operation, in line with the single-case endpoints
+var bulkOperationRoles = map[BulkOperation][]model.Role{
+	BulkAssign:             {model.RoleInvestigator, model.RoleAdmin},
+	BulkUnassign:           {model.RoleInvestigator, model.RoleAdmin},
+	BulkStatus:             {model.RoleOfficer, model.RoleInvestigator, model.RoleAdmin},
+	BulkTag:                {model.RoleOfficer, model.RoleInvestigator, model.RoleAdmin},
+	BulkUntag:              {model.RoleOfficer, model.RoleInvestigator, model.RoleAdmin},
+	BulkAuthorizationLevel: {model.RoleInvestigator, model.RoleAdmin},
+}
+
+type BulkCaseService struct {
+	caseRepo   *repository.CaseRepository
+	userRepo   *repository.UserRepository
+	tagRepo    *repository.TagRepository
+	notifier   *NotificationService
+	reconciler *AssignmentReconciler
+}
+
+func NewBulkCaseService(
+	caseRepo *repository.CaseRepository,
+	userRepo *repository.UserRepository,
+	tagRepo *repository.TagRepository,
+	notifier *NotificationService,
+	reconciler *AssignmentReconciler,
+) *BulkCaseService {
+	return &BulkCaseService{
+		caseRepo:   caseRepo,
+		userRepo:   userRepo,
+		tagRepo:    tagRepo,
+		notifier:   notifier,
+		reconciler: reconciler,
+	}
+}
+
+// bulkContext holds what was loaded once for the whole request
+type bulkContext struct {
+	request  BulkCaseRequest
+	user     *model.User
+	assignee *model.User
+	tags     []model.Tag
+	after    map[uint]func() // run for cases that end up applied
+}
+
+// Apply checks permissions case by case and applies the operation. Each case is
+// updated in its own transaction, or all cases in one transaction when the request
+// is atomic. Every changed case gets one audit log entry.
+func (s *BulkCaseService) Apply(user *model.User, request BulkCaseRequest) (*BulkCaseResult, error) {
+	roles, ok := bulkOperationRoles[request.Operation]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown operation %q", ErrInvalidInput, request.Operation)
+	}
+	if !slices.Contains(roles, user.Role) {
+		return nil, fmt.Errorf("%w: your role cannot run %s", ErrForbidden, request.Operation)
+	}
+
+	bulk := &bulkContext{request: request, user: user, after: map[uint]func(){}}
+	if err := s.prepare(bulk); err != nil {
+		return nil, err
+	}
+
+	caseIDs, err := s.resolveCaseIDs(user, request)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &BulkCaseResult{Items: make([]BulkItemResult, 0, len(caseIDs))}
+	changes := map[uint]bulkChange{}
+	for _, caseID := range caseIDs {
+		item := BulkItemResult{CaseID: caseID, Status: BulkItemApplied}
+		change, err := s.plan(bulk, caseID)
+		switch {
+		case err != nil:
+			item.Status, item.Error = BulkItemFailed, err.Error()
+		case change == nil:
+			item.Status = BulkItemUnchanged
+		default:
+			changes[caseID] = change
+		}
+		result.Items = append(result.Items, item)
+	}
+
+	if request.Atomic {
+		s.applyAtomic(result, changes)
+	} else {
+		for i := range result.Items {
+			item := &result.Items[i]
+			if change := changes[item.CaseID]; change != nil {
+				if err := s.caseRepo.Transaction(change); err != nil {
+					item.Status, item.Error = BulkItemFailed, err.Error()
+				}
+			}
+		}
+	}
+
+	for _, item := range result.Items {
+		switch item.Status {
+		case BulkItemApplied:
+			result.Applied++
+			if after := bulk.after[item.CaseID]; after != nil {
+				after()
+			}
+		case BulkItemUnchanged:
+			result.Unchanged++
+		case BulkItemFailed:
+			result.Failed++
+		}
+	}
+	return result, nil
+}
+
+// applyAtomic applies all changes in one transaction, or none if any case failed
+func (s *BulkCaseService) applyAtomic(result *BulkCaseResult, changes map[uint]bulkChange) {
+	failedID, failure := uint(0), ""
+	for _, item := range result.Items {
+		if item.Status == BulkItemFailed {
+			failedID = item.CaseID
+			break
+		}
+	}
+
+	if failedID == 0 {
+		err := s.caseRepo.Transaction(func(cases *repository.CaseRepository, tags *repository.TagRepository) error {
+			for _, item := range result.Items {
+				if change := changes[item.CaseID]; change != nil {
+					if err := change(cases, tags); err != nil {
+						failedID, failure = item.CaseID, err.Error()
+						return err
+					}
+				}
+			}
+			return nil
+		})
+		if err == nil {
+			return
+		}
+	}
+
+	for i := range result.Items {
+		item := &result.Items[i]
+		if item.CaseID == failedID && failure != "" {
+			item.Status, item.Error = BulkItemFailed, failure
+		} else if item.Status == BulkItemApplied {
+			item.Status = BulkItemRolledBack
+		}
+	}
+}
+
+// prepare validates the operation parameters and loads what every case needs
+func (s *BulkCaseService) prepare(bulk *bulkContext) error {
+	request := bulk.request
+	switch request.Operation {
+	case BulkAssign, BulkUnassign:
+		if request.UserID == 0 {
+			return fmt.Errorf("%w: userId is required", ErrInvalidInput)
+		}
+		assignee, err := s.userRepo.GetByID(request.UserID)
+		if err != nil {
+			return fmt.Errorf("%w: user not found", ErrNotFound)
+		}
+		if request.Operation == BulkAssign && !assignee.IsActive {
+			return fmt.Errorf("%w: cannot assign an inactive user", ErrInvalidInput)
+		}
+		bulk.assignee = assignee
+		if request.Operation == BulkAssign {
+			if bulk.request.Role == "" {
+				bulk.request.Role = model.AssignmentSupport
+			}
+			if !bulk.request.Role.IsValid() {
+				return fmt.Errorf("%w: invalid assignment role %q", ErrInvalidInput, request.Role)
+			}
+		}
+
+	case BulkStatus:
+		if !request.Status.IsValid() {
+			return fmt.Errorf("%w: invalid status %q", ErrInvalidInput, request.Status)
+		}
+		if request.Status == model.StatusClosed {
+			return fmt.Errorf("%w: %v", ErrInvalidInput, errClosureRequired)
+		}
+
+	case BulkTag, BulkUntag:
+		if len(request.TagIDs) == 0 {
+			return fmt.Errorf("%w: tagIds is required", ErrInvalidInput)
+		}
+		for _, tagID := range request.TagIDs {
+			tag, err := s.tagRepo.GetByID(tagID)
+			if err != nil || !canUseTag(bulk.user, tag) {
+				return fmt.Errorf("%w: tag %d not found", ErrNotFound, tagID)
+			}
+			bulk.tags = append(bulk.tags, *tag)
+		}
+
+	case BulkAuthorizationLevel:
+		if util.ClearanceLevelToInt(request.AuthorizationLevel) == 0 {
+			return fmt.Errorf("%w: invalid authorization level %q", ErrInvalidInput, request.AuthorizationLevel)
+		}
+		if !util.IsClearnceLevelHigherOrEqual(bulk.user.ClearanceLevel, request.AuthorizationLevel) {
+			return fmt.Errorf("%w: cannot raise cases above your own clearance", ErrInsufficientClearance)
+		}
+	}
+	return nil
+}
+
+// resolveCaseIDs returns the distinct case IDs named in the request or matched by its filter
+func (s *BulkCaseService) resolveCaseIDs(user *model.User, request BulkCaseRequest) ([]uint, error) {
+	if (len(request.CaseIDs) > 0) == (request.Filter != nil) {
+		return nil, fmt.Errorf("%w: give either caseIds or a filter", ErrInvalidInput)
+	}
+
+	ids := request.CaseIDs
+	if request.Filter != nil {
+		filter := *request.Filter
+		var err error
+		if filter.Tags, err = resolveTagFilter(s.tagRepo, user, request.Tags); err != nil {
+			return nil, err
+		}
+		// Never leave the list nil, which would disable the clearance filter
+		filter.AllowedLevels = append([]model.ClearanceLevel{}, util.ClearanceLevelsUpTo(user.ClearanceLevel)...)
+		if ids, err = s.caseRepo.ListIDs(filter, maxBulkCases+1); err != nil {
+			return nil, err
+		}
+	}
+
+	seen := map[uint]bool{}
+	distinct := make([]uint, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			distinct = append(distinct, id)
+		}
+	}
+	if len(distinct) == 0 {
+		return nil, fmt.Errorf("%w: no cases selected", ErrInvalidInput)
+	}
+	if len(distinct) > maxBulkCases {
+		return nil, fmt.Errorf("%w: a bulk operation can affect at most %d cases", ErrInvalidInput, maxBulkCases)
+	}
+	return distinct, nil
+}
+
+// plan checks the operation against one case and returns the change to make,
+// or nil if the case already is in the requested state
+func (s *BulkCaseService) plan(bulk *bulkContext, caseID uint) (bulkChange, error) {
+	caseData, err := getAccessibleCase(s.caseRepo, bulk.user, caseID)
+	if err != nil {
+		return nil, err
+	}
+	if caseData.MergedIntoID != nil {
+		return nil, fmt.Errorf("%w: case has been merged into case %d", ErrConflict, *caseData.MergedIntoID)
+	}
+
+	request := bulk.request
+	auditLog := &model.AuditLog{
+		UserID:    bulk.user.ID,
+		Action:    model.ActionUpdate,
+		EntityID:  caseID,
+		CaseID:    &caseID,
+		IPAddress: request.IPAddress,
+	}
+
+	switch request.Operation {
+	case BulkAssign, BulkUnassign:
+		assigned, err := s.caseRepo.IsAssigned(caseID, bulk.assignee.ID)
+		if err != nil {
+			return nil, err
+		}
+		auditLog.EntityType = "case_assignee"
+		auditLog.EntityID = bulk.assignee.ID
+
+		if request.Operation == BulkUnassign {
+			if !assigned {
+				return nil, nil
+			}
+			auditLog.Action = model.ActionDelete
+			auditLog.OldValue = fmt.Sprintf("%s unassigned from case", bulk.assignee.FullName)
+			return func(cases *repository.CaseRepository, _ *repository.TagRepository) error {
+				if err := cases.RemoveAssignee(caseID, bulk.assignee.ID); err != nil {
+					return err
+				}
+				return cases.CreateAuditLog(auditLog)
+			}, nil
+		}
+
+		if assigned {
+			return nil, nil
+		}
+		if !canAccessCase(bulk.assignee, caseData) {
+			return nil, fmt.Errorf("%w: %s is not cleared for this case", ErrInsufficientClearance, bulk.assignee.FullName)
+		}
+		if request.Role == model.AssignmentLead {
+			if _, err := s.caseRepo.GetLead(caseID); err == nil {
+				return nil, fmt.Errorf("%w: case already has a lead", ErrConflict)
+			}
+		}
+		auditLog.Action = model.ActionCreate
+		auditLog.NewValue = fmt.Sprintf("%s assigned to case as %s", bulk.assignee.FullName, request.Role)
+		bulk.after[caseID] = func() { notifyAssigneeAdded(s.notifier, caseData, bulk.user.ID, bulk.assignee) }
+		return func(cases *repository.CaseRepository, _ *repository.TagRepository) error {
+			if err := cases.AddAssignee(caseID, bulk.assignee.ID, request.Role, &bulk.user.ID); err != nil {
+				return err
+			}
+			if err := cases.MarkFirstAssigned(caseID, time.Now()); err != nil {
+				return err
+			}
+			return cases.CreateAuditLog(auditLog)
+		}, nil
+
+	case BulkStatus:
+		if caseData.Status == request.Status {
+			return nil, nil
+		}
+		if caseData.Status == model.StatusClosed {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidInput, errReopenRequired)
+		}
+		if bulk.user.Role == model.RoleOfficer {
+			assigned, err := s.caseRepo.IsAssigned(caseID, bulk.user.ID)
+			if err != nil {
+				return nil, err
+			}
+			if !assigned {
+				return nil, fmt.Errorf("%w: officer must be assigned to the case to update its status", ErrForbidden)
+			}
+		}
+		auditLog.EntityType = "case_status"
+		auditLog.OldValue = string(caseData.Status)
+		auditLog.NewValue = string(request.Status)
+		oldStatus := caseData.Status
+		fields := setCaseStatus(caseData, request.Status)
+		bulk.after[caseID] = func() { notifyStatusChanged(s.notifier, caseData, bulk.user.ID, oldStatus) }
+		return func(cases *repository.CaseRepository, _ *repository.TagRepository) error {
+			if err := cases.UpdateFields(caseData, fields); err != nil {
+				return err
+			}
+			return cases.CreateAuditLog(auditLog)
+		}, nil
+
+	case BulkTag, BulkUntag:
+		current, err := s.tagRepo.ListByEntity(model.TaggableCase, caseID, util.ClearanceLevelsUpTo(bulk.user.ClearanceLevel))
+		if err != nil {
+			return nil, err
+		}
+		var tagIDs []uint
+		var names []string
+		for _, tag := range bulk.tags {
+			has := slices.ContainsFunc(current, func(t model.Tag) bool { return t.ID == tag.ID })
+			if has == (request.Operation == BulkUntag) {
+				tagIDs = append(tagIDs, tag.ID)
+				names = append(names, tag.Name)
+			}
+		}
+		if len(tagIDs) == 0 {
+			return nil, nil
+		}
+
+		entities := []repository.TaggedEntity{{Type: model.TaggableCase, ID: caseID}}
+		auditLog.EntityType = "tagging"
+		if request.Operation == BulkUntag {
+			auditLog.Action = model.ActionDelete
+			auditLog.OldValue = fmt.Sprintf("Tags %s removed from case %d", strings.Join(names, ", "), caseID)
+			return func(_ *repository.CaseRepository, tags *repository.TagRepository) error {
+				return tags.Detach(tagIDs, entities, []*model.AuditLog{auditLog})
+			}, nil
+		}
+		auditLog.Action = model.ActionCreate
+		auditLog.NewValue = fmt.Sprintf("Tags %s added to case %d", strings.Join(names, ", "), caseID)
+		return func(_ *repository.CaseRepository, tags *repository.TagRepository) error {
+			return tags.Attach(tagIDs, entities, bulk.user.ID, []*model.AuditLog{auditLog})
+		}, nil
+
+	case BulkAuthorizationLevel:
+		if caseData.AuthorizationLevel == request.AuthorizationLevel {
+			return nil, nil
+		}
+		// Assignees who are no longer cleared are flagged or removed once the level changed
+		bulk.after[caseID] = func() {
+			if _, err := s.reconciler.ForCase(caseID, request.AuthorizationLevel, bulk.user.ID, false); err != nil {
+				log.Printf("Failed to reconcile assignees of case %d: %v", caseID, err)
+			}
+		}
+		auditLog.EntityType = "case_authorization"
+		auditLog.OldValue = string(caseData.AuthorizationLevel)
+		auditLog.NewValue = string(request.AuthorizationLevel)
+		fields := map[string]interface{}{"authorization_level": request.AuthorizationLevel}
+		return func(cases *repository.CaseRepository, _ *repository.TagRepository) error {
+			if err := cases.UpdateFields(caseData, fields); err != nil {
+				return err
+			}
+			return cases.CreateAuditLog(auditLog)
+		}, nil
+	}
+	return nil, fmt.Errorf("%w: unknown operation %q", ErrInvalidInput, request.Operation)
+}
@@ -0,0 +1,430 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/gabriel-vasile/mimetype"
+	"github.com/google/uuid"
+	"github.com/m7medVision/crime-management-system/internal/bundle"
+	"github.com/m7medVision/crime-management-system/internal/config"
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"github.com/m7medVision/crime-management-system/internal/repository"
+	"github.com/m7medVision/crime-management-system/internal/util"
+	"github.com/minio/minio-go/v7"
+)
+
+type BundleService struct {
+	bundleRepo  *repository.BundleRepository
+	caseRepo    *repository.CaseRepository
+	userRepo    *repository.UserRepository
+	caseService *CaseService
+	minioClient *minio.Client
+	minioBucket string
+	signingKey  []byte
+	areaCode    string
+}
+
+func NewBundleService(
+	bundleRepo *repository.BundleRepository,
+	caseRepo *repository.CaseRepository,
+	userRepo *repository.UserRepository,
+	caseService *CaseService,
+	minioBucket string,
+	bundleConfig config.BundleConfig,
+	areaCode string,
+) *BundleService {
+	return &BundleService{
+		bundleRepo:  bundleRepo,
+		caseRepo:    caseRepo,
+		userRepo:    userRepo,
+		caseService: caseService,
+		minioClient: util.GetMinioClient(),
+		minioBucket: minioBucket,
+		signingKey:  []byte(bundleConfig.SigningKey),
+		areaCode:    areaCode,
+	}
+}
+
+func bundleActor(user model.User) bundle.Actor {
+	return bundle.Actor{Username: user.Username, FullName: user.FullName}
+}
+
+// ExportCase packs a case with its people, evidence, comments and history into a signed bundle
+func (s *BundleService) ExportCase(user *model.User, caseID uint) ([]byte, *model.Case, error) {
+	if _, err := getAccessibleCase(s.caseRepo, user, caseID); err != nil {
+		return nil, nil, err
+	}
+	export, err := s.bundleRepo.Load(caseID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	caseData := &export.Case
+	b := &bundle.Bundle{
+		Manifest: bundle.Manifest{
+			ExportedAt: time.Now().UTC(),
+			ExportedBy: bundleActor(*user),
+			Source:     s.areaCode,
+			Reference:  caseData.ReferenceNumber,
+		},
+		Case: bundle.Case{
+			ID:                 caseData.ID,
+			Reference:          caseData.ReferenceNumber,
+			Name:               caseData.Name,
+			Description:        caseData.Description,
+			Area:               caseData.Area,
+			Address:            caseData.Address,
+			Latitude:           caseData.Latitude,
+			Longitude:          caseData.Longitude,
+			CaseType:           caseData.CaseType,
+			CustomFields:       caseData.CustomFields,
+			Status:             string(caseData.Status),
+			Priority:           string(caseData.Priority),
+			AuthorizationLevel: string(caseData.AuthorizationLevel),
+			Disposition:        string(caseData.Disposition),
+			ClosingSummary:     caseData.ClosingSummary,
+			CreatedBy:          bundleActor(caseData.CreatedBy),
+			CreatedAt:          caseData.CreatedAt,
+			FirstAssignedAt:    caseData.FirstAssignedAt,
+			FirstUpdatedAt:     caseData.FirstUpdatedAt,
+			ClosedAt:           caseData.ClosedAt,
+		},
+		People:     []bundle.Person{},
+		Evidence:   []bundle.Evidence{},
+		Comments:   []bundle.Comment{},
+		AuditTrail: []bundle.AuditEntry{},
+		Objects:    map[string][]byte{},
+	}
+
+	for _, suspect := range export.Suspects {
+		person := bundlePerson("suspect", suspect.Person)
+		person.Description = suspect.Description
+		person.IsArrested = suspect.IsArrested
+		b.People = append(b.People, person)
+	}
+	for _, victim := range export.Victims {
+		person := bundlePerson("victim", victim.Person)
+		person.InjuryDescription = victim.InjuryDescription
+		b.People = append(b.People, person)
+	}
+	for _, witness := range export.Witnesses {
+		person := bundlePerson("witness", witness.Person)
+		person.Statement = witness.Statement
+		b.People = append(b.People, person)
+	}
+
+	for _, evidence := range export.Evidence {
+		item := bundle.Evidence{
+			ID:         evidence.ID,
+			Type:       string(evidence.Type),
+			Content:    evidence.Content,
+			Remarks:    evidence.Remarks,
+			AddedBy:    bundleActor(evidence.AddedBy),
+			ArchivedAt: evidence.ArchivedAt,
+			CreatedAt:  evidence.CreatedAt,
+		}
+		if evidence.Type == model.EvidenceTypeImage && evidence.ImagePath != "" {
+			data, err := s.readObject(evidence.ImagePath)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to read image of evidence %d: %w", evidence.ID, err)
+			}
+			item.Object = bundle.ObjectPath(evidence.ID, evidence.ImagePath)
+			b.Objects[item.Object] = data
+		}
+		b.Evidence = append(b.Evidence, item)
+	}
+
+	for _, comment := range export.Comments {
+		item := bundle.Comment{
+			ID:        comment.ID,
+			ParentID:  comment.ParentID,
+			Content:   comment.Content,
+			Author:    bundleActor(comment.User),
+			EditedAt:  comment.EditedAt,
+			IsDeleted: comment.IsDeleted,
+			CreatedAt: comment.CreatedAt,
+		}
+		// Deleted comments only keep their place in the thread
+		if comment.IsDeleted {
+			item.Content = ""
+		}
+		b.Comments = append(b.Comments, item)
+	}
+
+	for _, auditLog := range export.AuditLogs {
+		b.AuditTrail = append(b.AuditTrail, bundle.AuditEntry{
+			Actor:      bundleActor(auditLog.User),
+			Action:     string(auditLog.Action),
+			EntityType: auditLog.EntityType,
+			EntityID:   auditLog.EntityID,
+			OldValue:   auditLog.OldValue,
+			NewValue:   auditLog.NewValue,
+			CreatedAt:  auditLog.CreatedAt,
+		})
+	}
+
+	var buffer bytes.Buffer
+	if err := bundle.Write(&buffer, b, s.signingKey); err != nil {
+		return nil, nil, err
+	}
+
+	s.caseRepo.CreateAuditLog(&model.AuditLog{
+		UserID:     user.ID,
+		Action:     model.ActionCreate,
+		EntityType: "case_export",
+		EntityID:   caseData.ID,
+		CaseID:     &caseData.ID,
+		NewValue: fmt.Sprintf("Case bundle exported with %d people, %d evidence items and %d comments",
+			len(b.People), len(b.Evidence), len(b.Comments)),
+	})
+	return buffer.Bytes(), caseData, nil
+}
+
+func bundlePerson(role string, person model.Person) bundle.Person {
+	return bundle.Person{
+		ID:          person.ID,
+		Role:        role,
+		FirstName:   person.FirstName,
+		LastName:    person.LastName,
+		Age:         person.Age,
+		Gender:      person.Gender,
+		Address:     person.Address,
+		PhoneNumber: person.PhoneNumber,
+		Notes:       person.Notes,
+		AddedBy:     bundleActor(person.AddedBy),
+		CreatedAt:   person.CreatedAt,
+	}
+}
+
+func (s *BundleService) readObject(objectName string) ([]byte, error) {
+	object, err := s.minioClient.GetObject(context.Background(), s.minioBucket, objectName, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	defer object.Close()
+	return io.ReadAll(object)
+}
+
+// bundleUsers maps the users of the exporting system to local users with the same
+// username. Users unknown here are replaced by the importing user.
+type bundleUsers struct {
+	userRepo *repository.UserRepository
+	importer uint
+	ids      map[string]uint
+	unknown  []string // names of the users that were replaced
+}
+
+func (u *bundleUsers) resolve(actor bundle.Actor) uint {
+	id, ok := u.ids[actor.Username]
+	if ok {
+		return id
+	}
+	if user, err := u.userRepo.GetByUsername(actor.Username); err == nil && actor.Username != "" {
+		id = user.ID
+	} else {
+		id = u.importer
+		u.unknown = append(u.unknown, actor.FullName)
+	}
+	u.ids[actor.Username] = id
+	return id
+}
+
+// ImportCase verifies a bundle against the signing key and the hashes of its manifest and
+// recreates the case under a new reference number, with new IDs for everything it contains
+func (s *BundleService) ImportCase(user *model.User, r io.ReaderAt, size int64) (*model.Case, error) {
+	b, err := bundle.Read(r, size, s.signingKey)
+	if err != nil {
+		if errors.Is(err, bundle.ErrSignature) || errors.Is(err, bundle.ErrInvalid) || errors.Is(err, bundle.ErrUnsupportedVersion) {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+		}
+		return nil, err
+	}
+
+	source := b.Case
+	caseData := &model.Case{
+		Name:               source.Name,
+		Description:        source.Description,
+		Area:               source.Area,
+		Address:            source.Address,
+		Latitude:           source.Latitude,
+		Longitude:          source.Longitude,
+		CaseType:           source.CaseType,
+		CustomFields:       model.CustomFields(source.CustomFields),
+		Status:             model.CaseStatus(source.Status),
+		Priority:           model.CasePriority(source.Priority),
+		AuthorizationLevel: model.ClearanceLevel(source.AuthorizationLevel),
+		Disposition:        model.CaseDisposition(source.Disposition),
+		ClosingSummary:     source.ClosingSummary,
+		FirstAssignedAt:    source.FirstAssignedAt,
+		FirstUpdatedAt:     source.FirstUpdatedAt,
+		ClosedAt:           source.ClosedAt,
+		SLAStatus:          model.SLAStatusOnTrack,
+	}
+	caseData.CreatedAt = source.CreatedAt
+	if caseData.CustomFields == nil {
+		caseData.CustomFields = model.CustomFields{}
+	}
+	if err := validateBundleCase(caseData); err != nil {
+		return nil, err
+	}
+	if !canAccessCase(user, caseData) {
+		return nil, ErrInsufficientClearance
+	}
+
+	users := &bundleUsers{userRepo: s.userRepo, importer: user.ID, ids: map[string]uint{}}
+	caseData.CreatedByID = users.resolve(source.CreatedBy)
+	data := &repository.CaseImport{Case: caseData}
+
+	for _, item := range b.People {
+		person := model.Person{
+			FirstName:   item.FirstName,
+			LastName:    item.LastName,
+			Age:         item.Age,
+			Gender:      item.Gender,
+			Address:     item.Address,
+			PhoneNumber: item.PhoneNumber,
+			Notes:       item.Notes,
+			AddedByID:   users.resolve(item.AddedBy),
+		}
+		person.ID = item.ID
+		person.CreatedAt = item.CreatedAt
+		switch item.Role {
+		case "suspect":
+			data.Suspects = append(data.Suspects, model.Suspect{Person: person, Description: item.Description, IsArrested: item.IsArrested})
+		case "victim":
+			data.Victims = append(data.Victims, model.Victim{Person: person, InjuryDescription: item.InjuryDescription})
+		case "witness":
+			data.Witnesses = append(data.Witnesses, model.Witness{Person: person, Statement: item.Statement})
+		default:
+			return nil, fmt.Errorf("%w: unknown person role %q", ErrInvalidInput, item.Role)
+		}
+	}
+
+	// Bundle paths of the images, by index in data.Evidence
+	objects := map[int]string{}
+	for _, item := range b.Evidence {
+		evidence := model.Evidence{
+			Type:       model.EvidenceType(item.Type),
+			Content:    item.Content,
+			Remarks:    item.Remarks,
+			AddedByID:  users.resolve(item.AddedBy),
+			ArchivedAt: item.ArchivedAt,
+		}
+		evidence.ID = item.ID
+		evidence.CreatedAt = item.CreatedAt
+		switch {
+		case evidence.Type == model.EvidenceTypeImage && item.Object != "":
+			objects[len(data.Evidence)] = item.Object
+		case evidence.Type != model.EvidenceTypeText:
+			return nil, fmt.Errorf("%w: evidence %d has no content of a known type", ErrInvalidInput, item.ID)
+		}
+		data.Evidence = append(data.Evidence, evidence)
+	}
+
+	for _, item := range b.Comments {
+		comment := model.Comment{
+			ParentID:  item.ParentID,
+			Content:   item.Content,
+			UserID:    users.resolve(item.Author),
+			EditedAt:  item.EditedAt,
+			IsDeleted: item.IsDeleted,
+		}
+		comment.ID = item.ID
+		comment.CreatedAt = item.CreatedAt
+		data.Comments = append(data.Comments, comment)
+	}
+
+	for _, item := range b.AuditTrail {
+		auditLog := &model.AuditLog{
+			UserID:     users.resolve(item.Actor),
+			Action:     model.ActionType(item.Action),
+			EntityType: item.EntityType,
+			EntityID:   item.EntityID,
+			OldValue:   item.OldValue,
+			NewValue:   item.NewValue,
+		}
+		auditLog.CreatedAt = item.CreatedAt
+		data.AuditLogs = append(data.AuditLogs, auditLog)
+	}
+
+	reference, err := s.caseService.nextReferenceNumber(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	caseData.ReferenceNumber = reference
+
+	var stored []string
+	err = s.bundleRepo.Import(data, func(caseID uint) error {
+		for i, path := range objects {
+			content := b.Objects[path]
+			objectName := fmt.Sprintf("evidence/%d/%s", caseID, uuid.New().String()+filepath.Ext(path))
+			_, err := s.minioClient.PutObject(
+				context.Background(),
+				s.minioBucket,
+				objectName,
+				bytes.NewReader(content),
+				int64(len(content)),
+				minio.PutObjectOptions{ContentType: mimetype.Detect(content).String()},
+			)
+			if err != nil {
+				return err
+			}
+			stored = append(stored, objectName)
+			data.Evidence[i].ImagePath = objectName
+		}
+		return nil
+	})
+	if err != nil {
+		// The case was rolled back, so the images it would have used are orphaned
+		for _, objectName := range stored {
+			s.minioClient.RemoveObject(context.Background(), s.minioBucket, objectName, minio.RemoveObjectOptions{})
+		}
+		return nil, err
+	}
+
+	s.caseRepo.CreateAuditLog(&model.AuditLog{
+		UserID:     user.ID,
+		Action:     model.ActionCreate,
+		EntityType: "case_import",
+		EntityID:   caseData.ID,
+		CaseID:     &caseData.ID,
+		NewValue:   importSummary(&b.Manifest, users.unknown),
+	})
+	return s.caseRepo.GetByID(caseData.ID)
+}
+
+func importSummary(manifest *bundle.Manifest, unknownUsers []string) string {
+	summary := fmt.Sprintf("Imported from case %s of %s, exported %s by %s",
+		manifest.Reference, manifest.Source, manifest.ExportedAt.Format(time.RFC3339), manifest.ExportedBy.FullName)
+	if len(unknownUsers) > 0 {
+		summary += fmt.Sprintf(". Records by users unknown here (%s) are attributed to the importer", strings.Join(unknownUsers, ", "))
+	}
+	return summary
+}
+
+// validateBundleCase checks the values a bundle from another system may not share with this one
+func validateBundleCase(caseData *model.Case) error {
+	if caseData.Name == "" || caseData.CaseType == "" {
+		return fmt.Errorf("%w: bundle case has no name or case type", ErrInvalidInput)
+	}
+	if !caseData.Status.IsValid() {
+		return fmt.Errorf("%w: unknown case status %q", ErrInvalidInput, caseData.Status)
+	}
+	if !caseData.Priority.IsValid() {
+		return fmt.Errorf("%w: unknown priority %q", ErrInvalidInput, caseData.Priority)
+	}
+	if util.ClearanceLevelToInt(caseData.AuthorizationLevel) == 0 {
+		return fmt.Errorf("%w: unknown authorization level %q", ErrInvalidInput, caseData.AuthorizationLevel)
+	}
+	if caseData.Disposition != "" && !caseData.Disposition.IsValid() {
+		return fmt.Errorf("%w: unknown disposition %q", ErrInvalidInput, caseData.Disposition)
+	}
+	return nil
+}
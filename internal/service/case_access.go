@@ -0,0 +1,33 @@
+package service
+
+import (
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"github.com/m7medVision/crime-management-system/internal/repository"
+	"github.com/m7medVision/crime-management-system/internal/util"
+)
+
+// canAccessCase reports whether the user's clearance covers the case's authorization level
+func canAccessCase(user *model.User, caseData *model.Case) bool {
+	return util.IsClearnceLevelHigherOrEqual(user.ClearanceLevel, caseData.AuthorizationLevel)
+}
+
+// getAccessibleCase loads a case and checks the user's clearance against it
+func getAccessibleCase(caseRepo *repository.CaseRepository, user *model.User, caseID uint) (*model.Case, error) {
+	caseData, err := caseRepo.GetByID(caseID)
+	if err != nil {
+		return nil, ErrCaseNotFound
+	}
+	if !canAccessCase(user, caseData) {
+		return nil, ErrInsufficientClearance
+	}
+	return caseData, nil
+}
+
+// resolveCaseID accepts a numeric case ID or a case reference number
+func resolveCaseID(caseRepo *repository.CaseRepository, idOrReference string) (uint, error) {
+	id, err := caseRepo.ResolveID(idOrReference)
+	if err != nil {
+		return 0, ErrCaseNotFound
+	}
+	return id, nil
+}
@@ -0,0 +1,248 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"github.com/m7medVision/crime-management-system/internal/repository"
+	"github.com/m7medVision/crime-management-system/internal/util"
+)
+
+// RelatedCase is a link seen from the perspective of one of the linked cases
+type RelatedCase struct {
+	Link     model.CaseLink
+	LinkType model.CaseLinkType
+	Case     model.Case
+}
+
+type CaseLinkService struct {
+	caseRepo     *repository.CaseRepository
+	caseLinkRepo *repository.CaseLinkRepository
+	reconciler   *AssignmentReconciler
+}
+
+func NewCaseLinkService(caseRepo *repository.CaseRepository, caseLinkRepo *repository.CaseLinkRepository, reconciler *AssignmentReconciler) *CaseLinkService {
+	return &CaseLinkService{
+		caseRepo:     caseRepo,
+		caseLinkRepo: caseLinkRepo,
+		reconciler:   reconciler,
+	}
+}
+
+func isValidLinkType(linkType model.CaseLinkType) bool {
+	switch linkType {
+	case model.LinkRelated, model.LinkDuplicateOf, model.LinkDuplicateBy,
+		model.LinkParentOf, model.LinkChildOf, model.LinkSameSuspect:
+		return true
+	}
+	return false
+}
+
+func (s *CaseLinkService) LinkCases(user *model.User, caseID, targetCaseID uint, linkType model.CaseLinkType, notes string) (*model.CaseLink, error) {
+	if !isValidLinkType(linkType) {
+		return nil, fmt.Errorf("%w: unknown link type %q", ErrInvalidInput, linkType)
+	}
+	if caseID == targetCaseID {
+		return nil, fmt.Errorf("%w: a case cannot be linked to itself", ErrInvalidInput)
+	}
+
+	if _, err := getAccessibleCase(s.caseRepo, user, caseID); err != nil {
+		return nil, err
+	}
+	if _, err := getAccessibleCase(s.caseRepo, user, targetCaseID); err != nil {
+		return nil, err
+	}
+
+	// Store inverse types in canonical direction
+	sourceID, targetID := caseID, targetCaseID
+	if linkType == model.LinkChildOf || linkType == model.LinkDuplicateBy {
+		sourceID, targetID = targetID, sourceID
+		linkType = linkType.Inverse()
+	}
+
+	exists, err := s.caseLinkRepo.Exists(sourceID, targetID, linkType)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, fmt.Errorf("%w: cases are already linked as %s", ErrConflict, linkType)
+	}
+
+	link := &model.CaseLink{
+		SourceCaseID: sourceID,
+		TargetCaseID: targetID,
+		LinkType:     linkType,
+		Notes:        notes,
+		CreatedByID:  user.ID,
+	}
+	if err := s.caseLinkRepo.Create(link); err != nil {
+		return nil, err
+	}
+
+	s.caseRepo.CreateAuditLog(&model.AuditLog{
+		UserID:     user.ID,
+		Action:     model.ActionCreate,
+		EntityType: "case_link",
+		EntityID:   link.ID,
+		CaseID:     &caseID,
+		NewValue:   fmt.Sprintf("Case %d linked to case %d as %s", sourceID, targetID, linkType),
+	})
+
+	return link, nil
+}
+
+// ListRelatedCases returns all cases linked to the given case that the user is cleared to see
+func (s *CaseLinkService) ListRelatedCases(user *model.User, caseID uint) ([]RelatedCase, error) {
+	if _, err := getAccessibleCase(s.caseRepo, user, caseID); err != nil {
+		return nil, err
+	}
+
+	links, err := s.caseLinkRepo.ListByCaseID(caseID)
+	if err != nil {
+		return nil, err
+	}
+
+	related := make([]RelatedCase, 0, len(links))
+	for _, link := range links {
+		item := RelatedCase{Link: link, LinkType: link.LinkType, Case: link.TargetCase}
+		if link.TargetCaseID == caseID {
+			item.LinkType = link.LinkType.Inverse()
+			item.Case = link.SourceCase
+		}
+		if !canAccessCase(user, &item.Case) {
+			continue
+		}
+		related = append(related, item)
+	}
+	return related, nil
+}
+
+func (s *CaseLinkService) UnlinkCases(user *model.User, caseID, linkID uint) error {
+	if _, err := getAccessibleCase(s.caseRepo, user, caseID); err != nil {
+		return err
+	}
+
+	link, err := s.caseLinkRepo.GetByID(linkID)
+	if err != nil || (link.SourceCaseID != caseID && link.TargetCaseID != caseID) {
+		return fmt.Errorf("%w: link not found", ErrNotFound)
+	}
+
+	if err := s.caseLinkRepo.Delete(linkID); err != nil {
+		return err
+	}
+
+	s.caseRepo.CreateAuditLog(&model.AuditLog{
+		UserID:     user.ID,
+		Action:     model.ActionDelete,
+		EntityType: "case_link",
+		EntityID:   link.ID,
+		CaseID:     &caseID,
+		OldValue:   fmt.Sprintf("Case %d linked to case %d as %s", link.SourceCaseID, link.TargetCaseID, link.LinkType),
+	})
+
+	return nil
+}
+
+// MergeCases folds the source case into the surviving target case. The source case
+// is closed and keeps a redirect to the target.
+func (s *CaseLinkService) MergeCases(user *model.User, sourceID, targetID uint, ipAddress string) (*model.Case, error) {
+	if sourceID == targetID {
+		return nil, fmt.Errorf("%w: a case cannot be merged into itself", ErrInvalidInput)
+	}
+
+	source, err := getAccessibleCase(s.caseRepo, user, sourceID)
+	if err != nil {
+		return nil, err
+	}
+	target, err := getAccessibleCase(s.caseRepo, user, targetID)
+	if err != nil {
+		return nil, err
+	}
+	if source.MergedIntoID != nil {
+		return nil, fmt.Errorf("%w: case %d has already been merged", ErrConflict, sourceID)
+	}
+	if target.MergedIntoID != nil {
+		return nil, fmt.Errorf("%w: case %d has been merged into case %d", ErrConflict, targetID, *target.MergedIntoID)
+	}
+	// Everything on the source becomes visible to whoever may open the target
+	if !util.IsClearnceLevelHigherOrEqual(target.AuthorizationLevel, source.AuthorizationLevel) {
+		return nil, fmt.Errorf("%w: case %s has a higher authorization level than case %s, raise the level of %s first",
+			ErrConflict, source.ReferenceNumber, target.ReferenceNumber, target.ReferenceNumber)
+	}
+
+	var link *model.CaseLink
+	exists, err := s.caseLinkRepo.Exists(sourceID, targetID, model.LinkDuplicateOf)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		link = &model.CaseLink{
+			SourceCaseID: sourceID,
+			TargetCaseID: targetID,
+			LinkType:     model.LinkDuplicateOf,
+			Notes:        "Created by case merge",
+			CreatedByID:  user.ID,
+		}
+	}
+
+	auditLogs := []*model.AuditLog{
+		{
+			UserID:     user.ID,
+			Action:     model.ActionMerge,
+			EntityType: "case",
+			EntityID:   sourceID,
+			CaseID:     &sourceID,
+			OldValue:   string(source.Status),
+			NewValue:   fmt.Sprintf("Case %s merged into case %s", source.ReferenceNumber, target.ReferenceNumber),
+			IPAddress:  ipAddress,
+		},
+		{
+			UserID:     user.ID,
+			Action:     model.ActionMerge,
+			EntityType: "case",
+			EntityID:   targetID,
+			CaseID:     &targetID,
+			NewValue:   fmt.Sprintf("Case %s merged into this case", source.ReferenceNumber),
+			IPAddress:  ipAddress,
+		},
+	}
+
+	// An open source case is closed by the merge, with a closure record saying why
+	var closure *model.CaseClosure
+	if source.Status != model.StatusClosed {
+		now := time.Now()
+		closure = &model.CaseClosure{
+			CaseID:        sourceID,
+			Disposition:   model.DispositionMerged,
+			Summary:       fmt.Sprintf("Merged into case %s", target.ReferenceNumber),
+			Status:        model.ClosureApproved,
+			RequestedByID: user.ID,
+			DecidedByID:   &user.ID,
+			DecidedAt:     &now,
+		}
+	}
+
+	merge := repository.CaseMerge{
+		Link:        link,
+		Closure:     closure,
+		DecidedByID: user.ID,
+		Response:    fmt.Sprintf("Case was merged into case %s", target.ReferenceNumber),
+		AuditLogs:   auditLogs,
+	}
+	// Moved assignees who are not cleared for the target are reconciled in the merge transaction
+	err = s.reconciler.ForMerge(sourceID, target, user.ID, func(changes []repository.AssignmentChange) error {
+		merge.AssigneeChanges = changes
+		return s.caseRepo.Merge(sourceID, targetID, merge)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.caseRepo.GetByID(targetID)
+}
+
+// ResolveCaseID accepts a numeric case ID or a case reference number
+func (s *CaseLinkService) ResolveCaseID(idOrReference string) (uint, error) {
+	return resolveCaseID(s.caseRepo, idOrReference)
+}
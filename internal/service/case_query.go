@@ -0,0 +1,110 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/m7medVision/crime-management-system/internal/geo"
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"github.com/m7medVision/crime-management-system/internal/repository"
+)
+
+// caseSortColumns maps the sort keys of the case list to SQL expressions
+var caseSortColumns = map[string]string{
+	"createdAt":       "created_at",
+	"updatedAt":       "updated_at",
+	"name":            "name",
+	"referenceNumber": "reference_number",
+	"status":          "status",
+	"slaDueAt":        "sla_due_at",
+	"priority":        "CASE priority WHEN 'critical' THEN 4 WHEN 'high' THEN 3 WHEN 'medium' THEN 2 ELSE 1 END",
+}
+
+// CaseQuery is a case list query as accepted by GET /cases and stored by saved views
+type CaseQuery struct {
+	Filter       repository.CaseFilter
+	Tags         TagQuery
+	CustomFields map[string]string // custom field filters given as field.<key>=value
+}
+
+// ParseCaseQuery reads the filters and sort order of the case list from query parameters
+func ParseCaseQuery(values url.Values) (CaseQuery, error) {
+	filter := repository.CaseFilter{
+		Search:      values.Get("search"),
+		Priority:    model.CasePriority(values.Get("priority")),
+		SLAStatus:   model.SLAStatus(values.Get("slaStatus")),
+		Status:      model.CaseStatus(values.Get("status")),
+		Disposition: model.CaseDisposition(values.Get("disposition")),
+		Area:        values.Get("area"),
+		CaseType:    values.Get("caseType"),
+	}
+	query := CaseQuery{Filter: filter, Tags: ParseTagQuery(values), CustomFields: map[string]string{}}
+	if filter.Priority != "" && !filter.Priority.IsValid() {
+		return query, errors.New("Invalid priority")
+	}
+	if filter.Status != "" && !filter.Status.IsValid() {
+		return query, errors.New("Invalid status")
+	}
+	if filter.SLAStatus != "" && !filter.SLAStatus.IsValid() {
+		return query, errors.New("Invalid SLA status")
+	}
+	if filter.Disposition != "" && !filter.Disposition.IsValid() {
+		return query, errors.New("Invalid disposition")
+	}
+
+	if bbox := values.Get("bbox"); bbox != "" {
+		box, err := geo.ParseBoundingBox(bbox)
+		if err != nil {
+			return query, fmt.Errorf("Invalid bbox: %v", err)
+		}
+		query.Filter.BoundingBox = &box
+	}
+	if near := values.Get("near"); near != "" {
+		point, err := geo.ParsePoint(near)
+		if err != nil {
+			return query, fmt.Errorf("Invalid near: %v", err)
+		}
+		radius := 5.0
+		if text := values.Get("radius"); text != "" {
+			radius, err = strconv.ParseFloat(text, 64)
+		}
+		if err != nil || radius <= 0 || radius > 1000 {
+			return query, errors.New("Invalid radius, expected km between 0 and 1000")
+		}
+		query.Filter.Near = &point
+		query.Filter.RadiusKm = radius
+	}
+
+	// sort=createdAt sorts ascending, sort=-createdAt descending
+	if sort := values.Get("sort"); sort != "" {
+		key, descending := strings.CutPrefix(sort, "-")
+		column, ok := caseSortColumns[key]
+		if !ok {
+			return query, fmt.Errorf("Invalid sort %q", key)
+		}
+		direction := "ASC"
+		if descending {
+			direction = "DESC"
+		}
+		query.Filter.OrderBy = column + " " + direction + ", id " + direction
+	}
+
+	for name, fieldValues := range values {
+		if key, ok := strings.CutPrefix(name, "field."); ok && key != "" && len(fieldValues) > 0 {
+			query.CustomFields[key] = fieldValues[0]
+		}
+	}
+	return query, nil
+}
+
+// ParseTagQuery reads the tag filter shared by list endpoints
+func ParseTagQuery(values url.Values) TagQuery {
+	query := TagQuery{MatchAny: values.Get("tagMatch") == "any"}
+	if tags := values.Get("tags"); tags != "" {
+		query.Names = strings.Split(tags, ",")
+	}
+	return query
+}
@@ -2,87 +2,604 @@ package service
 
 import (
 	"errors"
+	"fmt"
+	"log"
+	"time"
 
+	"github.com/m7medVision/crime-management-system/internal/config"
+	"github.com/m7medVision/crime-management-system/internal/geo"
 	"github.com/m7medVision/crime-management-system/internal/model"
 	"github.com/m7medVision/crime-management-system/internal/repository"
 	"github.com/m7medVision/crime-management-system/internal/util"
+	"gorm.io/gorm"
 )
 
 type CaseService struct {
-	caseRepo *repository.CaseRepository
-	userRepo *repository.UserRepository
+	caseRepo   *repository.CaseRepository
+	userRepo   *repository.UserRepository
+	tagRepo    *repository.TagRepository
+	schemaRepo *repository.CustomFieldSchemaRepository
+	notifier   *NotificationService
+	reconciler *AssignmentReconciler
+	caseConfig config.CaseConfig
+	geocoder   geo.Geocoder
 }
 
-func NewCaseService(caseRepo *repository.CaseRepository, userRepo *repository.UserRepository) *CaseService {
+func NewCaseService(
+	caseRepo *repository.CaseRepository,
+	userRepo *repository.UserRepository,
+	tagRepo *repository.TagRepository,
+	schemaRepo *repository.CustomFieldSchemaRepository,
+	notifier *NotificationService,
+	reconciler *AssignmentReconciler,
+	caseConfig config.CaseConfig,
+	geocoder geo.Geocoder,
+) *CaseService {
 	return &CaseService{
-		caseRepo: caseRepo,
-		userRepo: userRepo,
+		caseRepo:   caseRepo,
+		userRepo:   userRepo,
+		tagRepo:    tagRepo,
+		schemaRepo: schemaRepo,
+		notifier:   notifier,
+		reconciler: reconciler,
+		caseConfig: caseConfig,
+		geocoder:   geocoder,
 	}
 }
 
+// validateCustomFields checks the custom field values of a case against the schema of its case type
+func (s *CaseService) validateCustomFields(caseData *model.Case) error {
+	schema, err := getCustomFieldSchema(s.schemaRepo, caseData.CaseType)
+	if err != nil {
+		return err
+	}
+	if caseData.CustomFields == nil {
+		caseData.CustomFields = model.CustomFields{}
+	}
+	return validateCustomFields(schema, caseData.CustomFields)
+}
+
+// locate validates the given coordinates, or geocodes the address (falling back to
+// the area or location text) when there are none. Geocoding is best effort.
+func (s *CaseService) locate(latitude, longitude **float64, address, fallback string) error {
+	if (*latitude == nil) != (*longitude == nil) {
+		return fmt.Errorf("%w: latitude and longitude must be given together", ErrInvalidInput)
+	}
+	if *latitude != nil {
+		if !(geo.Point{Latitude: **latitude, Longitude: **longitude}).Valid() {
+			return fmt.Errorf("%w: coordinates out of range", ErrInvalidInput)
+		}
+		return nil
+	}
+
+	if s.geocoder == nil {
+		return nil
+	}
+	query := address
+	if query == "" {
+		query = fallback
+	}
+	location, err := s.geocoder.Geocode(query)
+	if err != nil {
+		return nil
+	}
+	lat, lon := location.Latitude, location.Longitude
+	*latitude, *longitude = &lat, &lon
+	return nil
+}
+
 func (s *CaseService) CreateCase(caseData *model.Case) (*model.Case, error) {
-	return nil, s.caseRepo.Create(caseData)
+	return s.CreateCaseWithSetup(caseData, repository.CaseSetup{})
+}
+
+// CreateCaseWithSetup creates a case together with tasks, tags or a report link
+func (s *CaseService) CreateCaseWithSetup(caseData *model.Case, setup repository.CaseSetup) (*model.Case, error) {
+	if caseData.Priority == "" {
+		caseData.Priority = model.PriorityMedium
+	}
+	caseData.SLAStatus = model.SLAStatusOnTrack
+
+	if err := s.validateCustomFields(caseData); err != nil {
+		return nil, err
+	}
+	if err := s.locate(&caseData.Latitude, &caseData.Longitude, caseData.Address, caseData.Area); err != nil {
+		return nil, err
+	}
+
+	reference, err := s.nextReferenceNumber(time.Now())
+	if err != nil {
+		return nil, err
+	}
+	caseData.ReferenceNumber = reference
+
+	if err := s.caseRepo.CreateWithSetup(caseData, setup); err != nil {
+		return nil, err
+	}
+
+	s.autoAssign(caseData)
+	return caseData, nil
+}
+
+// nextReferenceNumber issues the next reference number for the year of the given time
+func (s *CaseService) nextReferenceNumber(at time.Time) (string, error) {
+	year := at.Year()
+	seq, err := s.caseRepo.NextReferenceSequence(util.CaseReferenceScope(s.caseConfig.AreaCode, year))
+	if err != nil {
+		return "", fmt.Errorf("failed to generate reference number: %w", err)
+	}
+	return util.FormatCaseReference(s.caseConfig.ReferenceFormat, s.caseConfig.AreaCode, year, seq, s.caseConfig.ReferenceDigits), nil
 }
 
-func (s *CaseService) UpdateCase(caseData *model.Case) (*model.Case, error) {
-	return nil, s.caseRepo.Update(caseData)
+// BackfillReferenceNumbers assigns reference numbers to cases created before they were introduced
+func (s *CaseService) BackfillReferenceNumbers() error {
+	cases, err := s.caseRepo.ListWithoutReferenceNumber()
+	if err != nil {
+		return err
+	}
+
+	for _, cas := range cases {
+		reference, err := s.nextReferenceNumber(cas.CreatedAt)
+		if err != nil {
+			return err
+		}
+		if err := s.caseRepo.SetReferenceNumber(cas.ID, reference); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ResolveCaseID accepts a numeric case ID or a case reference number
+func (s *CaseService) ResolveCaseID(idOrReference string) (uint, error) {
+	return resolveCaseID(s.caseRepo, idOrReference)
+}
+
+// CaseChanges holds the case fields to update; nil fields are left unchanged
+type CaseChanges struct {
+	Name               *string
+	Description        *string
+	Area               *string
+	CaseType           *string
+	AuthorizationLevel *model.ClearanceLevel
+	Priority           *model.CasePriority
+	Address            *string
+	Latitude           *float64
+	Longitude          *float64
+	CustomFields       model.CustomFields // replaces all custom field values when not nil
+}
+
+// UpdateCase writes only the fields that changed. When expectedVersion is given the
+// update fails with ErrPreconditionFailed unless the case is still at that version.
+func (s *CaseService) UpdateCase(user *model.User, caseID uint, changes CaseChanges, expectedVersion *int) (*model.Case, error) {
+	caseData, err := getAccessibleCase(s.caseRepo, user, caseID)
+	if err != nil {
+		return nil, err
+	}
+	if expectedVersion != nil && *expectedVersion != caseData.Version {
+		return nil, fmt.Errorf("%w: case has been modified, it is now at version %d", ErrPreconditionFailed, caseData.Version)
+	}
+
+	fields := map[string]interface{}{}
+	setString := func(column string, current *string, value *string) bool {
+		if value == nil || *value == *current {
+			return false
+		}
+		*current = *value
+		fields[column] = *value
+		return true
+	}
+
+	setString("name", &caseData.Name, changes.Name)
+	setString("description", &caseData.Description, changes.Description)
+	areaChanged := setString("area", &caseData.Area, changes.Area)
+	addressChanged := setString("address", &caseData.Address, changes.Address)
+	caseTypeChanged := setString("case_type", &caseData.CaseType, changes.CaseType)
+
+	if level := changes.AuthorizationLevel; level != nil && *level != caseData.AuthorizationLevel {
+		if util.ClearanceLevelToInt(*level) == 0 {
+			return nil, fmt.Errorf("%w: invalid authorization level %q", ErrInvalidInput, *level)
+		}
+		if !util.IsClearnceLevelHigherOrEqual(user.ClearanceLevel, *level) {
+			return nil, fmt.Errorf("%w: cannot raise a case above your own clearance", ErrInsufficientClearance)
+		}
+		caseData.AuthorizationLevel = *level
+		fields["authorization_level"] = *level
+	}
+	if priority := changes.Priority; priority != nil && *priority != caseData.Priority {
+		if !priority.IsValid() {
+			return nil, fmt.Errorf("%w: invalid priority %q", ErrInvalidInput, *priority)
+		}
+		caseData.Priority = *priority
+		fields["priority"] = *priority
+		// The case is measured against another policy, a breach of which escalates again
+		caseData.EscalatedAt = nil
+		fields["escalated_at"] = nil
+	}
+
+	if changes.CustomFields != nil {
+		caseData.CustomFields = changes.CustomFields
+	}
+	if changes.CustomFields != nil || caseTypeChanged {
+		if err := s.validateCustomFields(caseData); err != nil {
+			return nil, err
+		}
+		fields["custom_fields"] = caseData.CustomFields
+	}
+
+	// Coordinates are geocoded again when the place changes and none are given
+	if changes.Latitude != nil || changes.Longitude != nil || areaChanged || addressChanged {
+		latitude, longitude := changes.Latitude, changes.Longitude
+		if err := s.locate(&latitude, &longitude, caseData.Address, caseData.Area); err != nil {
+			return nil, err
+		}
+		caseData.Latitude, caseData.Longitude = latitude, longitude
+		fields["latitude"], fields["longitude"] = latitude, longitude
+	}
+
+	if len(fields) == 0 {
+		return caseData, nil
+	}
+	if caseData.FirstUpdatedAt == nil {
+		markFirstUpdate(caseData)
+		fields["first_updated_at"] = caseData.FirstUpdatedAt
+	}
+	if err := s.caseRepo.UpdateFields(caseData, fields); err != nil {
+		return nil, versionConflict(err, expectedVersion)
+	}
+
+	if _, ok := fields["authorization_level"]; ok {
+		if _, err := s.reconciler.ForCase(caseData.ID, caseData.AuthorizationLevel, user.ID, false); err != nil {
+			log.Printf("Failed to reconcile assignees of case %d: %v", caseData.ID, err)
+		}
+	}
+	return caseData, nil
+}
+
+// PreviewAuthorizationChange reports which assignees would lose access if the case
+// were moved to the given authorization level, without changing anything
+func (s *CaseService) PreviewAuthorizationChange(user *model.User, caseID uint, level model.ClearanceLevel) (*ReconciliationReport, error) {
+	caseData, err := getAccessibleCase(s.caseRepo, user, caseID)
+	if err != nil {
+		return nil, err
+	}
+	if level == "" {
+		level = caseData.AuthorizationLevel
+	}
+	return s.reconciler.ForCase(caseID, level, user.ID, true)
+}
+
+// ReconcileAssignees applies the reconciliation for the case's current authorization level
+func (s *CaseService) ReconcileAssignees(user *model.User, caseID uint) (*ReconciliationReport, error) {
+	caseData, err := getAccessibleCase(s.caseRepo, user, caseID)
+	if err != nil {
+		return nil, err
+	}
+	return s.reconciler.ForCase(caseID, caseData.AuthorizationLevel, user.ID, false)
+}
+
+// UpdateCaseStatus changes the status of a case and records the change in the case history.
+// When expectedVersion is given the case must still be at that version.
+func (s *CaseService) UpdateCaseStatus(caseData *model.Case, userID uint, status model.CaseStatus, expectedVersion *int) (*model.Case, error) {
+	if status == model.StatusClosed && caseData.Status != model.StatusClosed {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, errClosureRequired)
+	}
+	if caseData.Status == model.StatusClosed && status != model.StatusClosed {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidInput, errReopenRequired)
+	}
+	if expectedVersion != nil && *expectedVersion != caseData.Version {
+		return nil, fmt.Errorf("%w: case has been modified, it is now at version %d", ErrPreconditionFailed, caseData.Version)
+	}
+
+	oldStatus := caseData.Status
+	if err := s.caseRepo.UpdateFields(caseData, setCaseStatus(caseData, status)); err != nil {
+		return nil, versionConflict(err, expectedVersion)
+	}
+
+	if oldStatus != status {
+		caseID := caseData.ID
+		s.caseRepo.CreateAuditLog(&model.AuditLog{
+			UserID:     userID,
+			Action:     model.ActionUpdate,
+			EntityType: "case_status",
+			EntityID:   caseID,
+			CaseID:     &caseID,
+			OldValue:   string(oldStatus),
+			NewValue:   string(status),
+		})
+		notifyStatusChanged(s.notifier, caseData, userID, oldStatus)
+	}
+
+	return caseData, nil
+}
+
+// notifyStatusChanged tells the assignees and watchers of a case that its status changed
+func notifyStatusChanged(notifier *NotificationService, caseData *model.Case, actorID uint, oldStatus model.CaseStatus) {
+	notifier.NotifyCaseEvent(
+		caseData,
+		model.NotificationStatusChanged,
+		fmt.Sprintf("Case %s is now %s", caseData.ReferenceNumber, caseData.Status),
+		fmt.Sprintf("Status changed from %s to %s", oldStatus, caseData.Status),
+		actorID,
+	)
+}
+
+// notifyAssigneeAdded tells the new assignee, the other assignees and the watchers of a case about an assignment
+func notifyAssigneeAdded(notifier *NotificationService, caseData *model.Case, actorID uint, assignee *model.User) {
+	notifier.NotifyCaseEvent(
+		caseData,
+		model.NotificationAssigneeAdded,
+		fmt.Sprintf("%s assigned to case %s", assignee.FullName, caseData.ReferenceNumber),
+		caseData.Name,
+		actorID,
+	)
+}
+
+// setCaseStatus changes the status, keeps the SLA timestamps in step and returns the changed columns
+func setCaseStatus(caseData *model.Case, status model.CaseStatus) map[string]interface{} {
+	caseData.Status = status
+	markFirstUpdate(caseData)
+	if status == model.StatusClosed && caseData.ClosedAt == nil {
+		now := time.Now()
+		caseData.ClosedAt = &now
+	} else if status != model.StatusClosed {
+		caseData.ClosedAt = nil
+		caseData.Disposition = ""
+		caseData.ClosingSummary = ""
+	}
+	return map[string]interface{}{
+		"status":           caseData.Status,
+		"closed_at":        caseData.ClosedAt,
+		"disposition":      caseData.Disposition,
+		"closing_summary":  caseData.ClosingSummary,
+		"first_updated_at": caseData.FirstUpdatedAt,
+	}
+}
+
+// markFirstUpdate records the first time a case was worked on, used for SLA tracking
+func markFirstUpdate(caseData *model.Case) {
+	if caseData.FirstUpdatedAt == nil {
+		now := time.Now()
+		caseData.FirstUpdatedAt = &now
+	}
 }
 
 func (s *CaseService) GetCaseByID(caseID uint) (*model.Case, error) {
 	return s.caseRepo.GetByID(caseID)
 }
 
-func (s *CaseService) ListCases(offset, limit int, search string) ([]model.Case, int64, error) {
-	return s.caseRepo.List(offset, limit, search)
+// ListCases returns a page of cases. Custom field filters are given as text and
+// require a case type filter, whose schema defines how they are compared.
+func (s *CaseService) ListCases(user *model.User, offset, limit int, filter repository.CaseFilter, tags TagQuery, customFields map[string]string) ([]model.Case, int64, error) {
+	var err error
+	if filter.Tags, err = resolveTagFilter(s.tagRepo, user, tags); err != nil {
+		return nil, 0, err
+	}
+	if len(customFields) > 0 {
+		if filter.CaseType == "" {
+			return nil, 0, fmt.Errorf("%w: filtering by custom fields requires a case type", ErrInvalidInput)
+		}
+		schema, err := getCustomFieldSchema(s.schemaRepo, filter.CaseType)
+		if err != nil {
+			return nil, 0, err
+		}
+		if schema == nil {
+			return nil, 0, fmt.Errorf("%w: case type %q has no custom fields", ErrInvalidInput, filter.CaseType)
+		}
+		if filter.CustomFields, err = parseCustomFieldFilter(schema, customFields); err != nil {
+			return nil, 0, err
+		}
+	}
+	return s.caseRepo.List(offset, limit, filter)
 }
 
 func (s *CaseService) GetAssignees(caseID uint) ([]model.User, error) {
 	return s.caseRepo.GetAssignees(caseID)
 }
 
-func (s *CaseService) AddAssignee(caseID, userID uint) error {
+// ListAssignments returns the assignees of a case with their roles
+func (s *CaseService) ListAssignments(caseID uint) ([]repository.Assignment, error) {
+	return s.caseRepo.ListAssignments(caseID)
+}
+
+// AddAssignee assigns a user to a case in the given role, or changes the role of
+// someone already assigned. A case has at most one lead.
+func (s *CaseService) AddAssignee(caseID, userID uint, role model.AssignmentRole, assignedByID uint) error {
+	if role == "" {
+		role = model.AssignmentSupport
+	}
+	if !role.IsValid() {
+		return fmt.Errorf("%w: invalid assignment role %q", ErrInvalidInput, role)
+	}
+
 	user, err := s.userRepo.GetByID(userID)
 	if err != nil {
-		return err
+		return fmt.Errorf("%w: user not found", ErrNotFound)
 	}
 
 	caseData, err := s.caseRepo.GetByID(caseID)
 	if err != nil {
-		return err
+		return ErrCaseNotFound
 	}
 
 	if !util.IsClearnceLevelHigherOrEqual(user.ClearanceLevel, caseData.AuthorizationLevel) {
-		return errors.New("insufficient clearance level")
+		return fmt.Errorf("%w: %s is not cleared for this case", ErrInsufficientClearance, user.FullName)
+	}
+
+	if role == model.AssignmentLead {
+		lead, err := s.caseRepo.GetLead(caseID)
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		if lead != nil && lead.UserID != userID {
+			return fmt.Errorf("%w: case already has a lead, change their role first", ErrConflict)
+		}
+	}
+
+	existing, err := s.caseRepo.GetAssignment(caseID, userID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+	if existing != nil {
+		if existing.Role == role {
+			return nil
+		}
+		if err := s.caseRepo.UpdateAssigneeRole(caseID, userID, role); err != nil {
+			return err
+		}
+		s.caseRepo.CreateAuditLog(&model.AuditLog{
+			UserID:     assignedByID,
+			Action:     model.ActionUpdate,
+			EntityType: "case_assignee",
+			EntityID:   userID,
+			CaseID:     &caseID,
+			OldValue:   fmt.Sprintf("%s as %s", user.FullName, existing.Role),
+			NewValue:   fmt.Sprintf("%s as %s", user.FullName, role),
+		})
+		return nil
+	}
+
+	if err := s.caseRepo.AddAssignee(caseID, userID, role, &assignedByID); err != nil {
+		return err
+	}
+	if err := s.caseRepo.MarkFirstAssigned(caseID, time.Now()); err != nil {
+		return err
+	}
+
+	s.caseRepo.CreateAuditLog(&model.AuditLog{
+		UserID:     assignedByID,
+		Action:     model.ActionCreate,
+		EntityType: "case_assignee",
+		EntityID:   userID,
+		CaseID:     &caseID,
+		NewValue:   fmt.Sprintf("%s assigned to case as %s", user.FullName, role),
+	})
+	notifyAssigneeAdded(s.notifier, caseData, assignedByID, user)
+	return nil
+}
+
+// GetWorkload returns the open case load of active investigators, optionally limited to one area
+func (s *CaseService) GetWorkload(area string) ([]repository.Workload, error) {
+	return s.caseRepo.ListWorkload(repository.WorkloadFilter{Area: area})
+}
+
+// Auto-assignment strategies for new cases, set with CASE_AUTO_ASSIGN
+const (
+	AutoAssignRoundRobin  = "round_robin"
+	AutoAssignLeastLoaded = "least_loaded"
+)
+
+// autoAssign makes an active investigator from the area of a new case its lead.
+// Round robin picks the investigator who has gone longest without an assignment,
+// least loaded the one with the fewest open cases. Only investigators cleared for
+// the case are considered, and failures leave the case unassigned.
+func (s *CaseService) autoAssign(caseData *model.Case) {
+	strategy := s.caseConfig.AutoAssign
+	if strategy != AutoAssignRoundRobin && strategy != AutoAssignLeastLoaded {
+		return
+	}
+
+	workload, err := s.caseRepo.ListWorkload(repository.WorkloadFilter{Area: caseData.Area})
+	if err != nil {
+		log.Printf("Failed to auto-assign case %d: %v", caseData.ID, err)
+		return
+	}
+
+	// The workload is ordered least loaded first
+	var pick *repository.Workload
+	for i := range workload {
+		candidate := &workload[i]
+		if !util.IsClearnceLevelHigherOrEqual(candidate.ClearanceLevel, caseData.AuthorizationLevel) {
+			continue
+		}
+		if pick == nil || (strategy == AutoAssignRoundRobin && assignedEarlier(candidate.LastAssignedAt, pick.LastAssignedAt)) {
+			pick = candidate
+		}
+	}
+	if pick == nil {
+		log.Printf("No investigator in area %q is available to auto-assign case %d", caseData.Area, caseData.ID)
+		return
 	}
 
-	return s.caseRepo.AddAssignee(caseID, userID)
+	if err := s.AddAssignee(caseData.ID, pick.UserID, model.AssignmentLead, caseData.CreatedByID); err != nil {
+		log.Printf("Failed to auto-assign case %d to user %d: %v", caseData.ID, pick.UserID, err)
+	}
 }
 
-func (s *CaseService) RemoveAssignee(caseID, userID uint) error {
-	return s.caseRepo.RemoveAssignee(caseID, userID)
+// assignedEarlier reports whether a was assigned before b, never being assigned counting as earliest
+func assignedEarlier(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == nil && b != nil
+	}
+	return a.Before(*b)
+}
+
+func (s *CaseService) RemoveAssignee(caseID, userID, removedByID uint) error {
+	if err := s.caseRepo.RemoveAssignee(caseID, userID); err != nil {
+		return err
+	}
+
+	oldValue := fmt.Sprintf("User %d unassigned from case", userID)
+	if user, err := s.userRepo.GetByID(userID); err == nil {
+		oldValue = fmt.Sprintf("%s unassigned from case", user.FullName)
+	}
+	s.caseRepo.CreateAuditLog(&model.AuditLog{
+		UserID:     removedByID,
+		Action:     model.ActionDelete,
+		EntityType: "case_assignee",
+		EntityID:   userID,
+		CaseID:     &caseID,
+		OldValue:   oldValue,
+	})
+	return nil
+}
+
+// ListGeolocatedCases returns cases with coordinates that the user is cleared to see
+func (s *CaseService) ListGeolocatedCases(user *model.User, filter repository.CaseFilter, limit int) ([]model.Case, error) {
+	// Never leave the list nil, which would disable the clearance filter
+	filter.AllowedLevels = append([]model.ClearanceLevel{}, util.ClearanceLevelsUpTo(user.ClearanceLevel)...)
+	return s.caseRepo.ListGeolocated(filter, limit)
 }
 
 func (s *CaseService) SubmitCrimeReport(report *model.Report) (*model.Report, error) {
+	if err := s.locate(&report.Latitude, &report.Longitude, report.Address, report.Location); err != nil {
+		return nil, err
+	}
 	if err := s.caseRepo.CreateReport(report); err != nil {
 		return nil, err
 	}
 	return report, nil
 }
 
-func (s *CaseService) GetEvidence(caseID uint) ([]model.Evidence, error) {
-	return s.caseRepo.GetEvidence(caseID)
+func (s *CaseService) GetEvidence(user *model.User, caseID uint, tags TagQuery) ([]model.Evidence, error) {
+	filter, err := resolveTagFilter(s.tagRepo, user, tags)
+	if err != nil {
+		return nil, err
+	}
+	return s.caseRepo.GetEvidence(caseID, filter)
 }
 
-func (s *CaseService) GetSuspects(caseID uint) ([]model.Suspect, error) {
-	return s.caseRepo.GetSuspects(caseID)
+func (s *CaseService) GetSuspects(user *model.User, caseID uint, tags TagQuery) ([]model.Suspect, error) {
+	filter, err := resolveTagFilter(s.tagRepo, user, tags)
+	if err != nil {
+		return nil, err
+	}
+	return s.caseRepo.GetSuspects(caseID, filter)
 }
 
-func (s *CaseService) GetVictims(caseID uint) ([]model.Victim, error) {
-	return s.caseRepo.GetVictims(caseID)
+func (s *CaseService) GetVictims(user *model.User, caseID uint, tags TagQuery) ([]model.Victim, error) {
+	filter, err := resolveTagFilter(s.tagRepo, user, tags)
+	if err != nil {
+		return nil, err
+	}
+	return s.caseRepo.GetVictims(caseID, filter)
 }
 
-func (s *CaseService) GetWitnesses(caseID uint) ([]model.Witness, error) {
-	return s.caseRepo.GetWitnesses(caseID)
+func (s *CaseService) GetWitnesses(user *model.User, caseID uint, tags TagQuery) ([]model.Witness, error) {
+	filter, err := resolveTagFilter(s.tagRepo, user, tags)
+	if err != nil {
+		return nil, err
+	}
+	return s.caseRepo.GetWitnesses(caseID, filter)
 }
 
 func (s *CaseService) ExtractLinksFromCase(caseID uint) ([]string, error) {
@@ -0,0 +1,327 @@
+package service
+
+import (
+	"fmt"
+	"maps"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"github.com/m7medVision/crime-management-system/internal/repository"
+	"github.com/m7medVision/crime-management-system/internal/util"
+)
+
+// CaseTemplateService manages case templates and opens cases from them, either
+// directly or for a citizen report
+type CaseTemplateService struct {
+	templateRepo *repository.CaseTemplateRepository
+	tagRepo      *repository.TagRepository
+	schemaRepo   *repository.CustomFieldSchemaRepository
+	reportRepo   *repository.ReportRepository
+	caseService  *CaseService
+}
+
+func NewCaseTemplateService(
+	templateRepo *repository.CaseTemplateRepository,
+	tagRepo *repository.TagRepository,
+	schemaRepo *repository.CustomFieldSchemaRepository,
+	reportRepo *repository.ReportRepository,
+	caseService *CaseService,
+) *CaseTemplateService {
+	return &CaseTemplateService{
+		templateRepo: templateRepo,
+		tagRepo:      tagRepo,
+		schemaRepo:   schemaRepo,
+		reportRepo:   reportRepo,
+		caseService:  caseService,
+	}
+}
+
+func (s *CaseTemplateService) ListTemplates() ([]model.CaseTemplate, error) {
+	return s.templateRepo.List()
+}
+
+// GetTemplate looks a template up by ID or name
+func (s *CaseTemplateService) GetTemplate(idOrName string) (*model.CaseTemplate, error) {
+	template, err := s.templateRepo.Resolve(idOrName)
+	if err != nil {
+		return nil, fmt.Errorf("%w: template %q not found", ErrNotFound, idOrName)
+	}
+	return template, nil
+}
+
+func (s *CaseTemplateService) CreateTemplate(template *model.CaseTemplate, tagIDs []uint) (*model.CaseTemplate, error) {
+	if err := s.validateTemplate(template, tagIDs); err != nil {
+		return nil, err
+	}
+	if existing, err := s.templateRepo.Resolve(template.Name); err == nil && existing != nil {
+		return nil, fmt.Errorf("%w: template %q already exists", ErrConflict, template.Name)
+	}
+	if err := s.templateRepo.Create(template); err != nil {
+		return nil, err
+	}
+	return s.templateRepo.GetByID(template.ID)
+}
+
+// UpdateTemplate replaces a template. Cases already created from it are not changed.
+func (s *CaseTemplateService) UpdateTemplate(id uint, changes *model.CaseTemplate, tagIDs []uint) (*model.CaseTemplate, error) {
+	template, err := s.templateRepo.GetByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: template not found", ErrNotFound)
+	}
+	if err := s.validateTemplate(changes, tagIDs); err != nil {
+		return nil, err
+	}
+	if existing, err := s.templateRepo.Resolve(changes.Name); err == nil && existing.ID != template.ID {
+		return nil, fmt.Errorf("%w: template %q already exists", ErrConflict, changes.Name)
+	}
+
+	changes.Model = template.Model
+	if err := s.templateRepo.Update(changes); err != nil {
+		return nil, err
+	}
+	return s.templateRepo.GetByID(id)
+}
+
+func (s *CaseTemplateService) DeleteTemplate(id uint) error {
+	if _, err := s.templateRepo.GetByID(id); err != nil {
+		return fmt.Errorf("%w: template not found", ErrNotFound)
+	}
+	return s.templateRepo.Delete(id)
+}
+
+// validateTemplate normalises a template and loads its tags. Custom field defaults are
+// checked against the schema of the case type, but required fields may be left to the
+// user creating the case.
+func (s *CaseTemplateService) validateTemplate(template *model.CaseTemplate, tagIDs []uint) error {
+	template.Name = strings.TrimSpace(template.Name)
+	template.CaseType = strings.TrimSpace(template.CaseType)
+	if template.Name == "" {
+		return fmt.Errorf("%w: template name is required", ErrInvalidInput)
+	}
+	// Numbers are taken to be template IDs when a template is looked up
+	if _, err := strconv.ParseUint(template.Name, 10, 64); err == nil {
+		return fmt.Errorf("%w: template name must not be a number", ErrInvalidInput)
+	}
+	if template.CaseType == "" {
+		return fmt.Errorf("%w: case type is required", ErrInvalidInput)
+	}
+	if template.Priority == "" {
+		template.Priority = model.PriorityMedium
+	}
+	if !template.Priority.IsValid() {
+		return fmt.Errorf("%w: unknown priority %q", ErrInvalidInput, template.Priority)
+	}
+	if util.ClearanceLevelToInt(template.AuthorizationLevel) == 0 {
+		return fmt.Errorf("%w: invalid authorization level %q", ErrInvalidInput, template.AuthorizationLevel)
+	}
+
+	for i := range template.Tasks {
+		task := &template.Tasks[i]
+		task.Title = strings.TrimSpace(task.Title)
+		if task.Title == "" {
+			return fmt.Errorf("%w: checklist task %d has no title", ErrInvalidInput, i+1)
+		}
+		if task.Priority == "" {
+			task.Priority = model.TaskPriorityMedium
+		}
+		if !isValidTaskPriority(task.Priority) {
+			return fmt.Errorf("%w: checklist task %q has unknown priority %q", ErrInvalidInput, task.Title, task.Priority)
+		}
+		if task.DueInDays < 0 {
+			return fmt.Errorf("%w: checklist task %q is due before the case is created", ErrInvalidInput, task.Title)
+		}
+	}
+
+	schema, err := getCustomFieldSchema(s.schemaRepo, template.CaseType)
+	if err != nil {
+		return err
+	}
+	if template.CustomFields == nil {
+		template.CustomFields = model.CustomFields{}
+	}
+	for key, value := range template.CustomFields {
+		var field model.CustomFieldDefinition
+		ok := false
+		if schema != nil {
+			field, ok = schema.Field(key)
+		}
+		if !ok {
+			return fmt.Errorf("%w: unknown custom field %q", ErrInvalidInput, key)
+		}
+		if err := validateCustomFieldValue(field, value); err != nil {
+			return fmt.Errorf("%w: custom field %q %s", ErrInvalidInput, key, err.Error())
+		}
+	}
+
+	template.Tags = make([]model.Tag, 0, len(tagIDs))
+	for _, tagID := range tagIDs {
+		tag, err := s.tagRepo.GetByID(tagID)
+		if err != nil {
+			return fmt.Errorf("%w: tag %d not found", ErrNotFound, tagID)
+		}
+		template.Tags = append(template.Tags, *tag)
+	}
+	return nil
+}
+
+// CreateCase opens a case from a template. Fields given in changes override the
+// template's defaults; custom field values are merged with the template's.
+func (s *CaseTemplateService) CreateCase(user *model.User, templateRef string, changes CaseChanges) (*model.Case, error) {
+	template, err := s.GetTemplate(templateRef)
+	if err != nil {
+		return nil, err
+	}
+
+	caseData := &model.Case{CreatedByID: user.ID}
+	applyTemplate(caseData, template)
+	applyCaseChanges(caseData, changes)
+
+	setup := s.checklist(user, template)
+	return s.createCase(caseData, setup)
+}
+
+// ConvertReport opens a case for a citizen report, optionally from a template. The
+// case starts from the report's title, text and location, after the template's
+// description skeleton, and fields given in changes override both.
+func (s *CaseTemplateService) ConvertReport(user *model.User, reportID uint, templateRef string, changes CaseChanges) (*model.Case, error) {
+	report, err := s.reportRepo.GetByID(reportID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: report not found", ErrNotFound)
+	}
+	if len(report.Cases) > 0 {
+		return nil, fmt.Errorf("%w: report is already linked to case %s", ErrConflict, report.Cases[0].ReferenceNumber)
+	}
+
+	caseData := &model.Case{CreatedByID: user.ID}
+	setup := repository.CaseSetup{CreatedByID: user.ID}
+	if templateRef != "" {
+		template, err := s.GetTemplate(templateRef)
+		if err != nil {
+			return nil, err
+		}
+		applyTemplate(caseData, template)
+		setup = s.checklist(user, template)
+	}
+
+	caseData.Name = report.Title
+	if caseData.Description != "" {
+		caseData.Description += "\n\n"
+	}
+	caseData.Description += report.Description
+	caseData.Area = report.Location
+	caseData.Address = report.Address
+	caseData.Latitude, caseData.Longitude = report.Latitude, report.Longitude
+	applyCaseChanges(caseData, changes)
+
+	setup.ReportID = &report.ID
+	setup.AuditLogs = append(setup.AuditLogs, &model.AuditLog{
+		UserID:     user.ID,
+		Action:     model.ActionCreate,
+		EntityType: "case_report",
+		EntityID:   report.ID,
+		NewValue:   fmt.Sprintf("Citizen report %q linked", report.Title),
+	})
+	return s.createCase(caseData, setup)
+}
+
+func (s *CaseTemplateService) createCase(caseData *model.Case, setup repository.CaseSetup) (*model.Case, error) {
+	switch {
+	case strings.TrimSpace(caseData.Name) == "":
+		return nil, fmt.Errorf("%w: name is required", ErrInvalidInput)
+	case strings.TrimSpace(caseData.Description) == "":
+		return nil, fmt.Errorf("%w: description is required", ErrInvalidInput)
+	case strings.TrimSpace(caseData.Area) == "":
+		return nil, fmt.Errorf("%w: area is required", ErrInvalidInput)
+	case strings.TrimSpace(caseData.CaseType) == "":
+		return nil, fmt.Errorf("%w: case type is required", ErrInvalidInput)
+	case util.ClearanceLevelToInt(caseData.AuthorizationLevel) == 0:
+		return nil, fmt.Errorf("%w: invalid authorization level %q", ErrInvalidInput, caseData.AuthorizationLevel)
+	}
+	return s.caseService.CreateCaseWithSetup(caseData, setup)
+}
+
+// checklist builds the tasks, tags and history entry a template adds to a new case
+func (s *CaseTemplateService) checklist(user *model.User, template *model.CaseTemplate) repository.CaseSetup {
+	now := time.Now()
+	setup := repository.CaseSetup{CreatedByID: user.ID}
+	for _, templateTask := range template.Tasks {
+		task := model.Task{
+			Title:       templateTask.Title,
+			Description: templateTask.Description,
+			Priority:    templateTask.Priority,
+			Status:      model.TaskStatusOpen,
+			CreatedByID: user.ID,
+		}
+		if templateTask.DueInDays > 0 {
+			dueDate := now.AddDate(0, 0, templateTask.DueInDays)
+			task.DueDate = &dueDate
+		}
+		setup.Tasks = append(setup.Tasks, task)
+	}
+
+	tagNames := make([]string, 0, len(template.Tags))
+	for _, tag := range template.Tags {
+		setup.TagIDs = append(setup.TagIDs, tag.ID)
+		tagNames = append(tagNames, tag.Name)
+	}
+
+	summary := fmt.Sprintf("Created from template %q with %d checklist tasks", template.Name, len(setup.Tasks))
+	if len(tagNames) > 0 {
+		summary += fmt.Sprintf(" and tags %s", strings.Join(tagNames, ", "))
+	}
+	setup.AuditLogs = append(setup.AuditLogs, &model.AuditLog{
+		UserID:     user.ID,
+		Action:     model.ActionCreate,
+		EntityType: "case_template",
+		EntityID:   template.ID,
+		NewValue:   summary,
+	})
+	return setup
+}
+
+// applyTemplate copies the case defaults of a template
+func applyTemplate(caseData *model.Case, template *model.CaseTemplate) {
+	caseData.Name = template.CaseName
+	caseData.Description = template.CaseDescription
+	caseData.CaseType = template.CaseType
+	caseData.Priority = template.Priority
+	caseData.AuthorizationLevel = template.AuthorizationLevel
+	caseData.CustomFields = maps.Clone(template.CustomFields)
+}
+
+// applyCaseChanges overrides case fields with the ones given. Custom field values are
+// merged with the existing ones rather than replacing them.
+func applyCaseChanges(caseData *model.Case, changes CaseChanges) {
+	if changes.Name != nil {
+		caseData.Name = *changes.Name
+	}
+	if changes.Description != nil {
+		caseData.Description = *changes.Description
+	}
+	if changes.Area != nil {
+		caseData.Area = *changes.Area
+	}
+	if changes.CaseType != nil {
+		caseData.CaseType = *changes.CaseType
+	}
+	if changes.AuthorizationLevel != nil {
+		caseData.AuthorizationLevel = *changes.AuthorizationLevel
+	}
+	if changes.Priority != nil {
+		caseData.Priority = *changes.Priority
+	}
+	if changes.Address != nil {
+		caseData.Address = *changes.Address
+	}
+	if changes.Latitude != nil || changes.Longitude != nil {
+		caseData.Latitude, caseData.Longitude = changes.Latitude, changes.Longitude
+	}
+	if len(changes.CustomFields) > 0 {
+		if caseData.CustomFields == nil {
+			caseData.CustomFields = model.CustomFields{}
+		}
+		maps.Copy(caseData.CustomFields, changes.CustomFields)
+	}
+}
@@ -0,0 +1,383 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/m7medVision/crime-management-system/internal/config"
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"github.com/m7medVision/crime-management-system/internal/repository"
+	"github.com/m7medVision/crime-management-system/internal/util"
+	"gorm.io/gorm"
+)
+
+// errClosureRequired is returned when a case is closed by a plain status change
+var errClosureRequired = errors.New("cases are closed with a disposition through POST /cases/{id}/close")
+
+// ClosureRequest is what an investigator submits to close a case
+type ClosureRequest struct {
+	Disposition model.CaseDisposition
+	Summary     string
+	Checklist   model.ClosureChecklist
+}
+
+// ClosureCheck shows whether a case is ready to be closed
+type ClosureCheck struct {
+	repository.ClosureReadiness
+	ApprovalRequired bool // a closure by this user waits for a supervisor
+	Pending          *model.CaseClosure
+}
+
+type ClosureService struct {
+	closureRepo     *repository.ClosureRepository
+	caseRepo        *repository.CaseRepository
+	userRepo        *repository.UserRepository
+	notifier        *NotificationService
+	requireApproval bool
+}
+
+func NewClosureService(
+	closureRepo *repository.ClosureRepository,
+	caseRepo *repository.CaseRepository,
+	userRepo *repository.UserRepository,
+	notifier *NotificationService,
+	closureConfig config.ClosureConfig,
+) *ClosureService {
+	return &ClosureService{
+		closureRepo:     closureRepo,
+		caseRepo:        caseRepo,
+		userRepo:        userRepo,
+		notifier:        notifier,
+		requireApproval: closureConfig.RequireApproval,
+	}
+}
+
+// isSupervisor reports whether the user can approve closures
+func isSupervisor(user *model.User) bool {
+	return user.IsSupervisor || user.Role == model.RoleAdmin
+}
+
+func (s *ClosureService) approvalRequired(user *model.User) bool {
+	return s.requireApproval && !isSupervisor(user)
+}
+
+// CheckClosure reports the evidence, open tasks and suspects the closure checklist covers
+func (s *ClosureService) CheckClosure(user *model.User, caseID uint) (*ClosureCheck, error) {
+	caseData, err := getAccessibleCase(s.caseRepo, user, caseID)
+	if err != nil {
+		return nil, err
+	}
+	readiness, err := s.closureRepo.Readiness(caseData.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	check := &ClosureCheck{ClosureReadiness: *readiness, ApprovalRequired: s.approvalRequired(user)}
+	if pending, err := s.closureRepo.GetPending(caseData.ID); err == nil {
+		check.Pending = pending
+	}
+	return check, nil
+}
+
+// CloseCase closes a case with a disposition and closing summary once the checklist is
+// complete. When approval is required the closure waits for a supervisor and the case
+// stays open. When expectedVersion is given the case must still be at that version.
+func (s *ClosureService) CloseCase(user *model.User, caseID uint, request ClosureRequest, expectedVersion *int) (*model.CaseClosure, error) {
+	caseData, err := getAccessibleCase(s.caseRepo, user, caseID)
+	if err != nil {
+		return nil, err
+	}
+	if expectedVersion != nil && *expectedVersion != caseData.Version {
+		return nil, fmt.Errorf("%w: case has been modified, it is now at version %d", ErrPreconditionFailed, caseData.Version)
+	}
+	if user.Role == model.RoleOfficer {
+		assigned, err := s.caseRepo.IsAssigned(caseData.ID, user.ID)
+		if err != nil {
+			return nil, err
+		}
+		if !assigned {
+			return nil, fmt.Errorf("%w: officer must be assigned to the case to close it", ErrForbidden)
+		}
+	}
+
+	if !request.Disposition.IsValid() {
+		return nil, fmt.Errorf("%w: invalid disposition %q", ErrInvalidInput, request.Disposition)
+	}
+	request.Summary = strings.TrimSpace(request.Summary)
+	if request.Summary == "" {
+		return nil, fmt.Errorf("%w: a closing summary is required", ErrInvalidInput)
+	}
+	var unchecked []string
+	if !request.Checklist.EvidenceReviewed {
+		unchecked = append(unchecked, "evidenceReviewed")
+	}
+	if !request.Checklist.TasksDone {
+		unchecked = append(unchecked, "tasksDone")
+	}
+	if !request.Checklist.SuspectStatusesSet {
+		unchecked = append(unchecked, "suspectStatusesSet")
+	}
+	if len(unchecked) > 0 {
+		return nil, fmt.Errorf("%w: closure checklist is incomplete: %s", ErrInvalidInput, strings.Join(unchecked, ", "))
+	}
+
+	if err := s.checkClosable(caseData, request.Disposition); err != nil {
+		return nil, err
+	}
+	if _, err := s.closureRepo.GetPending(caseData.ID); err == nil {
+		return nil, fmt.Errorf("%w: a closure of this case is already waiting for approval", ErrConflict)
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	closure := &model.CaseClosure{
+		CaseID:        caseData.ID,
+		Disposition:   request.Disposition,
+		Summary:       request.Summary,
+		Checklist:     request.Checklist,
+		Status:        model.ClosurePending,
+		RequestedByID: user.ID,
+	}
+
+	if !s.approvalRequired(user) {
+		if err := s.close(user, closure, caseData, expectedVersion); err != nil {
+			return nil, err
+		}
+		return s.closureRepo.GetByID(closure.ID)
+	}
+
+	caseID = caseData.ID
+	err = s.closureRepo.Request(closure, &model.AuditLog{
+		UserID:     user.ID,
+		Action:     model.ActionCreate,
+		EntityType: "case_closure",
+		CaseID:     &caseID,
+		NewValue:   fmt.Sprintf("Closure as %s requested", closure.Disposition),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	supervisors, err := s.userRepo.ListEscalationRecipients()
+	if err != nil {
+		return nil, err
+	}
+	var recipients []uint
+	for i := range supervisors {
+		if supervisors[i].ID != user.ID && canAccessCase(&supervisors[i], caseData) {
+			recipients = append(recipients, supervisors[i].ID)
+		}
+	}
+	actorID := user.ID
+	s.notifier.Notify(
+		recipients,
+		model.NotificationClosureRequested,
+		fmt.Sprintf("%s asks to close case %s as %s", user.FullName, caseData.ReferenceNumber, closure.Disposition),
+		closure.Summary,
+		&caseID,
+		&actorID,
+	)
+
+	return s.closureRepo.GetByID(closure.ID)
+}
+
+// checkClosable verifies the parts of the checklist the system can check itself
+func (s *ClosureService) checkClosable(caseData *model.Case, disposition model.CaseDisposition) error {
+	if caseData.MergedIntoID != nil {
+		return fmt.Errorf("%w: case was merged into another case", ErrConflict)
+	}
+	if caseData.Status == model.StatusClosed {
+		return fmt.Errorf("%w: case is already closed", ErrConflict)
+	}
+
+	readiness, err := s.closureRepo.Readiness(caseData.ID)
+	if err != nil {
+		return err
+	}
+	if readiness.OpenTasks > 0 {
+		return fmt.Errorf("%w: case still has %d open tasks", ErrConflict, readiness.OpenTasks)
+	}
+	if disposition == model.DispositionSolvedArrest && readiness.ArrestedSuspects == 0 {
+		return fmt.Errorf("%w: disposition %s requires an arrested suspect", ErrInvalidInput, disposition)
+	}
+	return nil
+}
+
+// close approves the closure and closes the case with its disposition
+func (s *ClosureService) close(user *model.User, closure *model.CaseClosure, caseData *model.Case, expectedVersion *int) error {
+	now := time.Now()
+	closure.Status = model.ClosureApproved
+	closure.DecidedByID = &user.ID
+	closure.DecidedAt = &now
+
+	oldStatus := caseData.Status
+	caseData.Disposition = closure.Disposition
+	caseData.ClosingSummary = closure.Summary
+	fields := setCaseStatus(caseData, model.StatusClosed)
+
+	caseID := caseData.ID
+	auditLogs := []*model.AuditLog{
+		{
+			UserID:     user.ID,
+			Action:     model.ActionUpdate,
+			EntityType: "case_status",
+			EntityID:   caseID,
+			CaseID:     &caseID,
+			OldValue:   string(oldStatus),
+			NewValue:   string(model.StatusClosed),
+		},
+		{
+			UserID:     user.ID,
+			Action:     model.ActionUpdate,
+			EntityType: "case_disposition",
+			CaseID:     &caseID,
+			NewValue:   string(closure.Disposition),
+		},
+	}
+	if closure.ID != 0 {
+		auditLogs = append(auditLogs, &model.AuditLog{
+			UserID:     user.ID,
+			Action:     model.ActionUpdate,
+			EntityType: "case_closure",
+			EntityID:   closure.ID,
+			CaseID:     &caseID,
+			OldValue:   string(model.ClosurePending),
+			NewValue:   string(model.ClosureApproved),
+		})
+	}
+
+	archived, err := s.closureRepo.Close(closure, caseData, fields, auditLogs)
+	if err != nil {
+		if errors.Is(err, repository.ErrClosureNotPending) {
+			return fmt.Errorf("%w: %v", ErrConflict, err)
+		}
+		return versionConflict(err, expectedVersion)
+	}
+	if archived > 0 {
+		s.caseRepo.CreateAuditLog(&model.AuditLog{
+			UserID:     user.ID,
+			Action:     model.ActionUpdate,
+			EntityType: "evidence_archived",
+			EntityID:   caseID,
+			CaseID:     &caseID,
+			NewValue:   fmt.Sprintf("%d evidence items archived with the closed case", archived),
+		})
+	}
+
+	notifyStatusChanged(s.notifier, caseData, user.ID, oldStatus)
+	return nil
+}
+
+// getPending loads a closure that is still waiting for approval
+func (s *ClosureService) getPending(user *model.User, id uint) (*model.CaseClosure, error) {
+	closure, err := s.closureRepo.GetByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: closure not found", ErrNotFound)
+	}
+	if !canAccessCase(user, &closure.Case) {
+		return nil, ErrInsufficientClearance
+	}
+	if closure.Status != model.ClosurePending {
+		return nil, fmt.Errorf("%w: closure is already %s", ErrConflict, closure.Status)
+	}
+	return closure, nil
+}
+
+// Approve closes the case of a pending closure. The checks are run again, since
+// the case may have changed while the closure was waiting.
+func (s *ClosureService) Approve(user *model.User, id uint, response string) (*model.CaseClosure, error) {
+	if !isSupervisor(user) {
+		return nil, fmt.Errorf("%w: only supervisors can approve a closure", ErrForbidden)
+	}
+	closure, err := s.getPending(user, id)
+	if err != nil {
+		return nil, err
+	}
+	caseData, err := s.caseRepo.GetByID(closure.CaseID)
+	if err != nil {
+		return nil, ErrCaseNotFound
+	}
+	if err := s.checkClosable(caseData, closure.Disposition); err != nil {
+		return nil, err
+	}
+
+	closure.Response = response
+	if err := s.close(user, closure, caseData, nil); err != nil {
+		return nil, err
+	}
+	s.notifyDecision(closure, user.ID)
+	return s.closureRepo.GetByID(closure.ID)
+}
+
+// Reject turns down a pending closure. The case stays open.
+func (s *ClosureService) Reject(user *model.User, id uint, response string) (*model.CaseClosure, error) {
+	if !isSupervisor(user) {
+		return nil, fmt.Errorf("%w: only supervisors can reject a closure", ErrForbidden)
+	}
+	if strings.TrimSpace(response) == "" {
+		return nil, fmt.Errorf("%w: a reason is required to reject a closure", ErrInvalidInput)
+	}
+	closure, err := s.getPending(user, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	closure.Status = model.ClosureRejected
+	closure.DecidedByID = &user.ID
+	closure.DecidedAt = &now
+	closure.Response = response
+
+	caseID := closure.CaseID
+	err = s.closureRepo.Reject(closure, &model.AuditLog{
+		UserID:     user.ID,
+		Action:     model.ActionUpdate,
+		EntityType: "case_closure",
+		EntityID:   closure.ID,
+		CaseID:     &caseID,
+		OldValue:   string(model.ClosurePending),
+		NewValue:   string(model.ClosureRejected),
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrClosureNotPending) {
+			return nil, fmt.Errorf("%w: %v", ErrConflict, err)
+		}
+		return nil, err
+	}
+
+	s.notifyDecision(closure, user.ID)
+	return s.closureRepo.GetByID(closure.ID)
+}
+
+// notifyDecision tells the requester of a closure whether it was approved
+func (s *ClosureService) notifyDecision(closure *model.CaseClosure, actorID uint) {
+	if closure.RequestedByID == actorID {
+		return
+	}
+	caseID := closure.CaseID
+	s.notifier.Notify(
+		[]uint{closure.RequestedByID},
+		model.NotificationClosureDecided,
+		fmt.Sprintf("Closure of case %s was %s", closure.Case.ReferenceNumber, closure.Status),
+		closure.Response,
+		&caseID,
+		&actorID,
+	)
+}
+
+// ListCaseClosures returns the closure history of a case
+func (s *ClosureService) ListCaseClosures(user *model.User, caseID uint) ([]model.CaseClosure, error) {
+	if _, err := getAccessibleCase(s.caseRepo, user, caseID); err != nil {
+		return nil, err
+	}
+	return s.closureRepo.ListByCase(caseID)
+}
+
+// ListPending returns the closures waiting for approval on cases the supervisor is cleared for
+func (s *ClosureService) ListPending(user *model.User) ([]model.CaseClosure, error) {
+	// Never leave the list nil, which would disable the clearance filter
+	allowedLevels := append([]model.ClearanceLevel{}, util.ClearanceLevelsUpTo(user.ClearanceLevel)...)
+	return s.closureRepo.ListPending(allowedLevels)
+}
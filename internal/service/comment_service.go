@@ -0,0 +1,256 @@
+package service
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"github.com/m7medVision/crime-management-system/internal/repository"
+)
+
+// mentionPattern matches @username mentions in comment content
+var mentionPattern = regexp.MustCompile(`(?:^|[^\w@])@([A-Za-z0-9_.\-]+)`)
+
+// CommentThread is a top-level comment with its replies
+type CommentThread struct {
+	Comment model.Comment
+	Replies []model.Comment
+}
+
+type CommentService struct {
+	commentRepo         *repository.CommentRepository
+	caseRepo            *repository.CaseRepository
+	userRepo            *repository.UserRepository
+	notificationService *NotificationService
+}
+
+func NewCommentService(
+	commentRepo *repository.CommentRepository,
+	caseRepo *repository.CaseRepository,
+	userRepo *repository.UserRepository,
+	notificationService *NotificationService,
+) *CommentService {
+	return &CommentService{
+		commentRepo:         commentRepo,
+		caseRepo:            caseRepo,
+		userRepo:            userRepo,
+		notificationService: notificationService,
+	}
+}
+
+// extractMentions returns the distinct usernames mentioned in the content
+func extractMentions(content string) []string {
+	seen := map[string]bool{}
+	var usernames []string
+	for _, match := range mentionPattern.FindAllStringSubmatch(content, -1) {
+		username := strings.TrimRight(match[1], ".-")
+		if username == "" || seen[username] {
+			continue
+		}
+		seen[username] = true
+		usernames = append(usernames, username)
+	}
+	return usernames
+}
+
+// notifyMentions notifies mentioned users who are cleared for the case, skipping
+// the author and anyone listed in alreadyNotified. Returns the users it notified.
+func (s *CommentService) notifyMentions(author *model.User, caseData *model.Case, comment *model.Comment, alreadyNotified []string) []uint {
+	skip := map[string]bool{author.Username: true}
+	for _, username := range alreadyNotified {
+		skip[username] = true
+	}
+
+	var recipients []uint
+	for _, username := range extractMentions(comment.Content) {
+		if skip[username] {
+			continue
+		}
+		mentioned, err := s.userRepo.GetByUsername(username)
+		if err != nil || !mentioned.IsActive || !canAccessCase(mentioned, caseData) {
+			continue
+		}
+		recipients = append(recipients, mentioned.ID)
+	}
+
+	if len(recipients) == 0 {
+		return nil
+	}
+
+	caseID := caseData.ID
+	authorID := author.ID
+	s.notificationService.Notify(
+		recipients,
+		model.NotificationMention,
+		fmt.Sprintf("%s mentioned you on case %s", author.FullName, caseData.ReferenceNumber),
+		comment.Content,
+		&caseID,
+		&authorID,
+	)
+	return recipients
+}
+
+func (s *CommentService) CreateComment(user *model.User, caseID uint, parentID *uint, content, ipAddress string) (*model.Comment, error) {
+	caseData, err := getAccessibleCase(s.caseRepo, user, caseID)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(content) == "" {
+		return nil, fmt.Errorf("%w: comment content is required", ErrInvalidInput)
+	}
+
+	// Replies are attached to the top-level comment of the thread
+	if parentID != nil {
+		parent, err := s.commentRepo.GetByID(*parentID)
+		if err != nil || parent.CaseID != caseID {
+			return nil, fmt.Errorf("%w: parent comment not found", ErrNotFound)
+		}
+		if parent.ParentID != nil {
+			parentID = parent.ParentID
+		}
+	}
+
+	comment := &model.Comment{
+		CaseID:    caseID,
+		ParentID:  parentID,
+		Content:   content,
+		UserID:    user.ID,
+		IPAddress: ipAddress,
+	}
+	if err := s.commentRepo.Create(comment); err != nil {
+		return nil, err
+	}
+
+	// Mentioned users already got a notification for this comment
+	mentioned := s.notifyMentions(user, caseData, comment, nil)
+	s.notificationService.NotifyCaseEvent(
+		caseData,
+		model.NotificationCommentAdded,
+		fmt.Sprintf("%s commented on case %s", user.FullName, caseData.ReferenceNumber),
+		comment.Content,
+		user.ID,
+		mentioned...,
+	)
+
+	return s.commentRepo.GetByID(comment.ID)
+}
+
+// ListCaseComments returns a page of comment threads for a case
+func (s *CommentService) ListCaseComments(user *model.User, caseID uint, offset, limit int) ([]CommentThread, int64, error) {
+	if _, err := getAccessibleCase(s.caseRepo, user, caseID); err != nil {
+		return nil, 0, err
+	}
+
+	roots, total, err := s.commentRepo.ListThreadsByCaseID(caseID, offset, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	parentIDs := make([]uint, 0, len(roots))
+	for _, root := range roots {
+		parentIDs = append(parentIDs, root.ID)
+	}
+	replies, err := s.commentRepo.ListReplies(parentIDs)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	repliesByParent := map[uint][]model.Comment{}
+	for _, reply := range replies {
+		repliesByParent[*reply.ParentID] = append(repliesByParent[*reply.ParentID], reply)
+	}
+
+	threads := make([]CommentThread, 0, len(roots))
+	for _, root := range roots {
+		threads = append(threads, CommentThread{Comment: root, Replies: repliesByParent[root.ID]})
+	}
+	return threads, total, nil
+}
+
+// getAccessibleComment loads a comment and checks the user's clearance for its case
+func (s *CommentService) getAccessibleComment(user *model.User, id uint) (*model.Comment, *model.Case, error) {
+	comment, err := s.commentRepo.GetByID(id)
+	if err != nil || comment.IsDeleted {
+		return nil, nil, fmt.Errorf("%w: comment not found", ErrNotFound)
+	}
+	caseData, err := getAccessibleCase(s.caseRepo, user, comment.CaseID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return comment, caseData, nil
+}
+
+// UpdateComment lets the author edit a comment, keeping the previous content as a revision
+func (s *CommentService) UpdateComment(user *model.User, id uint, content string) (*model.Comment, error) {
+	comment, caseData, err := s.getAccessibleComment(user, id)
+	if err != nil {
+		return nil, err
+	}
+	if comment.UserID != user.ID {
+		return nil, fmt.Errorf("%w: only the author can edit a comment", ErrForbidden)
+	}
+	if strings.TrimSpace(content) == "" {
+		return nil, fmt.Errorf("%w: comment content is required", ErrInvalidInput)
+	}
+	if content == comment.Content {
+		return comment, nil
+	}
+
+	revision := &model.CommentRevision{
+		CommentID:  comment.ID,
+		Content:    comment.Content,
+		EditedByID: user.ID,
+	}
+	if err := s.commentRepo.CreateRevision(revision); err != nil {
+		return nil, err
+	}
+
+	previousMentions := extractMentions(comment.Content)
+	now := time.Now()
+	comment.Content = content
+	comment.EditedAt = &now
+	if err := s.commentRepo.Update(comment); err != nil {
+		return nil, err
+	}
+
+	s.notifyMentions(user, caseData, comment, previousMentions)
+
+	return comment, nil
+}
+
+// DeleteComment soft deletes a comment. Only the author or an admin may delete it.
+func (s *CommentService) DeleteComment(user *model.User, id uint) error {
+	comment, _, err := s.getAccessibleComment(user, id)
+	if err != nil {
+		return err
+	}
+	if comment.UserID != user.ID && user.Role != model.RoleAdmin {
+		return fmt.Errorf("%w: only the author or an admin can delete a comment", ErrForbidden)
+	}
+
+	if err := s.commentRepo.SoftDelete(id); err != nil {
+		return err
+	}
+
+	caseID := comment.CaseID
+	s.caseRepo.CreateAuditLog(&model.AuditLog{
+		UserID:     user.ID,
+		Action:     model.ActionDelete,
+		EntityType: "comment",
+		EntityID:   comment.ID,
+		CaseID:     &caseID,
+		OldValue:   comment.Content,
+	})
+	return nil
+}
+
+// GetCommentHistory returns the previous versions of a comment
+func (s *CommentService) GetCommentHistory(user *model.User, id uint) ([]model.CommentRevision, error) {
+	if _, _, err := s.getAccessibleComment(user, id); err != nil {
+		return nil, err
+	}
+	return s.commentRepo.ListRevisions(id)
+}
@@ -0,0 +1,33 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExtractMentions(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []string
+	}{
+		{"none", "no mentions here", nil},
+		{"at start", "@alice please check", []string{"alice"}},
+		{"several in order", "cc @bob and @alice", []string{"bob", "alice"}},
+		{"repeated once", "@bob @bob @bob", []string{"bob"}},
+		{"trailing punctuation", "Thanks @j.smith. Also @carol-", []string{"j.smith", "carol"}},
+		{"dots, dashes and underscores", "@first.last @some-one @under_score", []string{"first.last", "some-one", "under_score"}},
+		{"after punctuation", "(@alice) and,@bob", []string{"alice", "bob"}},
+		{"email is not a mention", "write to alice@example.com", nil},
+		{"double at is not a mention", "@@alice", nil},
+		{"lone at", "meet @ noon", nil},
+		{"only punctuation", "@.-", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := extractMentions(tt.content); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("extractMentions(%q) = %q, want %q", tt.content, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,233 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"github.com/m7medVision/crime-management-system/internal/repository"
+	"gorm.io/gorm"
+)
+
+var customFieldKeyPattern = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9_]{0,63}$`)
+
+const customFieldDateLayout = "2006-01-02"
+
+type CustomFieldService struct {
+	schemaRepo *repository.CustomFieldSchemaRepository
+}
+
+func NewCustomFieldService(schemaRepo *repository.CustomFieldSchemaRepository) *CustomFieldService {
+	return &CustomFieldService{schemaRepo: schemaRepo}
+}
+
+func (s *CustomFieldService) ListSchemas() ([]model.CustomFieldSchema, error) {
+	return s.schemaRepo.List()
+}
+
+func (s *CustomFieldService) GetSchema(caseType string) (*model.CustomFieldSchema, error) {
+	schema, err := s.schemaRepo.GetByCaseType(caseType)
+	if err != nil {
+		return nil, fmt.Errorf("%w: no custom fields are defined for case type %q", ErrNotFound, caseType)
+	}
+	return schema, nil
+}
+
+// SaveSchema creates or replaces the custom fields of a case type. Cases that
+// already have values are not revalidated until they are next updated.
+func (s *CustomFieldService) SaveSchema(schema *model.CustomFieldSchema) (*model.CustomFieldSchema, error) {
+	if err := validateCustomFieldSchema(schema); err != nil {
+		return nil, err
+	}
+	if err := s.schemaRepo.Upsert(schema); err != nil {
+		return nil, err
+	}
+	return s.schemaRepo.GetByCaseType(schema.CaseType)
+}
+
+// DeleteSchema removes the custom fields of a case type. Values already stored on
+// cases are kept and still shown in reports.
+func (s *CustomFieldService) DeleteSchema(caseType string) error {
+	schema, err := s.GetSchema(caseType)
+	if err != nil {
+		return err
+	}
+	return s.schemaRepo.Delete(schema.ID)
+}
+
+func validateCustomFieldSchema(schema *model.CustomFieldSchema) error {
+	schema.CaseType = strings.TrimSpace(schema.CaseType)
+	if schema.CaseType == "" {
+		return fmt.Errorf("%w: case type is required", ErrInvalidInput)
+	}
+
+	seen := map[string]bool{}
+	for i := range schema.Fields {
+		field := &schema.Fields[i]
+		if !customFieldKeyPattern.MatchString(field.Key) {
+			return fmt.Errorf("%w: invalid field key %q, expected a letter followed by letters, digits or underscores", ErrInvalidInput, field.Key)
+		}
+		if seen[field.Key] {
+			return fmt.Errorf("%w: duplicate field key %q", ErrInvalidInput, field.Key)
+		}
+		seen[field.Key] = true
+
+		if field.Label == "" {
+			field.Label = field.Key
+		}
+		if !field.Type.IsValid() {
+			return fmt.Errorf("%w: field %q has invalid type %q", ErrInvalidInput, field.Key, field.Type)
+		}
+		if (field.Type == model.CustomFieldEnum) != (len(field.Options) > 0) {
+			return fmt.Errorf("%w: field %q: options are required for enum fields and only allowed for them", ErrInvalidInput, field.Key)
+		}
+		if (field.Min != nil || field.Max != nil) && field.Type != model.CustomFieldNumber && field.Type != model.CustomFieldInteger {
+			return fmt.Errorf("%w: field %q: min and max are only allowed for number and integer fields", ErrInvalidInput, field.Key)
+		}
+		if field.Min != nil && field.Max != nil && *field.Min > *field.Max {
+			return fmt.Errorf("%w: field %q: min is greater than max", ErrInvalidInput, field.Key)
+		}
+		if (field.MaxLength != 0 || field.Pattern != "") && field.Type != model.CustomFieldString {
+			return fmt.Errorf("%w: field %q: maxLength and pattern are only allowed for string fields", ErrInvalidInput, field.Key)
+		}
+		if field.MaxLength < 0 {
+			return fmt.Errorf("%w: field %q: maxLength must not be negative", ErrInvalidInput, field.Key)
+		}
+		if field.Pattern != "" {
+			if _, err := regexp.Compile(field.Pattern); err != nil {
+				return fmt.Errorf("%w: field %q: invalid pattern: %v", ErrInvalidInput, field.Key, err)
+			}
+		}
+	}
+	return nil
+}
+
+// validateCustomFields checks the values of a case against the schema of its case
+// type. A case type without a schema accepts no custom fields.
+func validateCustomFields(schema *model.CustomFieldSchema, values model.CustomFields) error {
+	if schema == nil {
+		if len(values) > 0 {
+			return fmt.Errorf("%w: this case type has no custom fields", ErrInvalidInput)
+		}
+		return nil
+	}
+
+	for key, value := range values {
+		field, ok := schema.Field(key)
+		if !ok {
+			return fmt.Errorf("%w: unknown custom field %q", ErrInvalidInput, key)
+		}
+		if value == nil {
+			delete(values, key)
+			continue
+		}
+		if err := validateCustomFieldValue(field, value); err != nil {
+			return fmt.Errorf("%w: custom field %q %s", ErrInvalidInput, key, err.Error())
+		}
+	}
+
+	for _, field := range schema.Fields {
+		if _, ok := values[field.Key]; field.Required && !ok {
+			return fmt.Errorf("%w: custom field %q is required", ErrInvalidInput, field.Key)
+		}
+	}
+	return nil
+}
+
+func validateCustomFieldValue(field model.CustomFieldDefinition, value interface{}) error {
+	switch field.Type {
+	case model.CustomFieldString:
+		text, ok := value.(string)
+		if !ok {
+			return errors.New("must be a string")
+		}
+		if field.MaxLength > 0 && utf8.RuneCountInString(text) > field.MaxLength {
+			return fmt.Errorf("must be at most %d characters", field.MaxLength)
+		}
+		if field.Pattern != "" && !regexp.MustCompile(field.Pattern).MatchString(text) {
+			return fmt.Errorf("must match %s", field.Pattern)
+		}
+
+	case model.CustomFieldNumber, model.CustomFieldInteger:
+		number, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("must be a %s", field.Type)
+		}
+		if field.Type == model.CustomFieldInteger && number != math.Trunc(number) {
+			return errors.New("must be an integer")
+		}
+		if field.Min != nil && number < *field.Min {
+			return fmt.Errorf("must be at least %v", *field.Min)
+		}
+		if field.Max != nil && number > *field.Max {
+			return fmt.Errorf("must be at most %v", *field.Max)
+		}
+
+	case model.CustomFieldBoolean:
+		if _, ok := value.(bool); !ok {
+			return errors.New("must be true or false")
+		}
+
+	case model.CustomFieldDate:
+		text, ok := value.(string)
+		if !ok {
+			return errors.New("must be a date string")
+		}
+		if _, err := time.Parse(customFieldDateLayout, text); err != nil {
+			return errors.New("must be a date in YYYY-MM-DD format")
+		}
+
+	case model.CustomFieldEnum:
+		text, ok := value.(string)
+		if !ok || !slices.Contains(field.Options, text) {
+			return fmt.Errorf("must be one of %s", strings.Join(field.Options, ", "))
+		}
+	}
+	return nil
+}
+
+// parseCustomFieldFilter converts custom field filters from the query string to
+// typed values so that they can be matched against the stored JSON
+func parseCustomFieldFilter(schema *model.CustomFieldSchema, raw map[string]string) (model.CustomFields, error) {
+	values := model.CustomFields{}
+	for key, text := range raw {
+		field, ok := schema.Field(key)
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown custom field %q", ErrInvalidInput, key)
+		}
+
+		var value interface{} = text
+		switch field.Type {
+		case model.CustomFieldNumber, model.CustomFieldInteger:
+			number, err := strconv.ParseFloat(text, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%w: custom field %q must be a number", ErrInvalidInput, key)
+			}
+			value = number
+		case model.CustomFieldBoolean:
+			flag, err := strconv.ParseBool(text)
+			if err != nil {
+				return nil, fmt.Errorf("%w: custom field %q must be true or false", ErrInvalidInput, key)
+			}
+			value = flag
+		}
+		values[key] = value
+	}
+	return values, nil
+}
+
+// getCustomFieldSchema returns the schema of a case type, or nil if it has none
+func getCustomFieldSchema(schemaRepo *repository.CustomFieldSchemaRepository, caseType string) (*model.CustomFieldSchema, error) {
+	schema, err := schemaRepo.GetByCaseType(caseType)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return schema, err
+}
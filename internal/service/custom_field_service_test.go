@@ -0,0 +1,74 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/m7medVision/crime-management-system/internal/model"
+)
+
+func TestValidateCustomFields(t *testing.T) {
+	zero, maxWeight := 0.0, 500.0
+	schema := &model.CustomFieldSchema{
+		CaseType: "vehicle_theft",
+		Fields: model.CustomFieldDefinitions{
+			{Key: "plate", Type: model.CustomFieldString, Required: true, MaxLength: 8, Pattern: "^[A-Z0-9]+$"},
+			{Key: "doors", Type: model.CustomFieldInteger, Min: &zero},
+			{Key: "weight", Type: model.CustomFieldNumber, Min: &zero, Max: &maxWeight},
+			{Key: "insured", Type: model.CustomFieldBoolean},
+			{Key: "stolenOn", Type: model.CustomFieldDate},
+			{Key: "color", Type: model.CustomFieldEnum, Options: []string{"red", "blue"}},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		schema  *model.CustomFieldSchema
+		values  model.CustomFields
+		wantErr bool
+	}{
+		{name: "no schema, no values", values: model.CustomFields{}},
+		{name: "no schema with values", values: model.CustomFields{"plate": "AB12"}, wantErr: true},
+		{name: "required only", schema: schema, values: model.CustomFields{"plate": "AB12"}},
+		{name: "all fields", schema: schema, values: model.CustomFields{
+			"plate": "AB12", "doors": 4.0, "weight": 12.5, "insured": true, "stolenOn": "2026-02-28", "color": "red",
+		}},
+		{name: "missing required", schema: schema, values: model.CustomFields{"doors": 4.0}, wantErr: true},
+		{name: "required set to null", schema: schema, values: model.CustomFields{"plate": nil}, wantErr: true},
+		{name: "unknown field", schema: schema, values: model.CustomFields{"plate": "AB12", "wheels": 4.0}, wantErr: true},
+		{name: "string too long", schema: schema, values: model.CustomFields{"plate": "ABCDEFGH1"}, wantErr: true},
+		{name: "string not matching pattern", schema: schema, values: model.CustomFields{"plate": "ab-12"}, wantErr: true},
+		{name: "string of wrong type", schema: schema, values: model.CustomFields{"plate": 12.0}, wantErr: true},
+		{name: "integer with fraction", schema: schema, values: model.CustomFields{"plate": "AB12", "doors": 2.5}, wantErr: true},
+		{name: "number below min", schema: schema, values: model.CustomFields{"plate": "AB12", "weight": -1.0}, wantErr: true},
+		{name: "number above max", schema: schema, values: model.CustomFields{"plate": "AB12", "weight": 501.0}, wantErr: true},
+		{name: "number as string", schema: schema, values: model.CustomFields{"plate": "AB12", "weight": "12"}, wantErr: true},
+		{name: "boolean as string", schema: schema, values: model.CustomFields{"plate": "AB12", "insured": "yes"}, wantErr: true},
+		{name: "invalid date", schema: schema, values: model.CustomFields{"plate": "AB12", "stolenOn": "28/02/2026"}, wantErr: true},
+		{name: "impossible date", schema: schema, values: model.CustomFields{"plate": "AB12", "stolenOn": "2026-02-30"}, wantErr: true},
+		{name: "enum outside options", schema: schema, values: model.CustomFields{"plate": "AB12", "color": "green"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateCustomFields(tt.schema, tt.values)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, want error %v", err, tt.wantErr)
+			}
+			if err != nil && !errors.Is(err, ErrInvalidInput) {
+				t.Errorf("error = %v, want ErrInvalidInput", err)
+			}
+		})
+	}
+}
+
+func TestValidateCustomFieldsDropsNulls(t *testing.T) {
+	schema := &model.CustomFieldSchema{Fields: model.CustomFieldDefinitions{{Key: "color", Type: model.CustomFieldString}}}
+	values := model.CustomFields{"color": nil}
+	if err := validateCustomFields(schema, values); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := values["color"]; ok {
+		t.Error("null value was not removed")
+	}
+}
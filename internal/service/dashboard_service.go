@@ -0,0 +1,116 @@
+package service
+
+import (
+	"time"
+
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"github.com/m7medVision/crime-management-system/internal/repository"
+	"github.com/m7medVision/crime-management-system/internal/util"
+)
+
+const (
+	newlyAssignedWindow = 7 * 24 * time.Hour
+	dashboardListLimit  = 10
+	// Likely duplicates shown with each untriaged report
+	dashboardDuplicateLimit = 3
+)
+
+// Dashboard summarises a user's workload
+type Dashboard struct {
+	CasesByStatus         map[model.CaseStatus]int64
+	NewlyAssignedCases    []repository.AssignedCase
+	OverdueTasks          []model.Task
+	BreachedCases         []model.Case
+	RecentEvidence        []model.Evidence
+	UntriagedReports      []model.Report
+	UntriagedReportsCount int64
+	ReportDuplicates      map[uint][]DuplicateMatch // keyed by report ID
+	GeneratedAt           time.Time
+}
+
+type DashboardService struct {
+	dashboardRepo    *repository.DashboardRepository
+	taskRepo         *repository.TaskRepository
+	duplicateService *DuplicateService
+	cache            *util.TTLCache[uint, *Dashboard]
+}
+
+func NewDashboardService(
+	dashboardRepo *repository.DashboardRepository,
+	taskRepo *repository.TaskRepository,
+	duplicateService *DuplicateService,
+	cacheTTL time.Duration,
+) *DashboardService {
+	return &DashboardService{
+		dashboardRepo:    dashboardRepo,
+		taskRepo:         taskRepo,
+		duplicateService: duplicateService,
+		cache:            util.NewTTLCache[uint, *Dashboard](cacheTTL),
+	}
+}
+
+// GetDashboard returns the user's dashboard, served from a short-lived cache unless refresh is set
+func (s *DashboardService) GetDashboard(user *model.User, refresh bool) (*Dashboard, error) {
+	if !refresh {
+		if dashboard, ok := s.cache.Get(user.ID); ok {
+			return dashboard, nil
+		}
+	}
+
+	dashboard, err := s.buildDashboard(user)
+	if err != nil {
+		return nil, err
+	}
+	s.cache.Set(user.ID, dashboard)
+	return dashboard, nil
+}
+
+func (s *DashboardService) buildDashboard(user *model.User) (*Dashboard, error) {
+	now := time.Now()
+	allowedLevels := append([]model.ClearanceLevel{}, util.ClearanceLevelsUpTo(user.ClearanceLevel)...)
+	dashboard := &Dashboard{
+		CasesByStatus:    map[model.CaseStatus]int64{},
+		ReportDuplicates: map[uint][]DuplicateMatch{},
+		GeneratedAt:      now,
+	}
+
+	counts, err := s.dashboardRepo.CountAssignedCasesByStatus(user.ID, allowedLevels)
+	if err != nil {
+		return nil, err
+	}
+	for _, count := range counts {
+		dashboard.CasesByStatus[model.CaseStatus(count.Key)] = count.Count
+	}
+
+	if dashboard.NewlyAssignedCases, err = s.dashboardRepo.ListNewlyAssignedCases(user.ID, allowedLevels, now.Add(-newlyAssignedWindow), dashboardListLimit); err != nil {
+		return nil, err
+	}
+	if dashboard.OverdueTasks, err = s.taskRepo.ListByAssigneeID(user.ID, repository.TaskFilter{OverdueOnly: true}); err != nil {
+		return nil, err
+	}
+	if dashboard.BreachedCases, err = s.dashboardRepo.ListBreachedAssignedCases(user.ID, allowedLevels, dashboardListLimit); err != nil {
+		return nil, err
+	}
+	if dashboard.RecentEvidence, err = s.dashboardRepo.ListRecentEvidence(user.ID, allowedLevels, dashboardListLimit); err != nil {
+		return nil, err
+	}
+
+	// Reports are only matched to users with a home area
+	if user.Area != "" {
+		if dashboard.UntriagedReports, dashboard.UntriagedReportsCount, err = s.dashboardRepo.ListUntriagedReports(user.Area, dashboardListLimit); err != nil {
+			return nil, err
+		}
+	}
+	duplicates, err := s.duplicateService.findDuplicatesOf(user, dashboard.UntriagedReports)
+	if err != nil {
+		return nil, err
+	}
+	for reportID, matches := range duplicates {
+		if len(matches) > dashboardDuplicateLimit {
+			matches = matches[:dashboardDuplicateLimit]
+		}
+		dashboard.ReportDuplicates[reportID] = matches
+	}
+
+	return dashboard, nil
+}
@@ -0,0 +1,326 @@
+package service
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/m7medVision/crime-management-system/internal/config"
+	"github.com/m7medVision/crime-management-system/internal/geo"
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"github.com/m7medVision/crime-management-system/internal/repository"
+	"github.com/m7medVision/crime-management-system/internal/util"
+)
+
+// Weights of the duplicate score components, adding up to 1
+const (
+	duplicateTextWeight     = 0.5
+	duplicateLocationWeight = 0.3
+	duplicateTimeWeight     = 0.2
+)
+
+type DuplicateKind string
+
+const (
+	DuplicateOfReport DuplicateKind = "report"
+	DuplicateOfCase   DuplicateKind = "case"
+)
+
+// DuplicateMatch is a recent report or open case that a report likely describes again.
+// Scores are out of 100.
+type DuplicateMatch struct {
+	Kind            DuplicateKind
+	ID              uint
+	Title           string
+	ReferenceNumber string // cases only
+	CaseID          *uint  // the case to link the report to, when there is one the user can access
+	CaseReference   string
+	Score           int
+	TextScore       int
+	LocationScore   int
+	TimeScore       int
+	DistanceKm      *float64 // set when both sides have coordinates
+	CreatedAt       time.Time
+}
+
+// duplicateText is the part of a report or case that is compared
+type duplicateText struct {
+	text      string
+	place     string
+	latitude  *float64
+	longitude *float64
+	createdAt time.Time
+}
+
+// DuplicateService scores incoming citizen reports against recent reports and open cases
+// on text similarity, location proximity and time
+type DuplicateService struct {
+	reportRepo *repository.ReportRepository
+	caseRepo   *repository.CaseRepository
+	window     time.Duration
+	radiusKm   float64
+	minScore   int
+}
+
+func NewDuplicateService(reportRepo *repository.ReportRepository, caseRepo *repository.CaseRepository, reportConfig config.ReportConfig) *DuplicateService {
+	return &DuplicateService{
+		reportRepo: reportRepo,
+		caseRepo:   caseRepo,
+		window:     time.Duration(reportConfig.DuplicateWindowHours) * time.Hour,
+		radiusKm:   float64(reportConfig.DuplicateRadiusKm),
+		minScore:   reportConfig.DuplicateMinScore,
+	}
+}
+
+// FindDuplicates returns the likely duplicates of a report, best match first
+func (s *DuplicateService) FindDuplicates(user *model.User, reportID uint) ([]DuplicateMatch, error) {
+	report, err := s.reportRepo.GetByID(reportID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: report not found", ErrNotFound)
+	}
+	return s.findDuplicates(user, report)
+}
+
+func (s *DuplicateService) findDuplicates(user *model.User, report *model.Report) ([]DuplicateMatch, error) {
+	matches, err := s.findDuplicatesOf(user, []model.Report{*report})
+	if err != nil {
+		return nil, err
+	}
+	return matches[report.ID], nil
+}
+
+// findDuplicatesOf returns the likely duplicates of each report, best match first. The
+// candidates of all reports are loaded together, so the cost does not grow with the number of reports.
+func (s *DuplicateService) findDuplicatesOf(user *model.User, reports []model.Report) (map[uint][]DuplicateMatch, error) {
+	all := map[uint][]DuplicateMatch{}
+	if len(reports) == 0 {
+		return all, nil
+	}
+
+	reportIDs := make([]uint, 0, len(reports))
+	from, to := reports[0].CreatedAt, reports[0].CreatedAt
+	for i := range reports {
+		reportIDs = append(reportIDs, reports[i].ID)
+		if reports[i].CreatedAt.Before(from) {
+			from = reports[i].CreatedAt
+		}
+		if reports[i].CreatedAt.After(to) {
+			to = reports[i].CreatedAt
+		}
+	}
+	from, to = from.Add(-s.window), to.Add(s.window)
+
+	candidateReports, err := s.reportRepo.ListCandidateReports(from, to, len(reports))
+	if err != nil {
+		return nil, err
+	}
+	// Never leave the list nil, which would disable the clearance filter
+	allowedLevels := append([]model.ClearanceLevel{}, util.ClearanceLevelsUpTo(user.ClearanceLevel)...)
+	candidateCases, err := s.reportRepo.ListCandidateCases(from, to, allowedLevels, len(reports))
+	if err != nil {
+		return nil, err
+	}
+	linkedCases, err := s.reportRepo.ListLinkedCaseIDs(reportIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range reports {
+		report := &reports[i]
+		all[report.ID] = s.matchReport(user, report, candidateReports, candidateCases, linkedCases[report.ID])
+	}
+	return all, nil
+}
+
+// matchReport scores a report against the candidates filed within the window around it,
+// leaving out the report itself and the cases it is already linked to
+func (s *DuplicateService) matchReport(user *model.User, report *model.Report, reports []model.Report, cases []model.Case, linkedCaseIDs []uint) []DuplicateMatch {
+	from, to := report.CreatedAt.Add(-s.window), report.CreatedAt.Add(s.window)
+	inWindow := func(createdAt time.Time) bool {
+		return !createdAt.Before(from) && !createdAt.After(to)
+	}
+	source := reportText(report)
+	matches := []DuplicateMatch{}
+
+	for i := range reports {
+		candidate := &reports[i]
+		if candidate.ID == report.ID || !inWindow(candidate.CreatedAt) {
+			continue
+		}
+		match := s.score(source, reportText(candidate))
+		if match.Score < s.minScore {
+			continue
+		}
+		match.Kind = DuplicateOfReport
+		match.ID = candidate.ID
+		match.Title = candidate.Title
+		// Suggest the case the other report was filed under
+		for j := range candidate.Cases {
+			linked := &candidate.Cases[j]
+			if linked.MergedIntoID == nil && canAccessCase(user, linked) {
+				match.CaseID = &linked.ID
+				match.CaseReference = linked.ReferenceNumber
+				break
+			}
+		}
+		matches = append(matches, match)
+	}
+
+	for i := range cases {
+		candidate := &cases[i]
+		if !inWindow(candidate.CreatedAt) || containsID(linkedCaseIDs, candidate.ID) {
+			continue
+		}
+		match := s.score(source, duplicateText{
+			text:      candidate.Name + " " + candidate.Description,
+			place:     candidate.Area + " " + candidate.Address,
+			latitude:  candidate.Latitude,
+			longitude: candidate.Longitude,
+			createdAt: candidate.CreatedAt,
+		})
+		if match.Score < s.minScore {
+			continue
+		}
+		match.Kind = DuplicateOfCase
+		match.ID = candidate.ID
+		match.Title = candidate.Name
+		match.ReferenceNumber = candidate.ReferenceNumber
+		match.CaseID = &candidate.ID
+		match.CaseReference = candidate.ReferenceNumber
+		matches = append(matches, match)
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Score > matches[j].Score
+	})
+	return matches
+}
+
+func reportText(report *model.Report) duplicateText {
+	return duplicateText{
+		text:      report.Title + " " + report.Description,
+		place:     report.Location + " " + report.Address,
+		latitude:  report.Latitude,
+		longitude: report.Longitude,
+		createdAt: report.CreatedAt,
+	}
+}
+
+// score weighs how alike two texts are, how close their locations are and how close in time they were filed
+func (s *DuplicateService) score(a, b duplicateText) DuplicateMatch {
+	var match DuplicateMatch
+	text := textSimilarity(a.text, b.text)
+
+	// Coordinates win over place names when both sides have them
+	var location float64
+	if a.latitude != nil && a.longitude != nil && b.latitude != nil && b.longitude != nil {
+		distance := geo.DistanceKm(
+			geo.Point{Latitude: *a.latitude, Longitude: *a.longitude},
+			geo.Point{Latitude: *b.latitude, Longitude: *b.longitude},
+		)
+		match.DistanceKm = &distance
+		if s.radiusKm > 0 {
+			location = math.Max(0, 1-distance/s.radiusKm)
+		}
+	} else {
+		location = textSimilarity(a.place, b.place)
+	}
+
+	var timing float64
+	if s.window > 0 {
+		timing = math.Max(0, 1-math.Abs(float64(a.createdAt.Sub(b.createdAt)))/float64(s.window))
+	}
+
+	match.TextScore = percent(text)
+	match.LocationScore = percent(location)
+	match.TimeScore = percent(timing)
+	match.Score = percent(duplicateTextWeight*text + duplicateLocationWeight*location + duplicateTimeWeight*timing)
+	match.CreatedAt = b.createdAt
+	return match
+}
+
+func percent(value float64) int {
+	return int(math.Round(value * 100))
+}
+
+// duplicateStopWords are left out of the text comparison
+var duplicateStopWords = map[string]bool{
+	"the": true, "and": true, "for": true, "was": true, "were": true, "are": true, "with": true,
+	"from": true, "that": true, "this": true, "there": true, "have": true, "has": true, "had": true,
+	"near": true, "about": true, "they": true, "them": true, "our": true, "his": true, "her": true,
+	"someone": true, "been": true, "into": true, "out": true, "not": true, "but": true, "all": true,
+}
+
+// textSimilarity is the Dice coefficient of the significant words of two texts, between 0 and 1
+func textSimilarity(a, b string) float64 {
+	wordsA, wordsB := significantWords(a), significantWords(b)
+	if len(wordsA) == 0 || len(wordsB) == 0 {
+		return 0
+	}
+	shared := 0
+	for word := range wordsA {
+		if wordsB[word] {
+			shared++
+		}
+	}
+	return 2 * float64(shared) / float64(len(wordsA)+len(wordsB))
+}
+
+func significantWords(text string) map[string]bool {
+	words := map[string]bool{}
+	for _, word := range strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		if len([]rune(word)) >= 3 && !duplicateStopWords[word] {
+			words[word] = true
+		}
+	}
+	return words
+}
+
+// LinkReportToCase files a citizen report under an existing case
+func (s *DuplicateService) LinkReportToCase(user *model.User, reportID, caseID uint) (*model.Report, error) {
+	report, err := s.reportRepo.GetByID(reportID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: report not found", ErrNotFound)
+	}
+	caseData, err := getAccessibleCase(s.caseRepo, user, caseID)
+	if err != nil {
+		return nil, err
+	}
+	if caseData.MergedIntoID != nil {
+		return nil, fmt.Errorf("%w: case %s was merged into another case", ErrConflict, caseData.ReferenceNumber)
+	}
+
+	linked, err := s.reportRepo.IsLinked(report.ID, caseData.ID)
+	if err != nil {
+		return nil, err
+	}
+	if linked {
+		return nil, fmt.Errorf("%w: report is already linked to case %s", ErrConflict, caseData.ReferenceNumber)
+	}
+
+	if err := s.reportRepo.LinkToCase(report.ID, caseData.ID, &model.AuditLog{
+		UserID:     user.ID,
+		Action:     model.ActionCreate,
+		EntityType: "case_report",
+		EntityID:   report.ID,
+		CaseID:     &caseData.ID,
+		NewValue:   fmt.Sprintf("Citizen report %q linked", report.Title),
+	}); err != nil {
+		return nil, err
+	}
+	return s.reportRepo.GetByID(report.ID)
+}
+
+func containsID(ids []uint, id uint) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
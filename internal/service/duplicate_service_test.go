@@ -0,0 +1,73 @@
+package service
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/m7medVision/crime-management-system/internal/model"
+)
+
+func TestMatchReport(t *testing.T) {
+	s := &DuplicateService{window: 72 * time.Hour, radiusKm: 2, minScore: 50}
+	user := &model.User{ClearanceLevel: model.ClearanceMedium}
+	filed := time.Date(2026, 5, 10, 12, 0, 0, 0, time.UTC)
+
+	newReport := func(id uint, createdAt time.Time) model.Report {
+		report := model.Report{Title: "Red bicycle stolen", Description: "Red bicycle stolen from the station rack", Location: "Central station"}
+		report.ID = id
+		report.CreatedAt = createdAt
+		return report
+	}
+	newCase := func(id uint, createdAt time.Time, level model.ClearanceLevel) model.Case {
+		caseData := model.Case{Name: "Red bicycle stolen", Description: "Red bicycle stolen from the station rack", Area: "Central station", AuthorizationLevel: level}
+		caseData.ID = id
+		caseData.CreatedAt = createdAt
+		caseData.ReferenceNumber = fmt.Sprintf("DC-2026-%06d", id)
+		return caseData
+	}
+
+	report := newReport(1, filed)
+	linkedToCase := newReport(3, filed.Add(-2*time.Hour))
+	linkedToCase.Cases = []model.Case{newCase(30, filed.Add(-3*time.Hour), model.ClearanceHigh), newCase(31, filed.Add(-3*time.Hour), model.ClearanceLow)}
+	unrelated := newReport(4, filed.Add(-time.Hour))
+	unrelated.Title, unrelated.Description, unrelated.Location = "Noise complaint", "Loud music late at night", "Harbour road"
+
+	reports := []model.Report{
+		report, // the report itself
+		newReport(2, filed.Add(-time.Hour)),
+		linkedToCase,
+		unrelated,
+		newReport(5, filed.Add(-100*time.Hour)), // outside the window
+	}
+	cases := []model.Case{
+		newCase(6, filed.Add(-time.Hour), model.ClearanceLow),
+		newCase(7, filed.Add(-time.Hour), model.ClearanceLow), // already linked to the report
+		newCase(8, filed.Add(100*time.Hour), model.ClearanceLow),
+	}
+
+	matches := s.matchReport(user, &report, reports, cases, []uint{7})
+
+	got := map[DuplicateKind][]uint{}
+	for _, match := range matches {
+		got[match.Kind] = append(got[match.Kind], match.ID)
+	}
+	if len(got[DuplicateOfReport]) != 2 || !containsID(got[DuplicateOfReport], 2) || !containsID(got[DuplicateOfReport], 3) {
+		t.Errorf("report matches = %v, want 2 and 3", got[DuplicateOfReport])
+	}
+	if len(got[DuplicateOfCase]) != 1 || got[DuplicateOfCase][0] != 6 {
+		t.Errorf("case matches = %v, want 6", got[DuplicateOfCase])
+	}
+
+	for i := 1; i < len(matches); i++ {
+		if matches[i].Score > matches[i-1].Score {
+			t.Errorf("matches are not ordered by score: %d before %d", matches[i-1].Score, matches[i].Score)
+		}
+	}
+	for _, match := range matches {
+		// The high-clearance case of report 3 is not suggested to a medium-clearance user
+		if match.Kind == DuplicateOfReport && match.ID == 3 && (match.CaseID == nil || *match.CaseID != 31) {
+			t.Errorf("suggested case of report 3 = %v, want 31", match.CaseID)
+		}
+	}
+}
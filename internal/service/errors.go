@@ -0,0 +1,31 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/m7medVision/crime-management-system/internal/repository"
+)
+
+// Errors shared by services so that controllers can map them to HTTP status codes
+var (
+	ErrCaseNotFound          = errors.New("case not found")
+	ErrInsufficientClearance = errors.New("insufficient clearance level")
+	ErrForbidden             = errors.New("permission denied")
+	ErrInvalidInput          = errors.New("invalid input")
+	ErrNotFound              = errors.New("not found")
+	ErrConflict              = errors.New("conflict")
+	ErrPreconditionFailed    = errors.New("precondition failed")
+)
+
+// versionConflict reports a concurrent modification as a failed precondition when the
+// caller named the version it expected, and as a conflict otherwise
+func versionConflict(err error, expectedVersion *int) error {
+	if !errors.Is(err, repository.ErrVersionConflict) {
+		return err
+	}
+	if expectedVersion != nil {
+		return fmt.Errorf("%w: %v", ErrPreconditionFailed, err)
+	}
+	return fmt.Errorf("%w: %v", ErrConflict, err)
+}
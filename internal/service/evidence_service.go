@@ -22,6 +22,7 @@ type EvidenceService struct {
 	evidenceRepo *repository.EvidenceRepository
 	caseRepo     *repository.CaseRepository
 	userRepo     *repository.UserRepository
+	notifier     *NotificationService
 	minioClient  *minio.Client
 	minioBucket  string
 }
@@ -30,26 +31,36 @@ func NewEvidenceService(
 	evidenceRepo *repository.EvidenceRepository,
 	caseRepo *repository.CaseRepository,
 	userRepo *repository.UserRepository,
+	notifier *NotificationService,
 	minioBucket string,
 ) *EvidenceService {
 	return &EvidenceService{
 		evidenceRepo: evidenceRepo,
 		caseRepo:     caseRepo,
 		userRepo:     userRepo,
+		notifier:     notifier,
 		minioClient:  util.GetMinioClient(),
 		minioBucket:  minioBucket,
 	}
 }
 
+// ResolveCaseID accepts a numeric case ID or a case reference number
+func (s *EvidenceService) ResolveCaseID(idOrReference string) (uint, error) {
+	return resolveCaseID(s.caseRepo, idOrReference)
+}
+
+// errEvidenceArchived is returned when archived evidence of a closed case is changed
+var errEvidenceArchived = fmt.Errorf("%w: evidence is archived with its closed case, reopen the case to change it", ErrConflict)
+
 func (s *EvidenceService) CreateTextEvidence(caseID, userID uint, content, remarks string) (*model.Evidence, error) {
 	// Check if case exists
-	_, err := s.caseRepo.GetByID(caseID)
+	caseData, err := s.caseRepo.GetByID(caseID)
 	if err != nil {
 		return nil, errors.New("case not found")
 	}
 
 	// Check if user exists
-	_, err = s.userRepo.GetByID(userID)
+	user, err := s.userRepo.GetByID(userID)
 	if err != nil {
 		return nil, errors.New("user not found")
 	}
@@ -73,22 +84,25 @@ func (s *EvidenceService) CreateTextEvidence(caseID, userID uint, content, remar
 		Action:     model.ActionCreate,
 		EntityType: "evidence",
 		EntityID:   evidence.ID,
+		CaseID:     &evidence.CaseID,
 		NewValue:   fmt.Sprintf("Text evidence created for case %d", caseID),
 	}
 	s.evidenceRepo.CreateAuditLog(auditLog)
 
+	s.notifyEvidenceAdded(caseData, user, evidence)
+
 	return evidence, nil
 }
 
 func (s *EvidenceService) CreateImageEvidence(caseID, userID uint, file *multipart.FileHeader, remarks string) (*model.Evidence, error) {
 	// Check if case exists
-	_, err := s.caseRepo.GetByID(caseID)
+	caseData, err := s.caseRepo.GetByID(caseID)
 	if err != nil {
 		return nil, errors.New("case not found")
 	}
 
 	// Check if user exists
-	_, err = s.userRepo.GetByID(userID)
+	user, err := s.userRepo.GetByID(userID)
 	if err != nil {
 		return nil, errors.New("user not found")
 	}
@@ -161,13 +175,31 @@ func (s *EvidenceService) CreateImageEvidence(caseID, userID uint, file *multipa
 		Action:     model.ActionCreate,
 		EntityType: "evidence",
 		EntityID:   evidence.ID,
+		CaseID:     &evidence.CaseID,
 		NewValue:   fmt.Sprintf("Image evidence uploaded for case %d", caseID),
 	}
 	s.evidenceRepo.CreateAuditLog(auditLog)
 
+	s.notifyEvidenceAdded(caseData, user, evidence)
+
 	return evidence, nil
 }
 
+// notifyEvidenceAdded tells the assignees and watchers of the case about new evidence
+func (s *EvidenceService) notifyEvidenceAdded(caseData *model.Case, user *model.User, evidence *model.Evidence) {
+	message := evidence.Remarks
+	if evidence.Type == model.EvidenceTypeText {
+		message = evidence.Content
+	}
+	s.notifier.NotifyCaseEvent(
+		caseData,
+		model.NotificationEvidenceAdded,
+		fmt.Sprintf("%s added %s evidence to case %s", user.FullName, evidence.Type, caseData.ReferenceNumber),
+		message,
+		user.ID,
+	)
+}
+
 func (s *EvidenceService) GetEvidenceByID(id uint) (*model.Evidence, error) {
 	return s.evidenceRepo.GetByID(id)
 }
@@ -200,20 +232,31 @@ func (s *EvidenceService) GetEvidenceImage(id uint) (io.ReadCloser, int64, strin
 	return obj, stat.Size, stat.ContentType, nil
 }
 
-func (s *EvidenceService) UpdateEvidence(id, userID uint, remarks string) (*model.Evidence, error) {
+// UpdateEvidence changes the remarks of an evidence item. When expectedVersion is given
+// the update fails with ErrPreconditionFailed unless the item is still at that version.
+func (s *EvidenceService) UpdateEvidence(id, userID uint, remarks *string, expectedVersion *int) (*model.Evidence, error) {
 	evidence, err := s.evidenceRepo.GetByID(id)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("%w: evidence not found", ErrNotFound)
+	}
+	if expectedVersion != nil && *expectedVersion != evidence.Version {
+		return nil, fmt.Errorf("%w: evidence has been modified, it is now at version %d", ErrPreconditionFailed, evidence.Version)
+	}
+	if evidence.ArchivedAt != nil {
+		return nil, errEvidenceArchived
+	}
+
+	// Only remarks can be changed
+	if remarks == nil || *remarks == evidence.Remarks {
+		return evidence, nil
 	}
 
 	// Store old value for audit log
 	oldValue := evidence.Remarks
+	evidence.Remarks = *remarks
 
-	// Update remarks only
-	evidence.Remarks = remarks
-
-	if err := s.evidenceRepo.Update(evidence); err != nil {
-		return nil, err
+	if err := s.evidenceRepo.UpdateFields(evidence, map[string]interface{}{"remarks": *remarks}); err != nil {
+		return nil, versionConflict(err, expectedVersion)
 	}
 
 	// Create audit log
@@ -222,8 +265,9 @@ func (s *EvidenceService) UpdateEvidence(id, userID uint, remarks string) (*mode
 		Action:     model.ActionUpdate,
 		EntityType: "evidence",
 		EntityID:   evidence.ID,
+		CaseID:     &evidence.CaseID,
 		OldValue:   oldValue,
-		NewValue:   remarks,
+		NewValue:   *remarks,
 	}
 	s.evidenceRepo.CreateAuditLog(auditLog)
 
@@ -235,9 +279,12 @@ func (s *EvidenceService) SoftDeleteEvidence(id, userID uint) error {
 	if err != nil {
 		return err
 	}
+	if evidence.ArchivedAt != nil {
+		return errEvidenceArchived
+	}
 
-	if err := s.evidenceRepo.SoftDelete(id); err != nil {
-		return err
+	if err := s.evidenceRepo.SoftDelete(evidence); err != nil {
+		return versionConflict(err, nil)
 	}
 
 	// Create audit log
@@ -246,6 +293,7 @@ func (s *EvidenceService) SoftDeleteEvidence(id, userID uint) error {
 		Action:     model.ActionDelete,
 		EntityType: "evidence",
 		EntityID:   evidence.ID,
+		CaseID:     &evidence.CaseID,
 		OldValue:   fmt.Sprintf("Evidence %d soft deleted", id),
 	}
 	s.evidenceRepo.CreateAuditLog(auditLog)
@@ -282,6 +330,7 @@ func (s *EvidenceService) HardDeleteEvidence(id, userID uint) error {
 		Action:     model.ActionDelete,
 		EntityType: "evidence",
 		EntityID:   evidence.ID,
+		CaseID:     &evidence.CaseID,
 		OldValue:   fmt.Sprintf("Evidence %d hard deleted", id),
 	}
 	s.evidenceRepo.CreateAuditLog(auditLog)
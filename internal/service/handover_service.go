@@ -0,0 +1,341 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"github.com/m7medVision/crime-management-system/internal/repository"
+	"gorm.io/gorm"
+)
+
+// HandoverBatchItem is the outcome of handing over one case in a batch
+type HandoverBatchItem struct {
+	CaseID          uint
+	ReferenceNumber string
+	Handover        *model.CaseHandover
+	Error           string
+}
+
+// HandoverBatchResult lists the handovers requested for all cases of a user
+type HandoverBatchResult struct {
+	Requested int
+	Failed    int
+	Items     []HandoverBatchItem
+}
+
+type HandoverService struct {
+	handoverRepo *repository.HandoverRepository
+	caseRepo     *repository.CaseRepository
+	userRepo     *repository.UserRepository
+	notifier     *NotificationService
+}
+
+func NewHandoverService(
+	handoverRepo *repository.HandoverRepository,
+	caseRepo *repository.CaseRepository,
+	userRepo *repository.UserRepository,
+	notifier *NotificationService,
+) *HandoverService {
+	return &HandoverService{
+		handoverRepo: handoverRepo,
+		caseRepo:     caseRepo,
+		userRepo:     userRepo,
+		notifier:     notifier,
+	}
+}
+
+// RequestHandover asks toUserID to take over fromUserID's assignment on a case.
+// Users hand over their own assignments; admins may act for someone else.
+func (s *HandoverService) RequestHandover(user *model.User, caseID, fromUserID, toUserID uint, note string) (*model.CaseHandover, error) {
+	from, to, err := s.loadParties(user, fromUserID, toUserID, note)
+	if err != nil {
+		return nil, err
+	}
+	caseData, err := getAccessibleCase(s.caseRepo, user, caseID)
+	if err != nil {
+		return nil, err
+	}
+	return s.request(user, caseData, from, to, note)
+}
+
+// HandOverAll requests a handover to toUserID for every open case fromUserID is assigned to.
+// Each case is handled on its own, so one failure does not stop the others.
+func (s *HandoverService) HandOverAll(user *model.User, fromUserID, toUserID uint, note string) (*HandoverBatchResult, error) {
+	from, to, err := s.loadParties(user, fromUserID, toUserID, note)
+	if err != nil {
+		return nil, err
+	}
+
+	assignments, err := s.caseRepo.ListUserAssignments(from.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &HandoverBatchResult{Items: []HandoverBatchItem{}}
+	for _, assignment := range assignments {
+		caseData, err := s.caseRepo.GetByID(assignment.CaseID)
+		if err != nil || caseData.Status == model.StatusClosed || caseData.MergedIntoID != nil {
+			continue
+		}
+
+		item := HandoverBatchItem{CaseID: caseData.ID, ReferenceNumber: caseData.ReferenceNumber}
+		if !canAccessCase(user, caseData) {
+			item.Error = ErrInsufficientClearance.Error()
+		} else if item.Handover, err = s.request(user, caseData, from, to, note); err != nil {
+			item.Error = err.Error()
+		}
+
+		if item.Error != "" {
+			result.Failed++
+		} else {
+			result.Requested++
+		}
+		result.Items = append(result.Items, item)
+	}
+	return result, nil
+}
+
+// loadParties checks who may hand over and who may receive the handover
+func (s *HandoverService) loadParties(user *model.User, fromUserID, toUserID uint, note string) (*model.User, *model.User, error) {
+	if fromUserID != user.ID && user.Role != model.RoleAdmin {
+		return nil, nil, fmt.Errorf("%w: only an admin can hand over someone else's cases", ErrForbidden)
+	}
+	if fromUserID == toUserID {
+		return nil, nil, fmt.Errorf("%w: cannot hand over to the same user", ErrInvalidInput)
+	}
+	if strings.TrimSpace(note) == "" {
+		return nil, nil, fmt.Errorf("%w: a handover note is required", ErrInvalidInput)
+	}
+
+	from, err := s.userRepo.GetByID(fromUserID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: user not found", ErrNotFound)
+	}
+	to, err := s.userRepo.GetByID(toUserID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: incoming investigator not found", ErrNotFound)
+	}
+	if !to.IsActive || to.Role != model.RoleInvestigator {
+		return nil, nil, fmt.Errorf("%w: cases can only be handed over to an active investigator", ErrInvalidInput)
+	}
+	return from, to, nil
+}
+
+func (s *HandoverService) request(user *model.User, caseData *model.Case, from, to *model.User, note string) (*model.CaseHandover, error) {
+	if caseData.Status == model.StatusClosed {
+		return nil, fmt.Errorf("%w: case is closed", ErrInvalidInput)
+	}
+	if !canAccessCase(to, caseData) {
+		return nil, fmt.Errorf("%w: %s is not cleared for this case", ErrInsufficientClearance, to.FullName)
+	}
+
+	assignment, err := s.caseRepo.GetAssignment(caseData.ID, from.ID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("%w: %s is not assigned to this case", ErrInvalidInput, from.FullName)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.handoverRepo.GetPending(caseData.ID, from.ID); err == nil {
+		return nil, fmt.Errorf("%w: a handover of this assignment is already pending", ErrConflict)
+	}
+
+	handover := &model.CaseHandover{
+		CaseID:        caseData.ID,
+		FromUserID:    from.ID,
+		ToUserID:      to.ID,
+		Role:          assignment.Role,
+		Note:          note,
+		Status:        model.HandoverPending,
+		RequestedByID: user.ID,
+	}
+	if err := s.handoverRepo.Create(handover); err != nil {
+		return nil, err
+	}
+
+	caseID := caseData.ID
+	s.caseRepo.CreateAuditLog(&model.AuditLog{
+		UserID:     user.ID,
+		Action:     model.ActionCreate,
+		EntityType: "case_handover",
+		EntityID:   handover.ID,
+		CaseID:     &caseID,
+		NewValue:   fmt.Sprintf("Handover of the %s role from %s to %s requested", assignment.Role, from.FullName, to.FullName),
+	})
+
+	actorID := user.ID
+	s.notifier.Notify(
+		[]uint{to.ID},
+		model.NotificationHandoverRequested,
+		fmt.Sprintf("%s asks you to take over case %s as %s", from.FullName, caseData.ReferenceNumber, assignment.Role),
+		note,
+		&caseID,
+		&actorID,
+	)
+
+	return s.handoverRepo.GetByID(handover.ID)
+}
+
+// getPending loads a handover that is still waiting for a decision
+func (s *HandoverService) getPending(id uint) (*model.CaseHandover, error) {
+	handover, err := s.handoverRepo.GetByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: handover not found", ErrNotFound)
+	}
+	if handover.Status != model.HandoverPending {
+		return nil, fmt.Errorf("%w: handover is already %s", ErrConflict, handover.Status)
+	}
+	return handover, nil
+}
+
+// Accept lets the incoming investigator take over the assignment. The outgoing user
+// is unassigned and the acceptance is recorded in the case history.
+func (s *HandoverService) Accept(user *model.User, id uint, response string) (*model.CaseHandover, error) {
+	handover, err := s.getPending(id)
+	if err != nil {
+		return nil, err
+	}
+	if handover.ToUserID != user.ID {
+		return nil, fmt.Errorf("%w: only the incoming investigator can accept a handover", ErrForbidden)
+	}
+	if !canAccessCase(user, &handover.Case) {
+		return nil, ErrInsufficientClearance
+	}
+
+	caseID := handover.CaseID
+	auditLogs := []*model.AuditLog{
+		{
+			UserID:     user.ID,
+			Action:     model.ActionUpdate,
+			EntityType: "case_handover",
+			EntityID:   handover.ID,
+			CaseID:     &caseID,
+			OldValue:   string(model.HandoverPending),
+			NewValue:   string(model.HandoverAccepted),
+		},
+		{
+			UserID:     user.ID,
+			Action:     model.ActionDelete,
+			EntityType: "case_assignee",
+			EntityID:   handover.FromUserID,
+			CaseID:     &caseID,
+			OldValue:   fmt.Sprintf("%s unassigned from case after handover", handover.FromUser.FullName),
+		},
+		{
+			UserID:     user.ID,
+			Action:     model.ActionCreate,
+			EntityType: "case_assignee",
+			EntityID:   handover.ToUserID,
+			CaseID:     &caseID,
+			NewValue:   fmt.Sprintf("%s took over from %s", handover.ToUser.FullName, handover.FromUser.FullName),
+		},
+	}
+
+	if err := s.handoverRepo.Accept(handover, response, auditLogs); err != nil {
+		if errors.Is(err, repository.ErrHandoverNotPending) || errors.Is(err, repository.ErrHandoverUnassigned) {
+			return nil, fmt.Errorf("%w: %v", ErrConflict, err)
+		}
+		return nil, err
+	}
+
+	s.notifyDecision(handover, user.ID)
+	return handover, nil
+}
+
+// Decline rejects a handover. The outgoing user keeps the assignment.
+func (s *HandoverService) Decline(user *model.User, id uint, response string) (*model.CaseHandover, error) {
+	handover, err := s.getPending(id)
+	if err != nil {
+		return nil, err
+	}
+	if handover.ToUserID != user.ID {
+		return nil, fmt.Errorf("%w: only the incoming investigator can decline a handover", ErrForbidden)
+	}
+	if strings.TrimSpace(response) == "" {
+		return nil, fmt.Errorf("%w: a reason is required to decline a handover", ErrInvalidInput)
+	}
+	return s.close(user, handover, model.HandoverDeclined, response)
+}
+
+// Cancel withdraws a handover. The outgoing user, whoever requested it and admins may cancel.
+func (s *HandoverService) Cancel(user *model.User, id uint) (*model.CaseHandover, error) {
+	handover, err := s.getPending(id)
+	if err != nil {
+		return nil, err
+	}
+	if handover.FromUserID != user.ID && handover.RequestedByID != user.ID && user.Role != model.RoleAdmin {
+		return nil, fmt.Errorf("%w: only the outgoing user can cancel a handover", ErrForbidden)
+	}
+	return s.close(user, handover, model.HandoverCancelled, "")
+}
+
+func (s *HandoverService) close(user *model.User, handover *model.CaseHandover, status model.HandoverStatus, response string) (*model.CaseHandover, error) {
+	caseID := handover.CaseID
+	auditLog := &model.AuditLog{
+		UserID:     user.ID,
+		Action:     model.ActionUpdate,
+		EntityType: "case_handover",
+		EntityID:   handover.ID,
+		CaseID:     &caseID,
+		OldValue:   string(model.HandoverPending),
+		NewValue:   string(status),
+	}
+	if err := s.handoverRepo.Close(handover, status, response, auditLog); err != nil {
+		if errors.Is(err, repository.ErrHandoverNotPending) {
+			return nil, fmt.Errorf("%w: %v", ErrConflict, err)
+		}
+		return nil, err
+	}
+
+	s.notifyDecision(handover, user.ID)
+	return handover, nil
+}
+
+// notifyDecision tells the other parties of a handover how it ended
+func (s *HandoverService) notifyDecision(handover *model.CaseHandover, actorID uint) {
+	var recipients []uint
+	for _, userID := range []uint{handover.FromUserID, handover.RequestedByID, handover.ToUserID} {
+		if userID != actorID && !slices.Contains(recipients, userID) {
+			recipients = append(recipients, userID)
+		}
+	}
+
+	caseID := handover.CaseID
+	s.notifier.Notify(
+		recipients,
+		model.NotificationHandoverDecided,
+		fmt.Sprintf("Handover of case %s to %s was %s", handover.Case.ReferenceNumber, handover.ToUser.FullName, handover.Status),
+		handover.Response,
+		&caseID,
+		&actorID,
+	)
+}
+
+// ListMyHandovers returns the handovers a user takes part in. Direction is incoming,
+// outgoing or empty for both; an empty status lists handovers in any status.
+func (s *HandoverService) ListMyHandovers(user *model.User, direction string, status model.HandoverStatus) ([]model.CaseHandover, error) {
+	filter := repository.HandoverFilter{Status: status}
+	switch direction {
+	case "incoming":
+		filter.Incoming = &user.ID
+	case "outgoing":
+		filter.Outgoing = &user.ID
+	case "":
+		filter.Incoming, filter.Outgoing = &user.ID, &user.ID
+	default:
+		return nil, fmt.Errorf("%w: direction must be incoming or outgoing", ErrInvalidInput)
+	}
+	return s.handoverRepo.List(filter)
+}
+
+// ListCaseHandovers returns the handover history of a case
+func (s *HandoverService) ListCaseHandovers(user *model.User, caseID uint) ([]model.CaseHandover, error) {
+	if _, err := getAccessibleCase(s.caseRepo, user, caseID); err != nil {
+		return nil, err
+	}
+	return s.handoverRepo.List(repository.HandoverFilter{CaseID: &caseID})
+}
@@ -0,0 +1,216 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/m7medVision/crime-management-system/internal/email"
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"github.com/m7medVision/crime-management-system/internal/repository"
+	"gorm.io/gorm"
+)
+
+// NotificationPreference is how a user receives one notification type
+type NotificationPreference struct {
+	Type  model.NotificationType
+	InApp bool
+	Email bool
+}
+
+type NotificationService struct {
+	notificationRepo *repository.NotificationRepository
+	watcherRepo      *repository.WatcherRepository
+	caseRepo         *repository.CaseRepository
+	userRepo         *repository.UserRepository
+	mailer           email.Sender
+}
+
+// NewNotificationService creates the notification service. A nil mailer disables email delivery.
+func NewNotificationService(
+	notificationRepo *repository.NotificationRepository,
+	watcherRepo *repository.WatcherRepository,
+	caseRepo *repository.CaseRepository,
+	userRepo *repository.UserRepository,
+	mailer email.Sender,
+) *NotificationService {
+	return &NotificationService{
+		notificationRepo: notificationRepo,
+		watcherRepo:      watcherRepo,
+		caseRepo:         caseRepo,
+		userRepo:         userRepo,
+		mailer:           mailer,
+	}
+}
+
+// Notify delivers a notification to each user according to their preferences for its type.
+// Delivery is best effort: failures are logged and do not fail the operation that triggered them.
+func (s *NotificationService) Notify(userIDs []uint, notificationType model.NotificationType, title, message string, caseID, actorID *uint) {
+	if len(userIDs) == 0 {
+		return
+	}
+
+	preferences, err := s.notificationRepo.GetPreferences(userIDs, notificationType)
+	if err != nil {
+		log.Printf("Failed to load notification preferences: %v", err)
+		preferences = map[uint]model.NotificationPreference{}
+	}
+
+	for _, userID := range userIDs {
+		preference := resolvePreference(preferences, userID, notificationType)
+
+		if preference.InApp {
+			notification := &model.Notification{
+				UserID:  userID,
+				Type:    notificationType,
+				Title:   title,
+				Message: message,
+				CaseID:  caseID,
+				ActorID: actorID,
+			}
+			if err := s.notificationRepo.Create(notification); err != nil {
+				log.Printf("Failed to create notification for user %d: %v", userID, err)
+			}
+		}
+
+		if preference.Email && s.mailer != nil {
+			s.sendEmail(userID, title, message)
+		}
+	}
+}
+
+// resolvePreference returns the user's stored preference, falling back to in-app only
+func resolvePreference(preferences map[uint]model.NotificationPreference, userID uint, notificationType model.NotificationType) NotificationPreference {
+	if preference, ok := preferences[userID]; ok {
+		return NotificationPreference{Type: notificationType, InApp: preference.InApp, Email: preference.Email}
+	}
+	return NotificationPreference{Type: notificationType, InApp: true}
+}
+
+// sendEmail mails a notification in the background so that slow delivery never blocks a request
+func (s *NotificationService) sendEmail(userID uint, subject, text string) {
+	user, err := s.userRepo.GetByID(userID)
+	if err != nil || !user.IsActive || user.Email == "" {
+		return
+	}
+
+	message := email.Message{To: user.Email, Subject: subject, Text: text}
+	go func() {
+		if err := s.mailer.Send(message); err != nil {
+			log.Printf("Failed to email notification to user %d: %v", userID, err)
+		}
+	}()
+}
+
+// NotifyCaseEvent notifies the assignees and watchers of a case about an event on it.
+// The actor and the users in skip are left out, as are users no longer cleared for the case.
+func (s *NotificationService) NotifyCaseEvent(caseData *model.Case, notificationType model.NotificationType, title, message string, actorID uint, skip ...uint) {
+	excluded := map[uint]bool{actorID: true}
+	for _, userID := range skip {
+		excluded[userID] = true
+	}
+
+	var candidates []model.User
+	assignees, err := s.caseRepo.GetAssignees(caseData.ID)
+	if err != nil {
+		log.Printf("Failed to load assignees of case %d: %v", caseData.ID, err)
+	}
+	candidates = append(candidates, assignees...)
+
+	watchers, err := s.watcherRepo.ListWatchers(caseData.ID)
+	if err != nil {
+		log.Printf("Failed to load watchers of case %d: %v", caseData.ID, err)
+	}
+	candidates = append(candidates, watchers...)
+
+	var recipients []uint
+	for i := range candidates {
+		candidate := &candidates[i]
+		if excluded[candidate.ID] || !candidate.IsActive || !canAccessCase(candidate, caseData) {
+			continue
+		}
+		excluded[candidate.ID] = true
+		recipients = append(recipients, candidate.ID)
+	}
+
+	caseID := caseData.ID
+	s.Notify(recipients, notificationType, title, message, &caseID, &actorID)
+}
+
+// ListNotifications returns a page of the user's inbox along with the number of unread notifications
+func (s *NotificationService) ListNotifications(userID uint, unreadOnly bool, offset, limit int) ([]model.Notification, int64, int64, error) {
+	notifications, total, err := s.notificationRepo.ListByUserID(userID, unreadOnly, offset, limit)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	unread, err := s.notificationRepo.CountUnread(userID)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	return notifications, total, unread, nil
+}
+
+func (s *NotificationService) MarkRead(userID, id uint) error {
+	err := s.notificationRepo.MarkRead(userID, id, time.Now())
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return fmt.Errorf("%w: notification not found", ErrNotFound)
+	}
+	return err
+}
+
+// MarkAllRead marks the whole inbox as read and returns how many notifications changed
+func (s *NotificationService) MarkAllRead(userID uint) (int64, error) {
+	return s.notificationRepo.MarkAllRead(userID, time.Now())
+}
+
+// GetPreferences returns the user's preference for every notification type, including defaults
+func (s *NotificationService) GetPreferences(userID uint) ([]NotificationPreference, error) {
+	stored, err := s.notificationRepo.ListPreferences(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	byType := make(map[model.NotificationType]model.NotificationPreference, len(stored))
+	for _, preference := range stored {
+		byType[preference.Type] = preference
+	}
+
+	preferences := make([]NotificationPreference, 0, len(model.NotificationTypes))
+	for _, notificationType := range model.NotificationTypes {
+		preference := NotificationPreference{Type: notificationType, InApp: true}
+		if record, ok := byType[notificationType]; ok {
+			preference.InApp = record.InApp
+			preference.Email = record.Email
+		}
+		preferences = append(preferences, preference)
+	}
+	return preferences, nil
+}
+
+// SavePreferences stores the given preferences; types that are not listed keep their current setting
+func (s *NotificationService) SavePreferences(userID uint, preferences []NotificationPreference) ([]NotificationPreference, error) {
+	seen := map[model.NotificationType]bool{}
+	records := make([]model.NotificationPreference, 0, len(preferences))
+	for _, preference := range preferences {
+		if !preference.Type.IsValid() {
+			return nil, fmt.Errorf("%w: unknown notification type %q", ErrInvalidInput, preference.Type)
+		}
+		if seen[preference.Type] {
+			return nil, fmt.Errorf("%w: notification type %q is listed more than once", ErrInvalidInput, preference.Type)
+		}
+		seen[preference.Type] = true
+
+		records = append(records, model.NotificationPreference{
+			UserID: userID,
+			Type:   preference.Type,
+			InApp:  preference.InApp,
+			Email:  preference.Email,
+		})
+	}
+
+	if err := s.notificationRepo.SavePreferences(records); err != nil {
+		return nil, err
+	}
+	return s.GetPreferences(userID)
+}
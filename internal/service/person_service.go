@@ -0,0 +1,237 @@
+package service
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"github.com/m7medVision/crime-management-system/internal/repository"
+)
+
+// PersonChanges holds the fields of a suspect, victim or witness; nil fields are left
+// unchanged. Fields that belong to another person type are rejected.
+type PersonChanges struct {
+	FirstName         *string
+	LastName          *string
+	Age               *int
+	Gender            *string
+	Address           *string
+	PhoneNumber       *string
+	Notes             *string
+	Description       *string // suspects
+	IsArrested        *bool   // suspects
+	InjuryDescription *string // victims
+	Statement         *string // witnesses
+}
+
+type PersonService struct {
+	personRepo *repository.PersonRepository
+	caseRepo   *repository.CaseRepository
+}
+
+func NewPersonService(personRepo *repository.PersonRepository, caseRepo *repository.CaseRepository) *PersonService {
+	return &PersonService{
+		personRepo: personRepo,
+		caseRepo:   caseRepo,
+	}
+}
+
+// newPersonRecord returns an empty record of the person type and its shared fields
+func newPersonRecord(personType model.PersonType) (interface{}, *model.Person) {
+	switch personType {
+	case model.PersonSuspect:
+		record := &model.Suspect{}
+		return record, &record.Person
+	case model.PersonVictim:
+		record := &model.Victim{}
+		return record, &record.Person
+	default:
+		record := &model.Witness{}
+		return record, &record.Person
+	}
+}
+
+// personLabel names a person type for messages, e.g. "Suspect"
+func personLabel(personType model.PersonType) string {
+	return strings.ToUpper(string(personType[:1])) + string(personType[1:])
+}
+
+// getEditableCase loads a case whose people the user may change. Like evidence, the
+// people of a closed case are read-only until it is reopened.
+func (s *PersonService) getEditableCase(user *model.User, caseID uint) (*model.Case, error) {
+	caseData, err := getAccessibleCase(s.caseRepo, user, caseID)
+	if err != nil {
+		return nil, err
+	}
+	if user.Role == model.RoleOfficer {
+		assigned, err := s.caseRepo.IsAssigned(caseData.ID, user.ID)
+		if err != nil {
+			return nil, err
+		}
+		if !assigned {
+			return nil, fmt.Errorf("%w: officer must be assigned to the case to change its people", ErrForbidden)
+		}
+	}
+	if caseData.Status == model.StatusClosed {
+		return nil, fmt.Errorf("%w: case is closed, reopen it to change its people", ErrConflict)
+	}
+	return caseData, nil
+}
+
+// personField is a change to one field, recorded in its own audit log entry
+type personField struct {
+	name     string // as named in the API
+	column   string
+	oldValue string
+	newValue string
+	value    interface{}
+}
+
+// applyPersonChanges validates the changes, sets them on the record and returns the fields that changed
+func applyPersonChanges(personType model.PersonType, record interface{}, person *model.Person, changes PersonChanges) ([]personField, error) {
+	var fields []personField
+	setString := func(name, column string, current *string, value *string) {
+		if value == nil {
+			return
+		}
+		trimmed := strings.TrimSpace(*value)
+		if trimmed == *current {
+			return
+		}
+		fields = append(fields, personField{name: name, column: column, oldValue: *current, newValue: trimmed, value: trimmed})
+		*current = trimmed
+	}
+
+	if (changes.FirstName != nil && strings.TrimSpace(*changes.FirstName) == "") ||
+		(changes.LastName != nil && strings.TrimSpace(*changes.LastName) == "") {
+		return nil, fmt.Errorf("%w: first and last name cannot be empty", ErrInvalidInput)
+	}
+	setString("firstName", "first_name", &person.FirstName, changes.FirstName)
+	setString("lastName", "last_name", &person.LastName, changes.LastName)
+	if age := changes.Age; age != nil && *age != person.Age {
+		if *age < 0 || *age > 150 {
+			return nil, fmt.Errorf("%w: age must be between 0 and 150", ErrInvalidInput)
+		}
+		fields = append(fields, personField{name: "age", column: "age", oldValue: strconv.Itoa(person.Age), newValue: strconv.Itoa(*age), value: *age})
+		person.Age = *age
+	}
+	setString("gender", "gender", &person.Gender, changes.Gender)
+	setString("address", "address", &person.Address, changes.Address)
+	setString("phoneNumber", "phone_number", &person.PhoneNumber, changes.PhoneNumber)
+	setString("notes", "notes", &person.Notes, changes.Notes)
+
+	if personType != model.PersonSuspect && (changes.Description != nil || changes.IsArrested != nil) {
+		return nil, fmt.Errorf("%w: description and isArrested only apply to suspects", ErrInvalidInput)
+	}
+	if personType != model.PersonVictim && changes.InjuryDescription != nil {
+		return nil, fmt.Errorf("%w: injuryDescription only applies to victims", ErrInvalidInput)
+	}
+	if personType != model.PersonWitness && changes.Statement != nil {
+		return nil, fmt.Errorf("%w: statement only applies to witnesses", ErrInvalidInput)
+	}
+
+	switch r := record.(type) {
+	case *model.Suspect:
+		setString("description", "description", &r.Description, changes.Description)
+		if arrested := changes.IsArrested; arrested != nil && *arrested != r.IsArrested {
+			fields = append(fields, personField{name: "isArrested", column: "is_arrested", oldValue: strconv.FormatBool(r.IsArrested), newValue: strconv.FormatBool(*arrested), value: *arrested})
+			r.IsArrested = *arrested
+		}
+	case *model.Victim:
+		setString("injuryDescription", "injury_description", &r.InjuryDescription, changes.InjuryDescription)
+	case *model.Witness:
+		setString("statement", "statement", &r.Statement, changes.Statement)
+	}
+	return fields, nil
+}
+
+// CreatePerson adds a suspect, victim or witness to a case. Officers must be assigned to the case.
+func (s *PersonService) CreatePerson(user *model.User, caseID uint, personType model.PersonType, changes PersonChanges) (interface{}, error) {
+	caseData, err := s.getEditableCase(user, caseID)
+	if err != nil {
+		return nil, err
+	}
+	if changes.FirstName == nil || changes.LastName == nil {
+		return nil, fmt.Errorf("%w: first and last name are required", ErrInvalidInput)
+	}
+
+	record, person := newPersonRecord(personType)
+	if _, err := applyPersonChanges(personType, record, person, changes); err != nil {
+		return nil, err
+	}
+	person.CaseID = caseData.ID
+	person.AddedByID = user.ID
+
+	err = s.personRepo.Create(record, person, &model.AuditLog{
+		UserID:     user.ID,
+		Action:     model.ActionCreate,
+		EntityType: string(personType),
+		CaseID:     &caseData.ID,
+		NewValue:   fmt.Sprintf("%s %s %s added", personLabel(personType), person.FirstName, person.LastName),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// UpdatePerson changes the given fields of a person and records each changed field in the case history
+func (s *PersonService) UpdatePerson(user *model.User, caseID, id uint, personType model.PersonType, changes PersonChanges) (interface{}, error) {
+	caseData, err := s.getEditableCase(user, caseID)
+	if err != nil {
+		return nil, err
+	}
+	record, person := newPersonRecord(personType)
+	if err := s.personRepo.Get(record, caseData.ID, id); err != nil {
+		return nil, fmt.Errorf("%w: %s not found", ErrNotFound, personType)
+	}
+
+	fields, err := applyPersonChanges(personType, record, person, changes)
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return record, nil
+	}
+
+	columns := map[string]interface{}{}
+	auditLogs := make([]*model.AuditLog, 0, len(fields))
+	for _, field := range fields {
+		columns[field.column] = field.value
+		auditLogs = append(auditLogs, &model.AuditLog{
+			UserID:     user.ID,
+			Action:     model.ActionUpdate,
+			EntityType: string(personType),
+			EntityID:   person.ID,
+			CaseID:     &caseData.ID,
+			OldValue:   field.name + ": " + field.oldValue,
+			NewValue:   field.name + ": " + field.newValue,
+		})
+	}
+	if err := s.personRepo.UpdateFields(record, person, columns, auditLogs); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// DeletePerson removes a person from a case
+func (s *PersonService) DeletePerson(user *model.User, caseID, id uint, personType model.PersonType) error {
+	caseData, err := s.getEditableCase(user, caseID)
+	if err != nil {
+		return err
+	}
+	record, person := newPersonRecord(personType)
+	if err := s.personRepo.Get(record, caseData.ID, id); err != nil {
+		return fmt.Errorf("%w: %s not found", ErrNotFound, personType)
+	}
+
+	return s.personRepo.Delete(record, &model.AuditLog{
+		UserID:     user.ID,
+		Action:     model.ActionDelete,
+		EntityType: string(personType),
+		EntityID:   person.ID,
+		CaseID:     &caseData.ID,
+		OldValue:   person.FirstName + " " + person.LastName,
+	})
+}
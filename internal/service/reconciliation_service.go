@@ -0,0 +1,333 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"github.com/m7medVision/crime-management-system/internal/repository"
+	"github.com/m7medVision/crime-management-system/internal/util"
+	"gorm.io/gorm"
+)
+
+// Reconciliation modes for assignees who are no longer cleared for their case, set with CASE_RECONCILE_MODE
+const (
+	ReconcileFlag   = "flag"   // keep the assignment but mark it ineligible
+	ReconcileRemove = "remove" // unassign the user
+)
+
+type ReconcileAction string
+
+const (
+	ReconcileRemoved  ReconcileAction = "removed"
+	ReconcileFlagged  ReconcileAction = "flagged"
+	ReconcileRestored ReconcileAction = "restored" // a flagged assignee is cleared for the case again
+)
+
+// ReconciliationItem is one assignment changed, or to be changed, by a reconciliation
+type ReconciliationItem struct {
+	CaseID             uint
+	ReferenceNumber    string
+	AuthorizationLevel model.ClearanceLevel
+	UserID             uint
+	FullName           string
+	ClearanceLevel     model.ClearanceLevel
+	Role               model.AssignmentRole
+	Action             ReconcileAction
+}
+
+// ReconciliationReport lists the assignments affected by a clearance or authorization change.
+// A dry run reports what would happen without changing anything.
+type ReconciliationReport struct {
+	DryRun bool
+	Mode   string
+	Items  []ReconciliationItem
+}
+
+// reconcileCandidate is an assignment checked against a new clearance or authorization level
+type reconcileCandidate struct {
+	item            ReconciliationItem
+	ineligibleSince *time.Time
+}
+
+// AssignmentReconciler keeps case assignments in line with clearance. It runs when a
+// user's clearance level or a case's authorization level changes.
+type AssignmentReconciler struct {
+	caseRepo *repository.CaseRepository
+	userRepo *repository.UserRepository
+	notifier *NotificationService
+	mode     string
+}
+
+func NewAssignmentReconciler(
+	caseRepo *repository.CaseRepository,
+	userRepo *repository.UserRepository,
+	notifier *NotificationService,
+	mode string,
+) *AssignmentReconciler {
+	if mode != ReconcileRemove {
+		mode = ReconcileFlag
+	}
+	return &AssignmentReconciler{
+		caseRepo: caseRepo,
+		userRepo: userRepo,
+		notifier: notifier,
+		mode:     mode,
+	}
+}
+
+// ForUser reconciles the assignments of a user against the given clearance level
+func (r *AssignmentReconciler) ForUser(userID uint, clearance model.ClearanceLevel, actorID uint, dryRun bool) (*ReconciliationReport, error) {
+	if util.ClearanceLevelToInt(clearance) == 0 {
+		return nil, fmt.Errorf("%w: invalid clearance level %q", ErrInvalidInput, clearance)
+	}
+	user, err := r.userRepo.GetByID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: user not found", ErrNotFound)
+	}
+
+	candidates, err := r.userCandidates(user, clearance)
+	if err != nil {
+		return nil, err
+	}
+	return r.reconcile(candidates, actorID, dryRun, nil)
+}
+
+// UpdateUser saves a user whose clearance level changed and reconciles their assignments
+// against the new level in one transaction
+func (r *AssignmentReconciler) UpdateUser(user *model.User, actorID uint) (*ReconciliationReport, error) {
+	if util.ClearanceLevelToInt(user.ClearanceLevel) == 0 {
+		return nil, fmt.Errorf("%w: invalid clearance level %q", ErrInvalidInput, user.ClearanceLevel)
+	}
+	candidates, err := r.userCandidates(user, user.ClearanceLevel)
+	if err != nil {
+		return nil, err
+	}
+	return r.reconcile(candidates, actorID, false, user)
+}
+
+// userCandidates returns the assignments of a user, to be checked against the clearance level
+func (r *AssignmentReconciler) userCandidates(user *model.User, clearance model.ClearanceLevel) ([]reconcileCandidate, error) {
+	assignments, err := r.caseRepo.ListUserAssignments(user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]reconcileCandidate, 0, len(assignments))
+	for _, assignment := range assignments {
+		candidates = append(candidates, reconcileCandidate{
+			item: ReconciliationItem{
+				CaseID:             assignment.CaseID,
+				ReferenceNumber:    assignment.ReferenceNumber,
+				AuthorizationLevel: assignment.AuthorizationLevel,
+				UserID:             user.ID,
+				FullName:           user.FullName,
+				ClearanceLevel:     clearance,
+				Role:               assignment.Role,
+			},
+			ineligibleSince: assignment.IneligibleSince,
+		})
+	}
+	return candidates, nil
+}
+
+// ForCase reconciles the assignees of a case against the given authorization level
+func (r *AssignmentReconciler) ForCase(caseID uint, level model.ClearanceLevel, actorID uint, dryRun bool) (*ReconciliationReport, error) {
+	if util.ClearanceLevelToInt(level) == 0 {
+		return nil, fmt.Errorf("%w: invalid authorization level %q", ErrInvalidInput, level)
+	}
+	caseData, err := r.caseRepo.GetByID(caseID)
+	if err != nil {
+		return nil, ErrCaseNotFound
+	}
+
+	assignments, err := r.caseRepo.ListAssignments(caseID)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]reconcileCandidate, 0, len(assignments))
+	for _, assignment := range assignments {
+		candidates = append(candidates, reconcileCandidate{
+			item: ReconciliationItem{
+				CaseID:             caseData.ID,
+				ReferenceNumber:    caseData.ReferenceNumber,
+				AuthorizationLevel: level,
+				UserID:             assignment.ID,
+				FullName:           assignment.FullName,
+				ClearanceLevel:     assignment.ClearanceLevel,
+				Role:               assignment.Role,
+			},
+			ineligibleSince: assignment.IneligibleSince,
+		})
+	}
+	return r.reconcile(candidates, actorID, dryRun, nil)
+}
+
+// ForMerge reconciles the assignees of a case against the authorization level of the
+// case it is merged into. The changes are passed to merge, which applies them along with
+// the merge; the lead of the target case is told once the merge succeeded.
+func (r *AssignmentReconciler) ForMerge(sourceID uint, target *model.Case, actorID uint, merge func([]repository.AssignmentChange) error) error {
+	assignments, err := r.caseRepo.ListAssignments(sourceID)
+	if err != nil {
+		return err
+	}
+
+	candidates := make([]reconcileCandidate, 0, len(assignments))
+	for _, assignment := range assignments {
+		candidates = append(candidates, reconcileCandidate{
+			item: ReconciliationItem{
+				CaseID:             target.ID,
+				ReferenceNumber:    target.ReferenceNumber,
+				AuthorizationLevel: target.AuthorizationLevel,
+				UserID:             assignment.ID,
+				FullName:           assignment.FullName,
+				ClearanceLevel:     assignment.ClearanceLevel,
+				Role:               assignment.Role,
+			},
+			ineligibleSince: assignment.IneligibleSince,
+		})
+	}
+
+	report := r.plan(candidates, false)
+	if err := merge(assignmentChanges(report.Items, actorID)); err != nil {
+		return err
+	}
+	r.notifyLeads(report.Items, actorID)
+	return nil
+}
+
+// reconcile decides what happens to each assignment and, unless this is a dry run,
+// applies it and writes the case history in one transaction, then tells the lead of
+// every affected case. A non-nil user is saved in the same transaction.
+func (r *AssignmentReconciler) reconcile(candidates []reconcileCandidate, actorID uint, dryRun bool, user *model.User) (*ReconciliationReport, error) {
+	report := r.plan(candidates, dryRun)
+	if dryRun {
+		return report, nil
+	}
+	if err := r.caseRepo.ApplyAssignmentChanges(user, assignmentChanges(report.Items, actorID)); err != nil {
+		return nil, err
+	}
+
+	r.notifyLeads(report.Items, actorID)
+	return report, nil
+}
+
+// plan decides what happens to each assignment
+func (r *AssignmentReconciler) plan(candidates []reconcileCandidate, dryRun bool) *ReconciliationReport {
+	report := &ReconciliationReport{DryRun: dryRun, Mode: r.mode, Items: []ReconciliationItem{}}
+
+	for _, candidate := range candidates {
+		item := candidate.item
+		eligible := util.IsClearnceLevelHigherOrEqual(item.ClearanceLevel, item.AuthorizationLevel)
+		switch {
+		case !eligible && r.mode == ReconcileRemove:
+			item.Action = ReconcileRemoved
+		case !eligible && candidate.ineligibleSince == nil:
+			item.Action = ReconcileFlagged
+		case eligible && candidate.ineligibleSince != nil:
+			item.Action = ReconcileRestored
+		default:
+			continue
+		}
+		report.Items = append(report.Items, item)
+	}
+	return report
+}
+
+// assignmentChanges turns reconciliation items into the changes to apply
+func assignmentChanges(items []ReconciliationItem, actorID uint) []repository.AssignmentChange {
+	now := time.Now()
+	changes := make([]repository.AssignmentChange, 0, len(items))
+	for _, item := range items {
+		changes = append(changes, assignmentChange(item, actorID, now))
+	}
+	return changes
+}
+
+// assignmentChange turns a reconciliation item into the change to its assignment and the audit log recording it
+func assignmentChange(item ReconciliationItem, actorID uint, now time.Time) repository.AssignmentChange {
+	caseID := item.CaseID
+	change := repository.AssignmentChange{CaseID: item.CaseID, UserID: item.UserID}
+	auditLog := &model.AuditLog{
+		UserID:     actorID,
+		Action:     model.ActionUpdate,
+		EntityType: "assignee_eligibility",
+		EntityID:   item.UserID,
+		CaseID:     &caseID,
+	}
+
+	switch item.Action {
+	case ReconcileRemoved:
+		change.Remove = true
+		auditLog.Action = model.ActionDelete
+		auditLog.EntityType = "case_assignee"
+		auditLog.OldValue = fmt.Sprintf("%s unassigned from case: %s clearance is below the %s authorization level",
+			item.FullName, item.ClearanceLevel, item.AuthorizationLevel)
+
+	case ReconcileFlagged:
+		change.IneligibleSince = &now
+		auditLog.OldValue = "eligible"
+		auditLog.NewValue = fmt.Sprintf("%s flagged: %s clearance is below the %s authorization level",
+			item.FullName, item.ClearanceLevel, item.AuthorizationLevel)
+
+	case ReconcileRestored:
+		auditLog.OldValue = "ineligible"
+		auditLog.NewValue = fmt.Sprintf("%s is cleared for the case again", item.FullName)
+	}
+
+	change.AuditLog = auditLog
+	return change
+}
+
+// notifyLeads sends one notification per case to its lead, unless the lead is among
+// the affected assignees or made the change themselves
+func (r *AssignmentReconciler) notifyLeads(items []ReconciliationItem, actorID uint) {
+	byCase := map[uint][]ReconciliationItem{}
+	var caseIDs []uint
+	for _, item := range items {
+		if _, ok := byCase[item.CaseID]; !ok {
+			caseIDs = append(caseIDs, item.CaseID)
+		}
+		byCase[item.CaseID] = append(byCase[item.CaseID], item)
+	}
+
+	for _, caseID := range caseIDs {
+		lead, err := r.caseRepo.GetLead(caseID)
+		if err != nil {
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				log.Printf("Failed to load the lead of case %d: %v", caseID, err)
+			}
+			continue
+		}
+		if lead.UserID == actorID {
+			continue
+		}
+
+		caseItems := byCase[caseID]
+		lines := make([]string, 0, len(caseItems))
+		leadAffected := false
+		for _, item := range caseItems {
+			if item.UserID == lead.UserID && item.Action != ReconcileRestored {
+				leadAffected = true
+			}
+			lines = append(lines, fmt.Sprintf("%s (%s): %s", item.FullName, item.Role, item.Action))
+		}
+		if leadAffected {
+			continue
+		}
+
+		id, actor := caseID, actorID
+		r.notifier.Notify(
+			[]uint{lead.UserID},
+			model.NotificationAssigneeIneligible,
+			fmt.Sprintf("Assignees of case %s changed after a clearance change", caseItems[0].ReferenceNumber),
+			strings.Join(lines, "\n"),
+			&id,
+			&actor,
+		)
+	}
+}
@@ -0,0 +1,82 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m7medVision/crime-management-system/internal/model"
+)
+
+func TestReconcilePlan(t *testing.T) {
+	since := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	candidate := func(userID uint, clearance, level model.ClearanceLevel, ineligibleSince *time.Time) reconcileCandidate {
+		return reconcileCandidate{
+			item:            ReconciliationItem{CaseID: 1, UserID: userID, ClearanceLevel: clearance, AuthorizationLevel: level},
+			ineligibleSince: ineligibleSince,
+		}
+	}
+	candidates := []reconcileCandidate{
+		candidate(1, model.ClearanceHigh, model.ClearanceMedium, nil),   // cleared, unchanged
+		candidate(2, model.ClearanceLow, model.ClearanceMedium, nil),    // newly not cleared
+		candidate(3, model.ClearanceLow, model.ClearanceMedium, &since), // already flagged
+		candidate(4, model.ClearanceHigh, model.ClearanceHigh, &since),  // cleared again
+	}
+
+	tests := []struct {
+		mode string
+		want map[uint]ReconcileAction
+	}{
+		{ReconcileFlag, map[uint]ReconcileAction{2: ReconcileFlagged, 4: ReconcileRestored}},
+		{ReconcileRemove, map[uint]ReconcileAction{2: ReconcileRemoved, 3: ReconcileRemoved, 4: ReconcileRestored}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.mode, func(t *testing.T) {
+			r := &AssignmentReconciler{mode: tt.mode}
+			report := r.plan(candidates, true)
+			if !report.DryRun || report.Mode != tt.mode {
+				t.Errorf("report dryRun %v mode %q", report.DryRun, report.Mode)
+			}
+
+			got := map[uint]ReconcileAction{}
+			for _, item := range report.Items {
+				got[item.UserID] = item.Action
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("actions = %v, want %v", got, tt.want)
+			}
+			for userID, action := range tt.want {
+				if got[userID] != action {
+					t.Errorf("action for user %d = %q, want %q", userID, got[userID], action)
+				}
+			}
+		})
+	}
+}
+
+func TestAssignmentChanges(t *testing.T) {
+	items := []ReconciliationItem{
+		{CaseID: 1, UserID: 2, Action: ReconcileRemoved},
+		{CaseID: 1, UserID: 3, Action: ReconcileFlagged},
+		{CaseID: 1, UserID: 4, Action: ReconcileRestored},
+	}
+	changes := assignmentChanges(items, 9)
+	if len(changes) != 3 {
+		t.Fatalf("got %d changes, want 3", len(changes))
+	}
+
+	removed, flagged, restored := changes[0], changes[1], changes[2]
+	if !removed.Remove || removed.AuditLog.Action != model.ActionDelete {
+		t.Errorf("removal = %+v", removed)
+	}
+	if flagged.Remove || flagged.IneligibleSince == nil {
+		t.Errorf("flag = %+v", flagged)
+	}
+	if restored.Remove || restored.IneligibleSince != nil {
+		t.Errorf("restore = %+v", restored)
+	}
+	for _, change := range changes {
+		if change.AuditLog.UserID != 9 || change.AuditLog.CaseID == nil || *change.AuditLog.CaseID != 1 || change.AuditLog.EntityID != change.UserID {
+			t.Errorf("audit log of user %d = %+v", change.UserID, change.AuditLog)
+		}
+	}
+}
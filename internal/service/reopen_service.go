@@ -0,0 +1,318 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/m7medVision/crime-management-system/internal/config"
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"github.com/m7medVision/crime-management-system/internal/repository"
+	"github.com/m7medVision/crime-management-system/internal/util"
+	"gorm.io/gorm"
+)
+
+// errReopenRequired is returned when a closed case is reopened by a plain status change
+var errReopenRequired = errors.New("closed cases are reopened with a reason through POST /cases/{id}/reopen")
+
+type ReopenService struct {
+	reopenRepo     *repository.ReopenRepository
+	caseRepo       *repository.CaseRepository
+	userRepo       *repository.UserRepository
+	notifier       *NotificationService
+	approvalPeriod time.Duration
+}
+
+func NewReopenService(
+	reopenRepo *repository.ReopenRepository,
+	caseRepo *repository.CaseRepository,
+	userRepo *repository.UserRepository,
+	notifier *NotificationService,
+	closureConfig config.ClosureConfig,
+) *ReopenService {
+	return &ReopenService{
+		reopenRepo:     reopenRepo,
+		caseRepo:       caseRepo,
+		userRepo:       userRepo,
+		notifier:       notifier,
+		approvalPeriod: time.Duration(closureConfig.ReopenApprovalDays) * 24 * time.Hour,
+	}
+}
+
+// approvalRequired reports whether reopening the case needs a supervisor's approval
+func (s *ReopenService) approvalRequired(user *model.User, caseData *model.Case) bool {
+	if isSupervisor(user) || caseData.ClosedAt == nil {
+		return false
+	}
+	return time.Since(*caseData.ClosedAt) > s.approvalPeriod
+}
+
+// ReopenCase moves a closed case back to ongoing and restores its archived evidence.
+// Cases closed longer than the approval period wait for a supervisor unless a supervisor
+// reopens them. When expectedVersion is given the case must still be at that version.
+func (s *ReopenService) ReopenCase(user *model.User, caseID uint, reason string, expectedVersion *int) (*model.CaseReopen, error) {
+	caseData, err := getAccessibleCase(s.caseRepo, user, caseID)
+	if err != nil {
+		return nil, err
+	}
+	if expectedVersion != nil && *expectedVersion != caseData.Version {
+		return nil, fmt.Errorf("%w: case has been modified, it is now at version %d", ErrPreconditionFailed, caseData.Version)
+	}
+	if user.Role == model.RoleOfficer {
+		assigned, err := s.caseRepo.IsAssigned(caseData.ID, user.ID)
+		if err != nil {
+			return nil, err
+		}
+		if !assigned {
+			return nil, fmt.Errorf("%w: officer must be assigned to the case to reopen it", ErrForbidden)
+		}
+	}
+
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, fmt.Errorf("%w: a reason is required to reopen a case", ErrInvalidInput)
+	}
+	if err := checkReopenable(caseData); err != nil {
+		return nil, err
+	}
+	if _, err := s.reopenRepo.GetPending(caseData.ID); err == nil {
+		return nil, fmt.Errorf("%w: a reopen request for this case is already waiting for approval", ErrConflict)
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+
+	reopen := &model.CaseReopen{
+		CaseID:              caseData.ID,
+		Reason:              reason,
+		PreviousDisposition: caseData.Disposition,
+		ClosedAt:            caseData.ClosedAt,
+		Status:              model.ReopenPending,
+		RequestedByID:       user.ID,
+	}
+
+	if !s.approvalRequired(user, caseData) {
+		if err := s.reopen(user, reopen, caseData, expectedVersion); err != nil {
+			return nil, err
+		}
+		return s.reopenRepo.GetByID(reopen.ID)
+	}
+
+	caseID = caseData.ID
+	err = s.reopenRepo.Request(reopen, &model.AuditLog{
+		UserID:     user.ID,
+		Action:     model.ActionCreate,
+		EntityType: "case_reopen",
+		CaseID:     &caseID,
+		NewValue:   "Reopen requested: " + reason,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	supervisors, err := s.userRepo.ListEscalationRecipients()
+	if err != nil {
+		return nil, err
+	}
+	var recipients []uint
+	for i := range supervisors {
+		if supervisors[i].ID != user.ID && canAccessCase(&supervisors[i], caseData) {
+			recipients = append(recipients, supervisors[i].ID)
+		}
+	}
+	actorID := user.ID
+	s.notifier.Notify(
+		recipients,
+		model.NotificationReopenRequested,
+		fmt.Sprintf("%s asks to reopen case %s", user.FullName, caseData.ReferenceNumber),
+		reason,
+		&caseID,
+		&actorID,
+	)
+
+	return s.reopenRepo.GetByID(reopen.ID)
+}
+
+func checkReopenable(caseData *model.Case) error {
+	if caseData.MergedIntoID != nil {
+		return fmt.Errorf("%w: case was merged into another case, reopen that case instead", ErrConflict)
+	}
+	if caseData.Status != model.StatusClosed {
+		return fmt.Errorf("%w: case is not closed", ErrConflict)
+	}
+	return nil
+}
+
+// reopen approves the request, moves the case back to ongoing and restores its evidence
+func (s *ReopenService) reopen(user *model.User, reopen *model.CaseReopen, caseData *model.Case, expectedVersion *int) error {
+	now := time.Now()
+	reopen.Status = model.ReopenApproved
+	reopen.DecidedByID = &user.ID
+	reopen.DecidedAt = &now
+
+	oldStatus := caseData.Status
+	fields := setCaseStatus(caseData, model.StatusOngoing)
+
+	caseID := caseData.ID
+	auditLogs := []*model.AuditLog{
+		{
+			UserID:     user.ID,
+			Action:     model.ActionUpdate,
+			EntityType: "case_status",
+			EntityID:   caseID,
+			CaseID:     &caseID,
+			OldValue:   string(oldStatus),
+			NewValue:   string(model.StatusOngoing),
+		},
+		{
+			UserID:     user.ID,
+			Action:     model.ActionUpdate,
+			EntityType: "case_reopened",
+			CaseID:     &caseID,
+			OldValue:   string(reopen.PreviousDisposition),
+			NewValue:   reopen.Reason,
+		},
+	}
+	if reopen.ID != 0 {
+		auditLogs = append(auditLogs, &model.AuditLog{
+			UserID:     user.ID,
+			Action:     model.ActionUpdate,
+			EntityType: "case_reopen",
+			EntityID:   reopen.ID,
+			CaseID:     &caseID,
+			OldValue:   string(model.ReopenPending),
+			NewValue:   string(model.ReopenApproved),
+		})
+	}
+
+	if err := s.reopenRepo.Reopen(reopen, caseData, fields, auditLogs); err != nil {
+		if errors.Is(err, repository.ErrReopenNotPending) {
+			return fmt.Errorf("%w: %v", ErrConflict, err)
+		}
+		return versionConflict(err, expectedVersion)
+	}
+	if reopen.RestoredEvidence > 0 {
+		s.caseRepo.CreateAuditLog(&model.AuditLog{
+			UserID:     user.ID,
+			Action:     model.ActionUpdate,
+			EntityType: "evidence_restored",
+			EntityID:   caseID,
+			CaseID:     &caseID,
+			NewValue:   fmt.Sprintf("%d evidence items restored from the archive", reopen.RestoredEvidence),
+		})
+	}
+
+	notifyStatusChanged(s.notifier, caseData, user.ID, oldStatus)
+	return nil
+}
+
+// getPending loads a reopen request that is still waiting for approval
+func (s *ReopenService) getPending(user *model.User, id uint) (*model.CaseReopen, error) {
+	reopen, err := s.reopenRepo.GetByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: reopen request not found", ErrNotFound)
+	}
+	if !canAccessCase(user, &reopen.Case) {
+		return nil, ErrInsufficientClearance
+	}
+	if reopen.Status != model.ReopenPending {
+		return nil, fmt.Errorf("%w: reopen request is already %s", ErrConflict, reopen.Status)
+	}
+	return reopen, nil
+}
+
+// Approve reopens the case of a pending request
+func (s *ReopenService) Approve(user *model.User, id uint, response string) (*model.CaseReopen, error) {
+	if !isSupervisor(user) {
+		return nil, fmt.Errorf("%w: only supervisors can approve a reopen request", ErrForbidden)
+	}
+	reopen, err := s.getPending(user, id)
+	if err != nil {
+		return nil, err
+	}
+	caseData, err := s.caseRepo.GetByID(reopen.CaseID)
+	if err != nil {
+		return nil, ErrCaseNotFound
+	}
+	if err := checkReopenable(caseData); err != nil {
+		return nil, err
+	}
+
+	reopen.Response = response
+	if err := s.reopen(user, reopen, caseData, nil); err != nil {
+		return nil, err
+	}
+	s.notifyDecision(reopen, user.ID)
+	return s.reopenRepo.GetByID(reopen.ID)
+}
+
+// Reject turns down a pending reopen request. The case stays closed.
+func (s *ReopenService) Reject(user *model.User, id uint, response string) (*model.CaseReopen, error) {
+	if !isSupervisor(user) {
+		return nil, fmt.Errorf("%w: only supervisors can reject a reopen request", ErrForbidden)
+	}
+	if strings.TrimSpace(response) == "" {
+		return nil, fmt.Errorf("%w: a reason is required to reject a reopen request", ErrInvalidInput)
+	}
+	reopen, err := s.getPending(user, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	reopen.Status = model.ReopenRejected
+	reopen.DecidedByID = &user.ID
+	reopen.DecidedAt = &now
+	reopen.Response = response
+
+	caseID := reopen.CaseID
+	err = s.reopenRepo.Reject(reopen, &model.AuditLog{
+		UserID:     user.ID,
+		Action:     model.ActionUpdate,
+		EntityType: "case_reopen",
+		EntityID:   reopen.ID,
+		CaseID:     &caseID,
+		OldValue:   string(model.ReopenPending),
+		NewValue:   string(model.ReopenRejected),
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrReopenNotPending) {
+			return nil, fmt.Errorf("%w: %v", ErrConflict, err)
+		}
+		return nil, err
+	}
+
+	s.notifyDecision(reopen, user.ID)
+	return s.reopenRepo.GetByID(reopen.ID)
+}
+
+// notifyDecision tells the requester whether the case was reopened
+func (s *ReopenService) notifyDecision(reopen *model.CaseReopen, actorID uint) {
+	if reopen.RequestedByID == actorID {
+		return
+	}
+	caseID := reopen.CaseID
+	s.notifier.Notify(
+		[]uint{reopen.RequestedByID},
+		model.NotificationReopenDecided,
+		fmt.Sprintf("Reopening of case %s was %s", reopen.Case.ReferenceNumber, reopen.Status),
+		reopen.Response,
+		&caseID,
+		&actorID,
+	)
+}
+
+// ListCaseReopens returns the reopen history of a case
+func (s *ReopenService) ListCaseReopens(user *model.User, caseID uint) ([]model.CaseReopen, error) {
+	if _, err := getAccessibleCase(s.caseRepo, user, caseID); err != nil {
+		return nil, err
+	}
+	return s.reopenRepo.ListByCase(caseID)
+}
+
+// ListPending returns the reopen requests waiting for approval on cases the supervisor is cleared for
+func (s *ReopenService) ListPending(user *model.User) ([]model.CaseReopen, error) {
+	// Never leave the list nil, which would disable the clearance filter
+	allowedLevels := append([]model.ClearanceLevel{}, util.ClearanceLevelsUpTo(user.ClearanceLevel)...)
+	return s.reopenRepo.ListPending(allowedLevels)
+}
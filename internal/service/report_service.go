@@ -3,6 +3,9 @@ package service
 import (
 	"bytes"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"codeberg.org/go-pdf/fpdf"
@@ -11,24 +14,80 @@ import (
 )
 
 type CaseReportData struct {
-	Case      model.Case
-	Evidence  []model.Evidence
-	Suspects  []model.Suspect
-	Victims   []model.Victim
-	Witnesses []model.Witness
-	CreatedAt string
+	Case         model.Case
+	CustomFields []CustomFieldRow
+	Evidence     []model.Evidence
+	Suspects     []model.Suspect
+	Victims      []model.Victim
+	Witnesses    []model.Witness
+	CreatedAt    string
+}
+
+// CustomFieldRow is a custom field value formatted for the report
+type CustomFieldRow struct {
+	Label string
+	Value string
 }
 
 type ReportService struct {
-	caseRepo *repository.CaseRepository
+	caseRepo   *repository.CaseRepository
+	schemaRepo *repository.CustomFieldSchemaRepository
 }
 
-func NewReportService(caseRepo *repository.CaseRepository) (*ReportService, error) {
+func NewReportService(caseRepo *repository.CaseRepository, schemaRepo *repository.CustomFieldSchemaRepository) (*ReportService, error) {
 	return &ReportService{
-		caseRepo: caseRepo,
+		caseRepo:   caseRepo,
+		schemaRepo: schemaRepo,
 	}, nil
 }
 
+// customFieldRows lists the custom field values in schema order, followed by any
+// values whose field was since removed from the schema
+func customFieldRows(schema *model.CustomFieldSchema, values model.CustomFields) []CustomFieldRow {
+	var rows []CustomFieldRow
+	shown := map[string]bool{}
+	if schema != nil {
+		for _, field := range schema.Fields {
+			if value, ok := values[field.Key]; ok {
+				rows = append(rows, CustomFieldRow{Label: field.Label, Value: formatCustomFieldValue(value)})
+				shown[field.Key] = true
+			}
+		}
+	}
+
+	var rest []string
+	for key := range values {
+		if !shown[key] {
+			rest = append(rest, key)
+		}
+	}
+	sort.Strings(rest)
+	for _, key := range rest {
+		rows = append(rows, CustomFieldRow{Label: key, Value: formatCustomFieldValue(values[key])})
+	}
+	return rows
+}
+
+// dispositionLabel turns a disposition code into words, e.g. "Insufficient evidence"
+func dispositionLabel(disposition model.CaseDisposition) string {
+	label := strings.ReplaceAll(string(disposition), "_", " ")
+	return strings.ToUpper(label[:1]) + label[1:]
+}
+
+func formatCustomFieldValue(value interface{}) string {
+	switch v := value.(type) {
+	case bool:
+		if v {
+			return "Yes"
+		}
+		return "No"
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
 func (s *ReportService) GenerateCaseReport(caseID uint) ([]byte, error) {
 	// Get case details
 	caseData, err := s.caseRepo.GetByID(caseID)
@@ -36,35 +95,41 @@ func (s *ReportService) GenerateCaseReport(caseID uint) ([]byte, error) {
 		return nil, fmt.Errorf("failed to get case: %w", err)
 	}
 
+	schema, err := getCustomFieldSchema(s.schemaRepo, caseData.CaseType)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get custom field schema: %w", err)
+	}
+
 	// Get related data
-	evidence, err := s.caseRepo.GetEvidence(caseID)
+	evidence, err := s.caseRepo.GetEvidence(caseID, repository.TagFilter{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get evidence: %w", err)
 	}
 
-	suspects, err := s.caseRepo.GetSuspects(caseID)
+	suspects, err := s.caseRepo.GetSuspects(caseID, repository.TagFilter{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get suspects: %w", err)
 	}
 
-	victims, err := s.caseRepo.GetVictims(caseID)
+	victims, err := s.caseRepo.GetVictims(caseID, repository.TagFilter{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get victims: %w", err)
 	}
 
-	witnesses, err := s.caseRepo.GetWitnesses(caseID)
+	witnesses, err := s.caseRepo.GetWitnesses(caseID, repository.TagFilter{})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get witnesses: %w", err)
 	}
 
 	// Prepare data for template
 	reportData := CaseReportData{
-		Case:      *caseData,
-		Evidence:  evidence,
-		Suspects:  suspects,
-		Victims:   victims,
-		Witnesses: witnesses,
-		CreatedAt: time.Now().Format("January 2, 2006"),
+		Case:         *caseData,
+		CustomFields: customFieldRows(schema, caseData.CustomFields),
+		Evidence:     evidence,
+		Suspects:     suspects,
+		Victims:      victims,
+		Witnesses:    witnesses,
+		CreatedAt:    time.Now().Format("January 2, 2006"),
 	}
 
 	// Generate PDF directly using fpdf
@@ -92,7 +157,11 @@ func GeneratePDFFromData(data CaseReportData) ([]byte, error) {
 
 	// Subtitle
 	pdf.SetFont("Arial", "B", 12)
-	pdf.Cell(0, 10, fmt.Sprintf("Case #%d - %s", data.Case.ID, data.Case.Name))
+	caseLabel := fmt.Sprintf("#%d", data.Case.ID)
+	if data.Case.ReferenceNumber != "" {
+		caseLabel = data.Case.ReferenceNumber
+	}
+	pdf.Cell(0, 10, fmt.Sprintf("Case %s - %s", caseLabel, data.Case.Name))
 	pdf.Ln(6)
 
 	// Date
@@ -104,8 +173,15 @@ func GeneratePDFFromData(data CaseReportData) ([]byte, error) {
 	addSectionTitle(pdf, "Case Information")
 
 	// Case details
+	addTableRow(pdf, "Reference Number:", data.Case.ReferenceNumber)
 	addTableRow(pdf, "Case Number:", fmt.Sprintf("%d", data.Case.ID))
 	addTableRow(pdf, "Status:", string(data.Case.Status))
+	if data.Case.Disposition != "" {
+		addTableRow(pdf, "Disposition:", dispositionLabel(data.Case.Disposition))
+	}
+	if data.Case.ClosedAt != nil {
+		addTableRow(pdf, "Closed At:", data.Case.ClosedAt.Format("January 2, 2006"))
+	}
 	addTableRow(pdf, "Area/City:", data.Case.Area)
 	addTableRow(pdf, "Case Type:", data.Case.CaseType)
 	if data.Case.CreatedBy.FullName != "" {
@@ -115,12 +191,29 @@ func GeneratePDFFromData(data CaseReportData) ([]byte, error) {
 	addTableRow(pdf, "Authorization Level:", string(data.Case.AuthorizationLevel))
 	pdf.Ln(10)
 
+	// Custom Fields Section
+	if len(data.CustomFields) > 0 {
+		addSectionTitle(pdf, fmt.Sprintf("%s Details", data.Case.CaseType))
+		for _, row := range data.CustomFields {
+			addTableRow(pdf, row.Label+":", row.Value)
+		}
+		pdf.Ln(10)
+	}
+
 	// Case Description
 	addSectionTitle(pdf, "Case Description")
 	pdf.SetFont("Arial", "", 10)
 	pdf.MultiCell(0, 5, data.Case.Description, "", "", false)
 	pdf.Ln(10)
 
+	// Closing Summary
+	if data.Case.ClosingSummary != "" {
+		addSectionTitle(pdf, "Closing Summary")
+		pdf.SetFont("Arial", "", 10)
+		pdf.MultiCell(0, 5, data.Case.ClosingSummary, "", "", false)
+		pdf.Ln(10)
+	}
+
 	// Evidence Section
 	addSectionTitle(pdf, "Evidence")
 	if len(data.Evidence) > 0 {
@@ -0,0 +1,280 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"github.com/m7medVision/crime-management-system/internal/repository"
+	"github.com/m7medVision/crime-management-system/internal/util"
+)
+
+// viewWatchLimit bounds how many new cases one watch notification lists
+const viewWatchLimit = 20
+
+// SavedViewColumns lists the case columns a saved view can show
+var SavedViewColumns = []string{
+	"id", "referenceNumber", "name", "status", "priority", "area", "caseType",
+	"authorizationLevel", "slaStatus", "slaDueAt", "disposition", "createdAt", "updatedAt", "createdBy",
+}
+
+// SavedViewInput holds the editable fields of a saved view
+type SavedViewInput struct {
+	Name        string
+	Description string
+	Query       string // case list query string, with or without the leading ?
+	Columns     []string
+	Shared      bool // share with the users of the owner's home area
+	Watch       bool
+}
+
+type SavedViewService struct {
+	viewRepo    *repository.SavedViewRepository
+	caseService *CaseService
+	notifier    *NotificationService
+}
+
+func NewSavedViewService(viewRepo *repository.SavedViewRepository, caseService *CaseService, notifier *NotificationService) *SavedViewService {
+	return &SavedViewService{
+		viewRepo:    viewRepo,
+		caseService: caseService,
+		notifier:    notifier,
+	}
+}
+
+// canSeeView reports whether the view is the user's own or shared with their area
+func canSeeView(user *model.User, view *model.SavedView) bool {
+	return view.OwnerID == user.ID || (view.SharedArea != "" && view.SharedArea == user.Area)
+}
+
+// canEditView reports whether the user may change or delete the view
+func canEditView(user *model.User, view *model.SavedView) bool {
+	return view.OwnerID == user.ID || user.Role == model.RoleAdmin
+}
+
+func (s *SavedViewService) ListViews(user *model.User) ([]model.SavedView, error) {
+	return s.viewRepo.ListVisible(user.ID, user.Area)
+}
+
+func (s *SavedViewService) GetView(user *model.User, id uint) (*model.SavedView, error) {
+	view, err := s.viewRepo.GetByID(id)
+	if err != nil || !(canSeeView(user, view) || canEditView(user, view)) {
+		return nil, fmt.Errorf("%w: saved view not found", ErrNotFound)
+	}
+	return view, nil
+}
+
+func (s *SavedViewService) CreateView(user *model.User, input SavedViewInput) (*model.SavedView, error) {
+	view := &model.SavedView{OwnerID: user.ID}
+	if err := s.apply(user, view, input); err != nil {
+		return nil, err
+	}
+	if err := s.viewRepo.Create(view); err != nil {
+		return nil, err
+	}
+	return s.viewRepo.GetByID(view.ID)
+}
+
+// UpdateView replaces the fields of a view. Only its owner or an admin may change it.
+func (s *SavedViewService) UpdateView(user *model.User, id uint, input SavedViewInput) (*model.SavedView, error) {
+	view, err := s.GetView(user, id)
+	if err != nil {
+		return nil, err
+	}
+	if !canEditView(user, view) {
+		return nil, fmt.Errorf("%w: only the owner can change a saved view", ErrForbidden)
+	}
+	if err := s.apply(&view.Owner, view, input); err != nil {
+		return nil, err
+	}
+	if err := s.viewRepo.Update(view); err != nil {
+		return nil, err
+	}
+	return s.viewRepo.GetByID(view.ID)
+}
+
+func (s *SavedViewService) DeleteView(user *model.User, id uint) error {
+	view, err := s.GetView(user, id)
+	if err != nil {
+		return err
+	}
+	if !canEditView(user, view) {
+		return fmt.Errorf("%w: only the owner can delete a saved view", ErrForbidden)
+	}
+	return s.viewRepo.Delete(view.ID)
+}
+
+// apply validates the input and copies it onto the view. The query is stored in a
+// normalised form, without pagination.
+func (s *SavedViewService) apply(owner *model.User, view *model.SavedView, input SavedViewInput) error {
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidInput)
+	}
+	taken, err := s.viewRepo.NameTaken(owner.ID, name, view.ID)
+	if err != nil {
+		return err
+	}
+	if taken {
+		return fmt.Errorf("%w: you already have a saved view named %q", ErrConflict, name)
+	}
+
+	values, err := url.ParseQuery(strings.TrimPrefix(strings.TrimSpace(input.Query), "?"))
+	if err != nil {
+		return fmt.Errorf("%w: invalid query: %v", ErrInvalidInput, err)
+	}
+	values.Del("offset")
+	values.Del("limit")
+	if _, err := ParseCaseQuery(values); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+
+	for _, column := range input.Columns {
+		if !slices.Contains(SavedViewColumns, column) {
+			return fmt.Errorf("%w: unknown column %q, expected one of %s", ErrInvalidInput, column, strings.Join(SavedViewColumns, ", "))
+		}
+	}
+
+	sharedArea := ""
+	if input.Shared {
+		if owner.Area == "" {
+			return fmt.Errorf("%w: a view can only be shared by users with a home area", ErrInvalidInput)
+		}
+		sharedArea = owner.Area
+	}
+
+	// Only cases created after the watch is turned on are reported
+	if input.Watch && !view.Watch {
+		now := time.Now()
+		view.LastCheckedAt = &now
+	}
+
+	view.Name = name
+	view.Description = input.Description
+	view.Query = values.Encode()
+	view.Columns = strings.Join(input.Columns, ",")
+	view.SharedArea = sharedArea
+	view.Watch = input.Watch
+	return nil
+}
+
+// RunView lists the cases matching a view, as seen by the user running it
+func (s *SavedViewService) RunView(user *model.User, id uint, offset, limit int) (*model.SavedView, []model.Case, int64, error) {
+	view, err := s.GetView(user, id)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	query, err := viewQuery(user, view)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	cases, total, err := s.caseService.ListCases(user, offset, limit, query.Filter, query.Tags, query.CustomFields)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	return view, cases, total, nil
+}
+
+// viewQuery parses the stored query of a view, limited to the cases the user is cleared for
+func viewQuery(user *model.User, view *model.SavedView) (CaseQuery, error) {
+	values, err := url.ParseQuery(view.Query)
+	if err != nil {
+		return CaseQuery{}, fmt.Errorf("%w: invalid saved query: %v", ErrInvalidInput, err)
+	}
+	query, err := ParseCaseQuery(values)
+	if err != nil {
+		return CaseQuery{}, fmt.Errorf("%w: %v", ErrInvalidInput, err)
+	}
+	// Never leave the list nil, which would disable the clearance filter
+	query.Filter.AllowedLevels = append([]model.ClearanceLevel{}, util.ClearanceLevelsUpTo(user.ClearanceLevel)...)
+	return query, nil
+}
+
+// Start checks watched views for new matching cases in the background until ctx is cancelled.
+// A non-positive interval disables the checks.
+func (s *SavedViewService) Start(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		log.Println("Saved view watches disabled: VIEW_WATCH_INTERVAL is not positive")
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			if err := s.CheckWatches(time.Now()); err != nil {
+				log.Printf("Saved view watch check failed: %v", err)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// CheckWatches notifies the owner of every watched view of the cases created since
+// the view was last checked
+func (s *SavedViewService) CheckWatches(now time.Time) error {
+	views, err := s.viewRepo.ListWatched()
+	if err != nil {
+		return err
+	}
+
+	for i := range views {
+		view := &views[i]
+		if !view.Owner.IsActive {
+			continue
+		}
+		if view.LastCheckedAt != nil {
+			if err := s.checkWatch(view, now); err != nil {
+				log.Printf("Failed to check saved view %d: %v", view.ID, err)
+				continue
+			}
+		}
+		if err := s.viewRepo.MarkChecked(view.ID, now); err != nil {
+			log.Printf("Failed to mark saved view %d as checked: %v", view.ID, err)
+			continue
+		}
+	}
+	return nil
+}
+
+func (s *SavedViewService) checkWatch(view *model.SavedView, now time.Time) error {
+	query, err := viewQuery(&view.Owner, view)
+	if err != nil {
+		return err
+	}
+	query.Filter.CreatedAfter = view.LastCheckedAt
+	query.Filter.CreatedBefore = &now
+	query.Filter.OrderBy = "created_at ASC, id ASC"
+
+	cases, total, err := s.caseService.ListCases(&view.Owner, 0, viewWatchLimit, query.Filter, query.Tags, query.CustomFields)
+	if err != nil || total == 0 {
+		return err
+	}
+
+	lines := make([]string, 0, len(cases)+1)
+	for _, caseData := range cases {
+		lines = append(lines, fmt.Sprintf("%s - %s", caseData.ReferenceNumber, caseData.Name))
+	}
+	if more := total - int64(len(cases)); more > 0 {
+		lines = append(lines, fmt.Sprintf("and %d more", more))
+	}
+
+	title := fmt.Sprintf("%d new cases match your saved view %q", total, view.Name)
+	var caseID *uint
+	if total == 1 {
+		title = fmt.Sprintf("New case %s matches your saved view %q", cases[0].ReferenceNumber, view.Name)
+		caseID = &cases[0].ID
+	}
+	s.notifier.Notify([]uint{view.OwnerID}, model.NotificationViewMatch, title, strings.Join(lines, "\n"), caseID, nil)
+	return nil
+}
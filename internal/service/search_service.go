@@ -0,0 +1,48 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"github.com/m7medVision/crime-management-system/internal/repository"
+	"github.com/m7medVision/crime-management-system/internal/util"
+)
+
+var ErrInvalidSearchQuery = errors.New("invalid search query")
+
+type SearchService struct {
+	searchRepo *repository.SearchRepository
+}
+
+func NewSearchService(searchRepo *repository.SearchRepository) *SearchService {
+	return &SearchService{searchRepo: searchRepo}
+}
+
+// Search looks up a keyword across cases, evidence, statements, suspect
+// descriptions and comments, returning only hits on cases the user is cleared for.
+func (s *SearchService) Search(user *model.User, query string, entityTypes []string, offset, limit int) ([]repository.SearchResult, int64, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, 0, fmt.Errorf("%w: query is required", ErrInvalidSearchQuery)
+	}
+
+	for _, entityType := range entityTypes {
+		if !isSearchEntityType(entityType) {
+			return nil, 0, fmt.Errorf("%w: unknown entity type %q", ErrInvalidSearchQuery, entityType)
+		}
+	}
+
+	allowedLevels := util.ClearanceLevelsUpTo(user.ClearanceLevel)
+	return s.searchRepo.Search(query, entityTypes, allowedLevels, offset, limit)
+}
+
+func isSearchEntityType(entityType string) bool {
+	for _, t := range repository.SearchEntityTypes() {
+		if t == entityType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,246 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/m7medVision/crime-management-system/internal/config"
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"github.com/m7medVision/crime-management-system/internal/repository"
+)
+
+type SLAService struct {
+	slaRepo             *repository.SLAPolicyRepository
+	caseRepo            *repository.CaseRepository
+	userRepo            *repository.UserRepository
+	notificationService *NotificationService
+	atRiskPercent       int
+}
+
+func NewSLAService(
+	slaRepo *repository.SLAPolicyRepository,
+	caseRepo *repository.CaseRepository,
+	userRepo *repository.UserRepository,
+	notificationService *NotificationService,
+	slaConfig config.SLAConfig,
+) *SLAService {
+	return &SLAService{
+		slaRepo:             slaRepo,
+		caseRepo:            caseRepo,
+		userRepo:            userRepo,
+		notificationService: notificationService,
+		atRiskPercent:       slaConfig.AtRiskPercent,
+	}
+}
+
+func (s *SLAService) ListPolicies() ([]model.SLAPolicy, error) {
+	return s.slaRepo.List()
+}
+
+// SavePolicy creates or replaces the policy for a case type and priority
+func (s *SLAService) SavePolicy(policy *model.SLAPolicy) (*model.SLAPolicy, error) {
+	if !policy.Priority.IsValid() {
+		return nil, fmt.Errorf("%w: invalid priority %q", ErrInvalidInput, policy.Priority)
+	}
+	if policy.FirstAssignmentMinutes < 0 || policy.FirstUpdateMinutes < 0 || policy.ClosureMinutes < 0 {
+		return nil, fmt.Errorf("%w: SLA targets must not be negative", ErrInvalidInput)
+	}
+	if err := s.slaRepo.Upsert(policy); err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+func (s *SLAService) DeletePolicy(id uint) error {
+	if _, err := s.slaRepo.GetByID(id); err != nil {
+		return fmt.Errorf("%w: SLA policy not found", ErrNotFound)
+	}
+	return s.slaRepo.Delete(id)
+}
+
+// Start runs the SLA evaluator every interval until the context is cancelled.
+// A non-positive interval disables the evaluator.
+func (s *SLAService) Start(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		log.Println("SLA evaluator disabled: SLA_EVALUATION_INTERVAL is not positive")
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			if err := s.Evaluate(time.Now()); err != nil {
+				log.Printf("SLA evaluation failed: %v", err)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Evaluate recomputes the SLA status of every open case and escalates new breaches
+func (s *SLAService) Evaluate(now time.Time) error {
+	policies, err := s.slaRepo.List()
+	if err != nil {
+		return err
+	}
+	policyByKey := map[string]model.SLAPolicy{}
+	for _, policy := range policies {
+		policyByKey[policy.CaseType+"|"+string(policy.Priority)] = policy
+	}
+
+	cases, err := s.caseRepo.ListForSLAEvaluation()
+	if err != nil {
+		return err
+	}
+
+	for i := range cases {
+		caseData := &cases[i]
+
+		policy, ok := policyByKey[caseData.CaseType+"|"+string(caseData.Priority)]
+		if !ok {
+			policy, ok = policyByKey["|"+string(caseData.Priority)]
+		}
+		if !ok {
+			continue
+		}
+
+		status, dueAt := evaluateSLA(caseData, &policy, now, s.atRiskPercent)
+		if status != caseData.SLAStatus || !sameTime(dueAt, caseData.SLADueAt) {
+			if err := s.caseRepo.UpdateSLA(caseData.ID, status, dueAt); err != nil {
+				log.Printf("Failed to update SLA status of case %d: %v", caseData.ID, err)
+				continue
+			}
+		}
+
+		if status == model.SLAStatusBreached && caseData.EscalatedAt == nil {
+			s.escalate(caseData, now)
+		}
+	}
+	return nil
+}
+
+// escalate notifies supervisors (or admins, if there are none) that a case breached its SLA.
+// Only recipients cleared for the case are told; if none of them are, cleared admins are.
+func (s *SLAService) escalate(caseData *model.Case, now time.Time) {
+	escalated, err := s.caseRepo.MarkEscalated(caseData.ID, now)
+	if err != nil || !escalated {
+		return
+	}
+
+	recipients, err := s.userRepo.ListEscalationRecipients()
+	if err != nil {
+		log.Printf("Failed to load escalation recipients for case %d: %v", caseData.ID, err)
+		return
+	}
+
+	userIDs := clearedUserIDs(recipients, caseData)
+	if len(userIDs) == 0 {
+		admins, err := s.userRepo.ListActiveAdmins()
+		if err != nil {
+			log.Printf("Failed to load admins for escalation of case %d: %v", caseData.ID, err)
+			return
+		}
+		userIDs = clearedUserIDs(admins, caseData)
+	}
+	if len(userIDs) == 0 {
+		log.Printf("No one is cleared to receive the SLA escalation of case %d", caseData.ID)
+		return
+	}
+
+	caseID := caseData.ID
+	s.notificationService.Notify(
+		userIDs,
+		model.NotificationSLAEscalation,
+		fmt.Sprintf("Case %s breached its SLA", caseData.ReferenceNumber),
+		fmt.Sprintf("%s (%s priority) has missed one or more SLA targets", caseData.Name, caseData.Priority),
+		&caseID,
+		nil,
+	)
+}
+
+// clearedUserIDs returns the IDs of the users whose clearance covers the case
+func clearedUserIDs(users []model.User, caseData *model.Case) []uint {
+	userIDs := make([]uint, 0, len(users))
+	for i := range users {
+		if canAccessCase(&users[i], caseData) {
+			userIDs = append(userIDs, users[i].ID)
+		}
+	}
+	return userIDs
+}
+
+// evaluateSLA works out the SLA status of a case under a policy and returns the
+// closure deadline. Targets are measured from the time the case was opened;
+// for closed cases, targets that were never reached are judged at closing time.
+func evaluateSLA(caseData *model.Case, policy *model.SLAPolicy, now time.Time, atRiskPercent int) (model.SLAStatus, *time.Time) {
+	closed := caseData.Status == model.StatusClosed
+	if closed && caseData.ClosedAt != nil {
+		now = *caseData.ClosedAt
+	}
+
+	closedAt := caseData.ClosedAt
+	if closed && closedAt == nil {
+		closedAt = &now
+	}
+
+	targets := []struct {
+		minutes   int
+		reachedAt *time.Time
+	}{
+		{policy.FirstAssignmentMinutes, caseData.FirstAssignedAt},
+		{policy.FirstUpdateMinutes, caseData.FirstUpdatedAt},
+		{policy.ClosureMinutes, closedAt},
+	}
+
+	breached, atRisk := false, false
+	for _, target := range targets {
+		if target.minutes <= 0 {
+			continue
+		}
+		window := time.Duration(target.minutes) * time.Minute
+		deadline := caseData.CreatedAt.Add(window)
+
+		if target.reachedAt != nil {
+			if target.reachedAt.After(deadline) {
+				breached = true
+			}
+			continue
+		}
+		if now.After(deadline) {
+			breached = true
+		} else if now.Sub(caseData.CreatedAt)*100 >= window*time.Duration(atRiskPercent) {
+			atRisk = true
+		}
+	}
+
+	var dueAt *time.Time
+	if policy.ClosureMinutes > 0 {
+		due := caseData.CreatedAt.Add(time.Duration(policy.ClosureMinutes) * time.Minute)
+		dueAt = &due
+	}
+
+	switch {
+	case breached:
+		return model.SLAStatusBreached, dueAt
+	case closed:
+		return model.SLAStatusMet, dueAt
+	case atRisk:
+		return model.SLAStatusAtRisk, dueAt
+	}
+	return model.SLAStatusOnTrack, dueAt
+}
+
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/m7medVision/crime-management-system/internal/model"
+)
+
+func TestEvaluateSLA(t *testing.T) {
+	created := time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC)
+	at := func(minutes int) *time.Time {
+		v := created.Add(time.Duration(minutes) * time.Minute)
+		return &v
+	}
+	policy := model.SLAPolicy{FirstAssignmentMinutes: 60, FirstUpdateMinutes: 120, ClosureMinutes: 1000}
+
+	tests := []struct {
+		name   string
+		status model.CaseStatus
+		policy *model.SLAPolicy
+		// minutes after creation; nil when not reached
+		assigned, updated, closed *time.Time
+		now                       int
+		want                      model.SLAStatus
+	}{
+		{name: "new case", now: 10, want: model.SLAStatusOnTrack},
+		{name: "just below the at-risk threshold", now: 44, want: model.SLAStatusOnTrack},
+		{name: "at the at-risk threshold", now: 45, want: model.SLAStatusAtRisk},
+		{name: "assignment target missed", now: 61, want: model.SLAStatusBreached},
+		{name: "assigned in time", assigned: at(30), now: 61, want: model.SLAStatusOnTrack},
+		{name: "assigned late", assigned: at(90), updated: at(100), now: 200, want: model.SLAStatusBreached},
+		{name: "update target missed", assigned: at(10), now: 121, want: model.SLAStatusBreached},
+		{name: "closure nearly due", assigned: at(10), updated: at(20), now: 800, want: model.SLAStatusAtRisk},
+		{name: "closure target missed", assigned: at(10), updated: at(20), now: 1001, want: model.SLAStatusBreached},
+		{name: "closed in time", status: model.StatusClosed, assigned: at(10), updated: at(20), closed: at(500), now: 5000, want: model.SLAStatusMet},
+		{name: "closed late", status: model.StatusClosed, assigned: at(10), updated: at(20), closed: at(1500), now: 5000, want: model.SLAStatusBreached},
+		{name: "closed without a closing time is judged now", status: model.StatusClosed, assigned: at(10), updated: at(20), now: 900, want: model.SLAStatusMet},
+		{name: "closed before being assigned", status: model.StatusClosed, closed: at(30), now: 5000, want: model.SLAStatusMet},
+		{name: "closed after the assignment target", status: model.StatusClosed, closed: at(90), now: 5000, want: model.SLAStatusBreached},
+		{name: "zero targets are ignored", policy: &model.SLAPolicy{}, now: 100000, want: model.SLAStatusOnTrack},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := tt.status
+			if status == "" {
+				status = model.StatusOngoing
+			}
+			p := &policy
+			if tt.policy != nil {
+				p = tt.policy
+			}
+			caseData := &model.Case{
+				Status:          status,
+				FirstAssignedAt: tt.assigned,
+				FirstUpdatedAt:  tt.updated,
+				ClosedAt:        tt.closed,
+			}
+			caseData.CreatedAt = created
+
+			got, dueAt := evaluateSLA(caseData, p, *at(tt.now), 75)
+			if got != tt.want {
+				t.Errorf("status = %s, want %s", got, tt.want)
+			}
+			if p.ClosureMinutes > 0 {
+				if dueAt == nil || !dueAt.Equal(*at(p.ClosureMinutes)) {
+					t.Errorf("dueAt = %v, want %v", dueAt, at(p.ClosureMinutes))
+				}
+			} else if dueAt != nil {
+				t.Errorf("dueAt = %v, want nil", dueAt)
+			}
+		})
+	}
+}
+
+func TestClearedUserIDs(t *testing.T) {
+	caseData := &model.Case{AuthorizationLevel: model.ClearanceHigh}
+	users := []model.User{
+		{ClearanceLevel: model.ClearanceLow},
+		{ClearanceLevel: model.ClearanceHigh},
+		{ClearanceLevel: model.ClearanceCritical},
+	}
+	for i := range users {
+		users[i].ID = uint(i + 1)
+	}
+
+	got := clearedUserIDs(users, caseData)
+	if len(got) != 2 || got[0] != 2 || got[1] != 3 {
+		t.Errorf("clearedUserIDs = %v, want [2 3]", got)
+	}
+}
@@ -0,0 +1,212 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"github.com/m7medVision/crime-management-system/internal/repository"
+	"github.com/m7medVision/crime-management-system/internal/util"
+	"gorm.io/gorm"
+)
+
+var tagColorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// TagQuery selects entities by tag name: entities must carry all the tags, or any of them with MatchAny
+type TagQuery struct {
+	Names    []string
+	MatchAny bool
+}
+
+// TagChanges holds the tag fields to update; nil fields are left unchanged
+type TagChanges struct {
+	Name         *string
+	Color        *string
+	Description  *string
+	MinClearance *model.ClearanceLevel
+}
+
+type TagService struct {
+	tagRepo  *repository.TagRepository
+	caseRepo *repository.CaseRepository
+}
+
+func NewTagService(tagRepo *repository.TagRepository, caseRepo *repository.CaseRepository) *TagService {
+	return &TagService{
+		tagRepo:  tagRepo,
+		caseRepo: caseRepo,
+	}
+}
+
+func canUseTag(user *model.User, tag *model.Tag) bool {
+	return util.IsClearnceLevelHigherOrEqual(user.ClearanceLevel, tag.MinClearance)
+}
+
+// resolveTagFilter turns tag names into a repository filter. Tags the user is not
+// cleared for are treated as unknown.
+func resolveTagFilter(tagRepo *repository.TagRepository, user *model.User, query TagQuery) (repository.TagFilter, error) {
+	filter := repository.TagFilter{MatchAny: query.MatchAny}
+	for _, name := range query.Names {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		tag, err := tagRepo.GetByName(name)
+		if err != nil || !canUseTag(user, tag) {
+			return filter, fmt.Errorf("%w: unknown tag %q", ErrInvalidInput, name)
+		}
+		filter.TagIDs = append(filter.TagIDs, tag.ID)
+	}
+	return filter, nil
+}
+
+func validateTag(tag *model.Tag) error {
+	tag.Name = strings.TrimSpace(tag.Name)
+	if tag.Name == "" || len(tag.Name) > 64 {
+		return fmt.Errorf("%w: tag name must be 1 to 64 characters", ErrInvalidInput)
+	}
+	if tag.Color != "" && !tagColorPattern.MatchString(tag.Color) {
+		return fmt.Errorf("%w: tag color must be a hex colour like #ff8800", ErrInvalidInput)
+	}
+	if tag.MinClearance == "" {
+		tag.MinClearance = model.ClearanceLow
+	}
+	if util.ClearanceLevelToInt(tag.MinClearance) == 0 {
+		return fmt.Errorf("%w: invalid clearance level %q", ErrInvalidInput, tag.MinClearance)
+	}
+	return nil
+}
+
+func (s *TagService) CreateTag(tag *model.Tag) (*model.Tag, error) {
+	if err := validateTag(tag); err != nil {
+		return nil, err
+	}
+	if _, err := s.tagRepo.GetByName(tag.Name); err == nil {
+		return nil, fmt.Errorf("%w: tag %q already exists", ErrConflict, tag.Name)
+	}
+	if err := s.tagRepo.Create(tag); err != nil {
+		return nil, err
+	}
+	return tag, nil
+}
+
+func (s *TagService) UpdateTag(id uint, changes TagChanges) (*model.Tag, error) {
+	tag, err := s.tagRepo.GetByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: tag not found", ErrNotFound)
+	}
+
+	if changes.Name != nil {
+		tag.Name = *changes.Name
+	}
+	if changes.Color != nil {
+		tag.Color = *changes.Color
+	}
+	if changes.Description != nil {
+		tag.Description = *changes.Description
+	}
+	if changes.MinClearance != nil {
+		tag.MinClearance = *changes.MinClearance
+	}
+	if err := validateTag(tag); err != nil {
+		return nil, err
+	}
+	if existing, err := s.tagRepo.GetByName(tag.Name); err == nil && existing.ID != tag.ID {
+		return nil, fmt.Errorf("%w: tag %q already exists", ErrConflict, tag.Name)
+	}
+
+	if err := s.tagRepo.Update(tag); err != nil {
+		return nil, err
+	}
+	return tag, nil
+}
+
+func (s *TagService) DeleteTag(id uint) error {
+	if err := s.tagRepo.Delete(id); err != nil {
+		return fmt.Errorf("%w: tag not found", ErrNotFound)
+	}
+	return nil
+}
+
+// ListTags returns the tags the user is cleared to see
+func (s *TagService) ListTags(user *model.User) ([]model.Tag, error) {
+	return s.tagRepo.List(append([]model.ClearanceLevel{}, util.ClearanceLevelsUpTo(user.ClearanceLevel)...))
+}
+
+// entityCase checks that the entity exists and that the user may access its case
+func (s *TagService) entityCase(user *model.User, entity repository.TaggedEntity) (uint, error) {
+	if !repository.IsTaggable(entity.Type) {
+		return 0, fmt.Errorf("%w: cannot tag entity type %q", ErrInvalidInput, entity.Type)
+	}
+	caseID, err := s.tagRepo.GetEntityCaseID(entity.Type, entity.ID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, fmt.Errorf("%w: %s %d not found", ErrNotFound, entity.Type, entity.ID)
+	}
+	if err != nil {
+		return 0, err
+	}
+	if _, err := getAccessibleCase(s.caseRepo, user, caseID); err != nil {
+		return 0, err
+	}
+	return caseID, nil
+}
+
+// ListEntityTags returns the tags on an entity that the user is cleared to see
+func (s *TagService) ListEntityTags(user *model.User, entity repository.TaggedEntity) ([]model.Tag, error) {
+	if _, err := s.entityCase(user, entity); err != nil {
+		return nil, err
+	}
+	return s.tagRepo.ListByEntity(entity.Type, entity.ID, append([]model.ClearanceLevel{}, util.ClearanceLevelsUpTo(user.ClearanceLevel)...))
+}
+
+// BulkTag adds (or with remove, removes) every tag on every entity. The whole
+// operation fails if the user may not use one of the tags or access one of the entities.
+func (s *TagService) BulkTag(user *model.User, tagIDs []uint, entities []repository.TaggedEntity, remove bool, ipAddress string) error {
+	if len(tagIDs) == 0 || len(entities) == 0 {
+		return fmt.Errorf("%w: at least one tag and one entity are required", ErrInvalidInput)
+	}
+
+	var tagNames []string
+	for _, tagID := range tagIDs {
+		tag, err := s.tagRepo.GetByID(tagID)
+		if err != nil || !canUseTag(user, tag) {
+			return fmt.Errorf("%w: tag %d not found", ErrNotFound, tagID)
+		}
+		tagNames = append(tagNames, tag.Name)
+	}
+
+	action, verb := model.ActionCreate, "added to"
+	if remove {
+		action, verb = model.ActionDelete, "removed from"
+	}
+
+	auditLogs := make([]*model.AuditLog, 0, len(entities))
+	for _, entity := range entities {
+		caseID, err := s.entityCase(user, entity)
+		if err != nil {
+			return err
+		}
+		auditLog := &model.AuditLog{
+			UserID:     user.ID,
+			Action:     action,
+			EntityType: "tagging",
+			EntityID:   entity.ID,
+			CaseID:     &caseID,
+			IPAddress:  ipAddress,
+		}
+		summary := fmt.Sprintf("Tags %s %s %s %d", strings.Join(tagNames, ", "), verb, entity.Type, entity.ID)
+		if remove {
+			auditLog.OldValue = summary
+		} else {
+			auditLog.NewValue = summary
+		}
+		auditLogs = append(auditLogs, auditLog)
+	}
+
+	if remove {
+		return s.tagRepo.Detach(tagIDs, entities, auditLogs)
+	}
+	return s.tagRepo.Attach(tagIDs, entities, user.ID, auditLogs)
+}
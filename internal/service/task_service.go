@@ -0,0 +1,226 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"github.com/m7medVision/crime-management-system/internal/repository"
+)
+
+// TaskChanges holds the fields of a task to update; nil fields are left unchanged
+type TaskChanges struct {
+	Title         *string
+	Description   *string
+	AssigneeID    *uint
+	ClearAssignee bool
+	DueDate       *time.Time
+	ClearDueDate  bool
+	Priority      *model.TaskPriority
+	Status        *model.TaskStatus
+}
+
+type TaskService struct {
+	taskRepo *repository.TaskRepository
+	caseRepo *repository.CaseRepository
+	userRepo *repository.UserRepository
+}
+
+func NewTaskService(taskRepo *repository.TaskRepository, caseRepo *repository.CaseRepository, userRepo *repository.UserRepository) *TaskService {
+	return &TaskService{
+		taskRepo: taskRepo,
+		caseRepo: caseRepo,
+		userRepo: userRepo,
+	}
+}
+
+func isValidTaskStatus(status model.TaskStatus) bool {
+	switch status {
+	case model.TaskStatusOpen, model.TaskStatusInProgress, model.TaskStatusDone, model.TaskStatusCancelled:
+		return true
+	}
+	return false
+}
+
+func isValidTaskPriority(priority model.TaskPriority) bool {
+	switch priority {
+	case model.TaskPriorityLow, model.TaskPriorityMedium, model.TaskPriorityHigh, model.TaskPriorityUrgent:
+		return true
+	}
+	return false
+}
+
+// checkAssignee verifies that a task can be assigned to the user
+func (s *TaskService) checkAssignee(caseData *model.Case, assigneeID uint) error {
+	assignee, err := s.userRepo.GetByID(assigneeID)
+	if err != nil || !assignee.IsActive {
+		return fmt.Errorf("%w: assignee not found", ErrInvalidInput)
+	}
+	if !canAccessCase(assignee, caseData) {
+		return fmt.Errorf("%w: assignee does not have clearance for this case", ErrInsufficientClearance)
+	}
+	return nil
+}
+
+func (s *TaskService) logTaskEvent(user *model.User, task *model.Task, action model.ActionType, oldValue, newValue string) {
+	caseID := task.CaseID
+	s.caseRepo.CreateAuditLog(&model.AuditLog{
+		UserID:     user.ID,
+		Action:     action,
+		EntityType: "task",
+		EntityID:   task.ID,
+		CaseID:     &caseID,
+		OldValue:   oldValue,
+		NewValue:   newValue,
+	})
+}
+
+func (s *TaskService) CreateTask(user *model.User, task *model.Task) (*model.Task, error) {
+	caseData, err := getAccessibleCase(s.caseRepo, user, task.CaseID)
+	if err != nil {
+		return nil, err
+	}
+
+	if task.Priority == "" {
+		task.Priority = model.TaskPriorityMedium
+	}
+	if !isValidTaskPriority(task.Priority) {
+		return nil, fmt.Errorf("%w: unknown priority %q", ErrInvalidInput, task.Priority)
+	}
+	if task.AssigneeID != nil {
+		if err := s.checkAssignee(caseData, *task.AssigneeID); err != nil {
+			return nil, err
+		}
+	}
+
+	task.Status = model.TaskStatusOpen
+	task.CreatedByID = user.ID
+	if err := s.taskRepo.Create(task); err != nil {
+		return nil, err
+	}
+
+	s.logTaskEvent(user, task, model.ActionCreate, "", fmt.Sprintf("Task %q created", task.Title))
+
+	return s.taskRepo.GetByID(task.ID)
+}
+
+func (s *TaskService) GetTask(user *model.User, id uint) (*model.Task, error) {
+	task, err := s.taskRepo.GetByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: task not found", ErrNotFound)
+	}
+	if _, err := getAccessibleCase(s.caseRepo, user, task.CaseID); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+func (s *TaskService) ListCaseTasks(user *model.User, caseID uint, filter repository.TaskFilter) ([]model.Task, error) {
+	if _, err := getAccessibleCase(s.caseRepo, user, caseID); err != nil {
+		return nil, err
+	}
+	return s.taskRepo.ListByCaseID(caseID, filter)
+}
+
+// ListMyTasks returns the tasks assigned to the user across all cases the user is cleared for
+func (s *TaskService) ListMyTasks(user *model.User, filter repository.TaskFilter) ([]model.Task, error) {
+	tasks, err := s.taskRepo.ListByAssigneeID(user.ID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Tasks stay assigned after a clearance downgrade, but their cases are no longer shown
+	accessible := make([]model.Task, 0, len(tasks))
+	for i := range tasks {
+		if canAccessCase(user, &tasks[i].Case) {
+			accessible = append(accessible, tasks[i])
+		}
+	}
+	return accessible, nil
+}
+
+// UpdateTask applies the given changes. Officers may only update tasks assigned to them.
+func (s *TaskService) UpdateTask(user *model.User, id uint, changes TaskChanges) (*model.Task, error) {
+	task, err := s.GetTask(user, id)
+	if err != nil {
+		return nil, err
+	}
+
+	isAssignee := task.AssigneeID != nil && *task.AssigneeID == user.ID
+	if user.Role == model.RoleOfficer && !isAssignee {
+		return nil, fmt.Errorf("%w: officers can only update tasks assigned to them", ErrForbidden)
+	}
+
+	if changes.Title != nil {
+		task.Title = *changes.Title
+	}
+	if changes.Description != nil {
+		task.Description = *changes.Description
+	}
+	if changes.Priority != nil {
+		if !isValidTaskPriority(*changes.Priority) {
+			return nil, fmt.Errorf("%w: unknown priority %q", ErrInvalidInput, *changes.Priority)
+		}
+		task.Priority = *changes.Priority
+	}
+	if changes.ClearDueDate {
+		task.DueDate = nil
+	} else if changes.DueDate != nil {
+		task.DueDate = changes.DueDate
+	}
+	if changes.ClearAssignee {
+		task.AssigneeID = nil
+		task.Assignee = nil
+	} else if changes.AssigneeID != nil {
+		caseData, err := s.caseRepo.GetByID(task.CaseID)
+		if err != nil {
+			return nil, ErrCaseNotFound
+		}
+		if err := s.checkAssignee(caseData, *changes.AssigneeID); err != nil {
+			return nil, err
+		}
+		task.AssigneeID = changes.AssigneeID
+		task.Assignee = nil
+	}
+
+	oldStatus := task.Status
+	if changes.Status != nil {
+		if !isValidTaskStatus(*changes.Status) {
+			return nil, fmt.Errorf("%w: unknown status %q", ErrInvalidInput, *changes.Status)
+		}
+		task.Status = *changes.Status
+	}
+	if task.Status == model.TaskStatusDone && oldStatus != model.TaskStatusDone {
+		now := time.Now()
+		task.CompletedAt = &now
+	} else if task.Status != model.TaskStatusDone {
+		task.CompletedAt = nil
+	}
+
+	if err := s.taskRepo.Update(task); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case task.Status == model.TaskStatusDone && oldStatus != model.TaskStatusDone:
+		s.logTaskEvent(user, task, model.ActionUpdate, string(oldStatus), fmt.Sprintf("Task %q completed", task.Title))
+	case task.Status != oldStatus:
+		s.logTaskEvent(user, task, model.ActionUpdate, string(oldStatus), fmt.Sprintf("Task %q marked %s", task.Title, task.Status))
+	}
+
+	return s.taskRepo.GetByID(task.ID)
+}
+
+func (s *TaskService) DeleteTask(user *model.User, id uint) error {
+	task, err := s.GetTask(user, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.taskRepo.Delete(id); err != nil {
+		return err
+	}
+
+	s.logTaskEvent(user, task, model.ActionDelete, fmt.Sprintf("Task %q deleted", task.Title), "")
+	return nil
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"github.com/m7medVision/crime-management-system/internal/repository"
+)
+
+// TimelineEventTypes lists the event types that can be used to filter a case timeline
+var TimelineEventTypes = []string{
+	"case_created", "created_from_template", "status_changed", "authorization_changed",
+	"assignee_added", "assignee_removed", "assignee_role_changed", "assignee_eligibility_changed",
+	"evidence_added", "evidence_updated", "evidence_deleted", "person_added", "person_updated", "person_removed",
+	"comment_added", "comment_deleted", "report_linked", "case_merged",
+	"case_link_created", "case_link_deleted", "task_created", "task_updated", "task_deleted",
+	"tagging_created", "tagging_deleted",
+	"handover_requested", "handover_accepted", "handover_declined", "handover_cancelled",
+	"closure_requested", "closure_approved", "closure_rejected", "case_closed",
+	"reopen_requested", "reopen_approved", "reopen_rejected", "case_reopened", "evidence_archived", "evidence_restored",
+	"case_exported", "case_imported",
+}
+
+type TimelineService struct {
+	timelineRepo *repository.TimelineRepository
+	caseRepo     *repository.CaseRepository
+}
+
+func NewTimelineService(timelineRepo *repository.TimelineRepository, caseRepo *repository.CaseRepository) *TimelineService {
+	return &TimelineService{
+		timelineRepo: timelineRepo,
+		caseRepo:     caseRepo,
+	}
+}
+
+// GetCaseTimeline returns a page of the case's activity. Raw before/after values
+// from the audit log are only shown to admins.
+func (s *TimelineService) GetCaseTimeline(user *model.User, caseID uint, filter repository.TimelineFilter, offset, limit int) ([]repository.TimelineItem, int64, error) {
+	if _, err := getAccessibleCase(s.caseRepo, user, caseID); err != nil {
+		return nil, 0, err
+	}
+
+	for _, eventType := range filter.Types {
+		if !containsEventType(eventType) {
+			return nil, 0, fmt.Errorf("%w: unknown event type %q", ErrInvalidInput, eventType)
+		}
+	}
+	if filter.Since != nil && filter.Until != nil && filter.Until.Before(*filter.Since) {
+		return nil, 0, fmt.Errorf("%w: until must not be before since", ErrInvalidInput)
+	}
+
+	items, total, err := s.timelineRepo.ListByCaseID(caseID, filter, offset, limit)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if user.Role != model.RoleAdmin {
+		for i := range items {
+			items[i].OldValue = ""
+			items[i].NewValue = ""
+		}
+	}
+	return items, total, nil
+}
+
+func containsEventType(eventType string) bool {
+	for _, t := range TimelineEventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
@@ -2,17 +2,24 @@ package service
 
 import (
 	"errors"
+	"fmt"
 
 	"github.com/m7medVision/crime-management-system/internal/model"
 	"github.com/m7medVision/crime-management-system/internal/repository"
 )
 
 type UserService struct {
-	userRepo *repository.UserRepository
+	userRepo        *repository.UserRepository
+	reconciler      *AssignmentReconciler
+	handoverService *HandoverService
 }
 
-func NewUserService(userRepo *repository.UserRepository) *UserService {
-	return &UserService{userRepo: userRepo}
+func NewUserService(userRepo *repository.UserRepository, reconciler *AssignmentReconciler, handoverService *HandoverService) *UserService {
+	return &UserService{
+		userRepo:        userRepo,
+		reconciler:      reconciler,
+		handoverService: handoverService,
+	}
 }
 
 func (s *UserService) CreateUser(user *model.User) (*model.User, error) {
@@ -33,18 +40,79 @@ func (s *UserService) CreateUser(user *model.User) (*model.User, error) {
 	return user.SafeResponse(), nil
 }
 
-func (s *UserService) UpdateUser(user *model.User) (*model.User, error) {
+// UpdateUser saves the user. A change of clearance level reconciles the user's case assignments.
+func (s *UserService) UpdateUser(user *model.User, actorID uint) (*model.User, error) {
 	// Check if email is being changed and if it conflicts with another user
 	if existingUser, _ := s.userRepo.GetByEmail(user.Email); existingUser != nil && existingUser.ID != user.ID {
 		return nil, errors.New("email already in use by another user")
 	}
 
-	if err := s.userRepo.Update(user); err != nil {
+	previous, err := s.userRepo.GetByID(user.ID)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+
+	if previous.ClearanceLevel != user.ClearanceLevel {
+		// The user is saved together with the reconciliation, so neither happens without the other
+		if _, err := s.reconciler.UpdateUser(user, actorID); err != nil {
+			return nil, err
+		}
+	} else if err := s.userRepo.Update(user); err != nil {
 		return nil, err
 	}
 	return user.SafeResponse(), nil
 }
 
+// PreviewClearanceChange reports which assignments the user would lose if their
+// clearance were changed to the given level, without changing anything
+func (s *UserService) PreviewClearanceChange(userID uint, level model.ClearanceLevel, actorID uint) (*ReconciliationReport, error) {
+	if level == "" {
+		user, err := s.userRepo.GetByID(userID)
+		if err != nil {
+			return nil, fmt.Errorf("%w: user not found", ErrNotFound)
+		}
+		level = user.ClearanceLevel
+	}
+	return s.reconciler.ForUser(userID, level, actorID, true)
+}
+
+// ReconcileAssignments applies the reconciliation for the user's current clearance level
+func (s *UserService) ReconcileAssignments(userID, actorID uint) (*ReconciliationReport, error) {
+	user, err := s.userRepo.GetByID(userID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: user not found", ErrNotFound)
+	}
+	return s.reconciler.ForUser(userID, user.ClearanceLevel, actorID, false)
+}
+
+// DeactivateUser disables a user's account. With handoverTo set, a handover of every
+// open case the user is assigned to is requested first; the user stays assigned to
+// each case until the handover is accepted.
+func (s *UserService) DeactivateUser(actor *model.User, id uint, handoverTo *uint, note string) (*HandoverBatchResult, error) {
+	user, err := s.userRepo.GetByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: user not found", ErrNotFound)
+	}
+	if user.ID == actor.ID {
+		return nil, fmt.Errorf("%w: you cannot deactivate your own account", ErrInvalidInput)
+	}
+
+	result := &HandoverBatchResult{Items: []HandoverBatchItem{}}
+	if handoverTo != nil {
+		if result, err = s.handoverService.HandOverAll(actor, user.ID, *handoverTo, note); err != nil {
+			return nil, err
+		}
+	}
+
+	if user.IsActive {
+		user.IsActive = false
+		if err := s.userRepo.Update(user); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
 func (s *UserService) DeleteUser(id uint) error {
 	return s.userRepo.Delete(id)
 }
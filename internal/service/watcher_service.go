@@ -0,0 +1,55 @@
+package service
+
+import (
+	"github.com/m7medVision/crime-management-system/internal/model"
+	"github.com/m7medVision/crime-management-system/internal/repository"
+)
+
+type WatcherService struct {
+	watcherRepo *repository.WatcherRepository
+	caseRepo    *repository.CaseRepository
+}
+
+func NewWatcherService(watcherRepo *repository.WatcherRepository, caseRepo *repository.CaseRepository) *WatcherService {
+	return &WatcherService{
+		watcherRepo: watcherRepo,
+		caseRepo:    caseRepo,
+	}
+}
+
+// Watch subscribes the user to the events of a case they are cleared for
+func (s *WatcherService) Watch(user *model.User, caseID uint) error {
+	if _, err := getAccessibleCase(s.caseRepo, user, caseID); err != nil {
+		return err
+	}
+	return s.watcherRepo.Watch(caseID, user.ID)
+}
+
+// Unwatch stops following a case. It does not check clearance so that users can
+// still unwatch a case whose authorization level was raised above theirs.
+func (s *WatcherService) Unwatch(user *model.User, caseID uint) error {
+	return s.watcherRepo.Unwatch(caseID, user.ID)
+}
+
+func (s *WatcherService) ListWatchers(user *model.User, caseID uint) ([]model.User, error) {
+	if _, err := getAccessibleCase(s.caseRepo, user, caseID); err != nil {
+		return nil, err
+	}
+	return s.watcherRepo.ListWatchers(caseID)
+}
+
+// ListWatchedCases returns the cases the user watches and is still cleared for
+func (s *WatcherService) ListWatchedCases(user *model.User) ([]model.Case, error) {
+	cases, err := s.watcherRepo.ListWatchedCases(user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	accessible := make([]model.Case, 0, len(cases))
+	for i := range cases {
+		if canAccessCase(user, &cases[i]) {
+			accessible = append(accessible, cases[i])
+		}
+	}
+	return accessible, nil
+}
@@ -24,3 +24,14 @@ func ClearanceLevelToInt(level model.ClearanceLevel) int {
 		return 0
 	}
 }
+
+// ClearanceLevelsUpTo returns every clearance level that a user with the given level may access
+func ClearanceLevelsUpTo(level model.ClearanceLevel) []model.ClearanceLevel {
+	var levels []model.ClearanceLevel
+	for _, l := range []model.ClearanceLevel{model.ClearanceLow, model.ClearanceMedium, model.ClearanceHigh, model.ClearanceCritical} {
+		if IsClearnceLevelHigherOrEqual(level, l) {
+			levels = append(levels, l)
+		}
+	}
+	return levels
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/m7medVision/crime-management-system/internal/auth"
 	"github.com/m7medVision/crime-management-system/internal/config"
 	"github.com/m7medVision/crime-management-system/internal/model"
+	"github.com/m7medVision/crime-management-system/internal/repository"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
@@ -35,10 +36,16 @@ func InitDB(config *config.DatabaseConfig) (*gorm.DB, error) {
 	sqlDB.SetMaxOpenConns(100)
 	sqlDB.SetConnMaxLifetime(time.Hour)
 
+	// Assignments carry a role and who made them
+	if err := db.SetupJoinTable(&model.Case{}, "Assignees", &model.CaseAssignee{}); err != nil {
+		return nil, fmt.Errorf("failed to set up case assignees: %v", err)
+	}
+
 	// Auto migrate the schema
 	err = db.AutoMigrate(
 		&model.User{},
 		&model.Case{},
+		&model.CaseReferenceCounter{},
 		&model.Report{},
 		&model.Evidence{},
 		&model.Suspect{},
@@ -46,11 +53,61 @@ func InitDB(config *config.DatabaseConfig) (*gorm.DB, error) {
 		&model.Witness{},
 		&model.AuditLog{},
 		&model.Comment{},
+		&model.CaseLink{},
+		&model.Task{},
+		&model.CommentRevision{},
+		&model.Notification{},
+		&model.CaseWatcher{},
+		&model.NotificationPreference{},
+		&model.CaseHandover{},
+		&model.CaseTemplate{},
+		&model.SavedView{},
+		&model.CaseClosure{},
+		&model.CaseReopen{},
+		&model.SLAPolicy{},
+		&model.Tag{},
+		&model.Tagging{},
+		&model.CustomFieldSchema{},
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to migrate database: %v", err)
 	}
 
+	// Full-text search indexes
+	if err := repository.EnsureSearchIndexes(db); err != nil {
+		return nil, err
+	}
+
+	// Analytics indexes
+	if err := repository.EnsureAnalyticsIndexes(db); err != nil {
+		return nil, err
+	}
+
+	// Custom field filter index
+	if err := repository.EnsureCustomFieldIndexes(db); err != nil {
+		return nil, err
+	}
+
+	// One lead per case
+	if err := repository.EnsureAssignmentIndexes(db); err != nil {
+		return nil, err
+	}
+
+	// One pending handover per assignment
+	if err := repository.EnsureHandoverIndexes(db); err != nil {
+		return nil, err
+	}
+
+	// One pending closure per case
+	if err := repository.EnsureClosureIndexes(db); err != nil {
+		return nil, err
+	}
+
+	// One pending reopen request per case
+	if err := repository.EnsureReopenIndexes(db); err != nil {
+		return nil, err
+	}
+
 	// Create default admin user if not exists
 	var adminCount int64
 	db.Model(&model.User{}).Where("role = ?", model.RoleAdmin).Count(&adminCount)
@@ -76,6 +133,36 @@ func InitDB(config *config.DatabaseConfig) (*gorm.DB, error) {
 		log.Println("Default admin user created successfully")
 	}
 
+	// Create default SLA policies if none are defined
+	var policyCount int64
+	db.Model(&model.SLAPolicy{}).Count(&policyCount)
+	if policyCount == 0 {
+		defaultPolicies := []model.SLAPolicy{
+			{Priority: model.PriorityCritical, FirstAssignmentMinutes: 15, FirstUpdateMinutes: 60, ClosureMinutes: 7 * 24 * 60},
+			{Priority: model.PriorityHigh, FirstAssignmentMinutes: 60, FirstUpdateMinutes: 4 * 60, ClosureMinutes: 14 * 24 * 60},
+			{Priority: model.PriorityMedium, FirstAssignmentMinutes: 4 * 60, FirstUpdateMinutes: 24 * 60, ClosureMinutes: 30 * 24 * 60},
+			{Priority: model.PriorityLow, FirstAssignmentMinutes: 24 * 60, FirstUpdateMinutes: 3 * 24 * 60, ClosureMinutes: 90 * 24 * 60},
+		}
+		if result := db.Create(&defaultPolicies); result.Error != nil {
+			log.Fatalf("Failed to create default SLA policies: %v", result.Error)
+		}
+		// Open cases that already missed a target under these policies missed it before
+		// the policies existed; mark them as escalated so the first evaluation does not send
+		// a breach notification for each of them. Other open cases escalate as usual.
+		now := time.Now()
+		result := db.Exec(`UPDATE cases SET escalated_at = ? FROM sla_policies p
+			WHERE p.deleted_at IS NULL AND p.case_type = '' AND p.priority = cases.priority
+				AND cases.deleted_at IS NULL AND cases.status <> ? AND cases.escalated_at IS NULL
+				AND ((p.first_assignment_minutes > 0 AND COALESCE(cases.first_assigned_at, ?) > cases.created_at + p.first_assignment_minutes * INTERVAL '1 minute')
+					OR (p.first_update_minutes > 0 AND COALESCE(cases.first_updated_at, ?) > cases.created_at + p.first_update_minutes * INTERVAL '1 minute')
+					OR (p.closure_minutes > 0 AND ? > cases.created_at + p.closure_minutes * INTERVAL '1 minute'))`,
+			now, model.StatusClosed, now, now, now)
+		if result.Error != nil {
+			log.Fatalf("Failed to backfill SLA escalations: %v", result.Error)
+		}
+		log.Println("Default SLA policies created successfully")
+	}
+
 	log.Println("Database connected and migrated successfully")
 	return db, nil
 }
@@ -0,0 +1,25 @@
+package util
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// FormatCaseReference renders a case reference number such as DC-2026-000123
+// from a format containing {AREA}, {YEAR} and {SEQ} placeholders. The format is checked
+// when the configuration is loaded.
+func FormatCaseReference(format, areaCode string, year int, seq int64, digits int) string {
+	replacer := strings.NewReplacer(
+		"{AREA}", areaCode,
+		"{YEAR}", strconv.Itoa(year),
+		"{SEQ}", fmt.Sprintf("%0*d", digits, seq),
+	)
+	return replacer.Replace(format)
+}
+
+// CaseReferenceScope returns the counter scope for a reference number, so that
+// sequences restart every year for each area code
+func CaseReferenceScope(areaCode string, year int) string {
+	return fmt.Sprintf("%s-%d", areaCode, year)
+}
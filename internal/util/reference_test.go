@@ -0,0 +1,36 @@
+package util
+
+import "testing"
+
+func TestFormatCaseReference(t *testing.T) {
+	tests := []struct {
+		format string
+		area   string
+		year   int
+		seq    int64
+		digits int
+		want   string
+	}{
+		{"{AREA}-{YEAR}-{SEQ}", "DC", 2026, 123, 6, "DC-2026-000123"},
+		{"{YEAR}/{SEQ}", "DC", 2026, 7, 3, "2026/007"},
+		{"{AREA}{YEAR}{SEQ}", "NW", 2025, 1, 4, "NW20250001"},
+		{"CASE-{SEQ}-{YEAR}", "DC", 2026, 42, 0, "CASE-42-2026"},
+		{"{AREA}-{YEAR}-{SEQ}", "DC", 2026, 1234567, 6, "DC-2026-1234567"}, // wider than the padding
+	}
+	for _, tt := range tests {
+		got := FormatCaseReference(tt.format, tt.area, tt.year, tt.seq, tt.digits)
+		if got != tt.want {
+			t.Errorf("FormatCaseReference(%q, %q, %d, %d, %d) = %q, want %q",
+				tt.format, tt.area, tt.year, tt.seq, tt.digits, got, tt.want)
+		}
+	}
+}
+
+func TestCaseReferenceScope(t *testing.T) {
+	if got := CaseReferenceScope("DC", 2026); got != "DC-2026" {
+		t.Errorf("CaseReferenceScope = %q, want %q", got, "DC-2026")
+	}
+	if CaseReferenceScope("DC", 2026) == CaseReferenceScope("DC", 2027) {
+		t.Error("scopes of different years must differ")
+	}
+}